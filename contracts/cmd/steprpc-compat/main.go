@@ -0,0 +1,113 @@
+// Command steprpc-compat reports wire-breaking changes between two versions of
+// the Step RPC contracts.
+//
+// Usage:
+//
+//	steprpc-compat OLD.binpb [NEW.binpb]
+//
+// Inputs are FileDescriptorSets produced by `buf build -o FILE`; a .json
+// extension selects the protojson encoding. When NEW is omitted, OLD is
+// compared against the contracts compiled into this binary. The exit status is
+// 1 when breaking changes are found and 2 on usage or input errors.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/albertocavalcante/jenkins-rpc/contracts/compat"
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("steprpc-compat", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: steprpc-compat OLD.binpb [NEW.binpb]")
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return 2
+	}
+
+	oldSet, err := readSet(fs.Arg(0))
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "steprpc-compat: %v\n", err)
+		return 2
+	}
+	newSet := compiledSet()
+	if fs.NArg() == 2 {
+		if newSet, err = readSet(fs.Arg(1)); err != nil {
+			_, _ = fmt.Fprintf(stderr, "steprpc-compat: %v\n", err)
+			return 2
+		}
+	}
+
+	changes, err := compat.CompareSets(oldSet, newSet)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "steprpc-compat: %v\n", err)
+		return 2
+	}
+	for _, c := range changes {
+		_, _ = fmt.Fprintln(stdout, c)
+	}
+	if len(changes) > 0 {
+		_, _ = fmt.Fprintf(stderr, "steprpc-compat: %d breaking change(s)\n", len(changes))
+		return 1
+	}
+	return 0
+}
+
+func readSet(path string) (*descriptorpb.FileDescriptorSet, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is an explicit CLI argument
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = protojson.Unmarshal(data, set)
+	} else {
+		err = proto.Unmarshal(data, set)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+	return set, nil
+}
+
+// compiledSet returns the contracts linked into this binary plus their imports.
+func compiledSet() *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(steprpcv1.File_proto_steprpc_v1_contracts_proto)
+	add(steprpcv1.File_proto_steprpc_v1_service_proto)
+	return set
+}
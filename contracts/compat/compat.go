@@ -0,0 +1,298 @@
+// Package compat detects wire-breaking changes between two versions of the
+// Step RPC protobuf contracts.
+//
+// A change is breaking when a peer built against the old contract can no longer
+// exchange messages with a peer built against the new one, either in binary
+// protobuf or in the protojson encoding used on the HTTP API.
+package compat
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ChangeKind classifies a breaking change.
+type ChangeKind string
+
+const (
+	MessageRemoved          ChangeKind = "MESSAGE_REMOVED"
+	FieldRemoved            ChangeKind = "FIELD_REMOVED"
+	FieldRenumbered         ChangeKind = "FIELD_RENUMBERED"
+	FieldTypeChanged        ChangeKind = "FIELD_TYPE_CHANGED"
+	FieldCardinalityChanged ChangeKind = "FIELD_CARDINALITY_CHANGED"
+	FieldJSONNameChanged    ChangeKind = "FIELD_JSON_NAME_CHANGED"
+	FieldOneofChanged       ChangeKind = "FIELD_ONEOF_CHANGED"
+	EnumRemoved             ChangeKind = "ENUM_REMOVED"
+	EnumValueRemoved        ChangeKind = "ENUM_VALUE_REMOVED"
+	EnumValueRenamed        ChangeKind = "ENUM_VALUE_RENAMED"
+	ServiceRemoved          ChangeKind = "SERVICE_REMOVED"
+	MethodRemoved           ChangeKind = "METHOD_REMOVED"
+	MethodSignatureChanged  ChangeKind = "METHOD_SIGNATURE_CHANGED"
+)
+
+// Change describes one breaking change.
+type Change struct {
+	Kind ChangeKind
+	// Element is the fully-qualified name of the affected declaration in the old contract.
+	Element string
+	Detail  string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s: %s", c.Kind, c.Element, c.Detail)
+}
+
+// CompareSets compares two FileDescriptorSets, as produced by
+// `buf build -o contracts.binpb`, and returns the breaking changes in new
+// relative to old. Declarations are matched by fully-qualified name, so moving
+// a message between files is not reported.
+func CompareSets(oldSet, newSet *descriptorpb.FileDescriptorSet) ([]Change, error) {
+	oldFiles, err := protodesc.NewFiles(oldSet)
+	if err != nil {
+		return nil, fmt.Errorf("load old descriptors: %w", err)
+	}
+	newFiles, err := protodesc.NewFiles(newSet)
+	if err != nil {
+		return nil, fmt.Errorf("load new descriptors: %w", err)
+	}
+	return CompareRegistries(oldFiles, newFiles), nil
+}
+
+// CompareFiles compares two versions of a single file descriptor.
+func CompareFiles(oldFile, newFile protoreflect.FileDescriptor) []Change {
+	return compare(collect(oldFile), collect(newFile))
+}
+
+// CompareRegistries compares every declaration in oldFiles against newFiles.
+func CompareRegistries(oldFiles, newFiles *protoregistry.Files) []Change {
+	oldDecls, newDecls := newDeclarations(), newDeclarations()
+	oldFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		oldDecls.addFile(fd)
+		return true
+	})
+	newFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		newDecls.addFile(fd)
+		return true
+	})
+	return compare(oldDecls, newDecls)
+}
+
+type declarations struct {
+	messages map[protoreflect.FullName]protoreflect.MessageDescriptor
+	enums    map[protoreflect.FullName]protoreflect.EnumDescriptor
+	services map[protoreflect.FullName]protoreflect.ServiceDescriptor
+}
+
+func newDeclarations() *declarations {
+	return &declarations{
+		messages: map[protoreflect.FullName]protoreflect.MessageDescriptor{},
+		enums:    map[protoreflect.FullName]protoreflect.EnumDescriptor{},
+		services: map[protoreflect.FullName]protoreflect.ServiceDescriptor{},
+	}
+}
+
+func collect(fd protoreflect.FileDescriptor) *declarations {
+	d := newDeclarations()
+	d.addFile(fd)
+	return d
+}
+
+func (d *declarations) addFile(fd protoreflect.FileDescriptor) {
+	d.addMessages(fd.Messages())
+	d.addEnums(fd.Enums())
+	for i := range fd.Services().Len() {
+		sd := fd.Services().Get(i)
+		d.services[sd.FullName()] = sd
+	}
+}
+
+func (d *declarations) addMessages(mds protoreflect.MessageDescriptors) {
+	for i := range mds.Len() {
+		md := mds.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		d.messages[md.FullName()] = md
+		d.addMessages(md.Messages())
+		d.addEnums(md.Enums())
+	}
+}
+
+func (d *declarations) addEnums(eds protoreflect.EnumDescriptors) {
+	for i := range eds.Len() {
+		ed := eds.Get(i)
+		d.enums[ed.FullName()] = ed
+	}
+}
+
+func compare(oldDecls, newDecls *declarations) []Change {
+	var changes []Change
+	for name, oldMsg := range oldDecls.messages {
+		newMsg, ok := newDecls.messages[name]
+		if !ok {
+			changes = append(changes, Change{MessageRemoved, string(name), "message no longer exists"})
+			continue
+		}
+		changes = append(changes, compareMessage(oldMsg, newMsg)...)
+	}
+	for name, oldEnum := range oldDecls.enums {
+		newEnum, ok := newDecls.enums[name]
+		if !ok {
+			changes = append(changes, Change{EnumRemoved, string(name), "enum no longer exists"})
+			continue
+		}
+		changes = append(changes, compareEnum(oldEnum, newEnum)...)
+	}
+	for name, oldSvc := range oldDecls.services {
+		newSvc, ok := newDecls.services[name]
+		if !ok {
+			changes = append(changes, Change{ServiceRemoved, string(name), "service no longer exists"})
+			continue
+		}
+		changes = append(changes, compareService(oldSvc, newSvc)...)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Element != changes[j].Element {
+			return changes[i].Element < changes[j].Element
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes
+}
+
+func compareMessage(oldMsg, newMsg protoreflect.MessageDescriptor) []Change {
+	var changes []Change
+	oldFields := oldMsg.Fields()
+	newFields := newMsg.Fields()
+	for i := range oldFields.Len() {
+		oldField := oldFields.Get(i)
+		element := string(oldField.FullName())
+
+		newField := newFields.ByNumber(oldField.Number())
+		if newField == nil {
+			if moved := newFields.ByName(oldField.Name()); moved != nil {
+				changes = append(changes, Change{
+					FieldRenumbered, element,
+					fmt.Sprintf("field number changed from %d to %d", oldField.Number(), moved.Number()),
+				})
+				continue
+			}
+			changes = append(changes, Change{FieldRemoved, element, fmt.Sprintf("field %d no longer exists", oldField.Number())})
+			continue
+		}
+
+		if oldField.JSONName() != newField.JSONName() {
+			changes = append(changes, Change{
+				FieldJSONNameChanged, element,
+				fmt.Sprintf("protojson name changed from %q to %q", oldField.JSONName(), newField.JSONName()),
+			})
+		}
+		if oldType, newType := fieldType(oldField), fieldType(newField); oldType != newType {
+			changes = append(changes, Change{FieldTypeChanged, element, fmt.Sprintf("type changed from %s to %s", oldType, newType)})
+		}
+		if oldCard, newCard := cardinality(oldField), cardinality(newField); oldCard != newCard {
+			changes = append(changes, Change{
+				FieldCardinalityChanged, element,
+				fmt.Sprintf("cardinality changed from %s to %s", oldCard, newCard),
+			})
+		}
+		if oldOneof, newOneof := oneofName(oldField), oneofName(newField); oldOneof != newOneof {
+			changes = append(changes, Change{
+				FieldOneofChanged, element,
+				fmt.Sprintf("oneof membership changed from %q to %q", oldOneof, newOneof),
+			})
+		}
+	}
+	return changes
+}
+
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return fmt.Sprintf("map<%s, %s>", fieldType(fd.MapKey()), fieldType(fd.MapValue()))
+	case fd.Message() != nil:
+		return string(fd.Message().FullName())
+	case fd.Enum() != nil:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+func cardinality(fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map"
+	case fd.IsList():
+		return "repeated"
+	default:
+		return "singular"
+	}
+}
+
+// oneofName ignores the synthetic oneofs generated for proto3 optional fields,
+// which only change presence tracking and are wire compatible.
+func oneofName(fd protoreflect.FieldDescriptor) protoreflect.Name {
+	if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+		return od.Name()
+	}
+	return ""
+}
+
+func compareEnum(oldEnum, newEnum protoreflect.EnumDescriptor) []Change {
+	var changes []Change
+	oldValues := oldEnum.Values()
+	newValues := newEnum.Values()
+	for i := range oldValues.Len() {
+		oldValue := oldValues.Get(i)
+		element := string(oldValue.FullName())
+		newValue := newValues.ByNumber(oldValue.Number())
+		if newValue == nil {
+			changes = append(changes, Change{EnumValueRemoved, element, fmt.Sprintf("value %d no longer exists", oldValue.Number())})
+			continue
+		}
+		// protojson encodes enums by name, so a rename breaks JSON peers.
+		if oldValue.Name() != newValue.Name() {
+			changes = append(changes, Change{
+				EnumValueRenamed, element,
+				fmt.Sprintf("value %d renamed from %s to %s", oldValue.Number(), oldValue.Name(), newValue.Name()),
+			})
+		}
+	}
+	return changes
+}
+
+func compareService(oldSvc, newSvc protoreflect.ServiceDescriptor) []Change {
+	var changes []Change
+	oldMethods := oldSvc.Methods()
+	for i := range oldMethods.Len() {
+		oldMethod := oldMethods.Get(i)
+		element := string(oldMethod.FullName())
+		newMethod := newSvc.Methods().ByName(oldMethod.Name())
+		if newMethod == nil {
+			changes = append(changes, Change{MethodRemoved, element, "method no longer exists"})
+			continue
+		}
+		if oldSig, newSig := signature(oldMethod), signature(newMethod); oldSig != newSig {
+			changes = append(changes, Change{MethodSignatureChanged, element, fmt.Sprintf("signature changed from %s to %s", oldSig, newSig)})
+		}
+	}
+	return changes
+}
+
+func signature(md protoreflect.MethodDescriptor) string {
+	in, out := string(md.Input().FullName()), string(md.Output().FullName())
+	if md.IsStreamingClient() {
+		in = "stream " + in
+	}
+	if md.IsStreamingServer() {
+		out = "stream " + out
+	}
+	return fmt.Sprintf("(%s) returns (%s)", in, out)
+}
@@ -0,0 +1,185 @@
+package compat_test
+
+import (
+	"testing"
+
+	"github.com/albertocavalcante/jenkins-rpc/contracts/compat"
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func contractSet(t *testing.T, mutate func(*descriptorpb.FileDescriptorProto)) *descriptorpb.FileDescriptorSet {
+	t.Helper()
+	contract := protodesc.ToFileDescriptorProto(steprpcv1.File_proto_steprpc_v1_contracts_proto)
+	contract = proto.CloneOf(contract)
+	if mutate != nil {
+		mutate(contract)
+	}
+	return &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(structpb.File_google_protobuf_struct_proto),
+		protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+		contract,
+	}}
+}
+
+func message(t *testing.T, fd *descriptorpb.FileDescriptorProto, name string) *descriptorpb.DescriptorProto {
+	t.Helper()
+	for _, m := range fd.GetMessageType() {
+		if m.GetName() == name {
+			return m
+		}
+	}
+	t.Fatalf("message %s not found", name)
+	return nil
+}
+
+func field(t *testing.T, m *descriptorpb.DescriptorProto, name string) *descriptorpb.FieldDescriptorProto {
+	t.Helper()
+	for _, f := range m.GetField() {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	t.Fatalf("field %s.%s not found", m.GetName(), name)
+	return nil
+}
+
+func TestCompareSets_Identical(t *testing.T) {
+	t.Parallel()
+
+	changes, err := compat.CompareSets(contractSet(t, nil), contractSet(t, nil))
+	if err != nil {
+		t.Fatalf("CompareSets() error = %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("changes = %v, want none", changes)
+	}
+}
+
+func TestCompareSets_AdditiveChangeIsCompatible(t *testing.T) {
+	t.Parallel()
+
+	newSet := contractSet(t, func(fd *descriptorpb.FileDescriptorProto) {
+		m := message(t, fd, "InvokeResponse")
+		m.Field = append(m.Field, &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String("note"),
+			JsonName: proto.String("note"),
+			Number:   proto.Int32(99),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		})
+	})
+
+	changes, err := compat.CompareSets(contractSet(t, nil), newSet)
+	if err != nil {
+		t.Fatalf("CompareSets() error = %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("changes = %v, want none", changes)
+	}
+}
+
+func TestCompareSets_BreakingChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mutate  func(*testing.T, *descriptorpb.FileDescriptorProto)
+		kind    compat.ChangeKind
+		element string
+	}{
+		{
+			name: "field removed",
+			mutate: func(t *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				m := message(t, fd, "HealthResponse")
+				m.Field = m.Field[:len(m.Field)-1]
+			},
+			kind:    compat.FieldRemoved,
+			element: "steprpc.v1.HealthResponse.capabilities",
+		},
+		{
+			name: "field renumbered",
+			mutate: func(t *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				field(t, message(t, fd, "InvokeRequest"), "idempotency_key").Number = proto.Int32(40)
+			},
+			kind:    compat.FieldRenumbered,
+			element: "steprpc.v1.InvokeRequest.idempotency_key",
+		},
+		{
+			name: "field type changed",
+			mutate: func(t *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				field(t, message(t, fd, "RunStatusResponse"), "state").Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+			},
+			kind:    compat.FieldTypeChanged,
+			element: "steprpc.v1.RunStatusResponse.state",
+		},
+		{
+			name: "field cardinality changed",
+			mutate: func(t *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				field(t, message(t, fd, "InvokeResponse"), "run_id").Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			},
+			kind:    compat.FieldCardinalityChanged,
+			element: "steprpc.v1.InvokeResponse.run_id",
+		},
+		{
+			name: "json name changed",
+			mutate: func(t *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				field(t, message(t, fd, "InvokeRequest"), "request_id").JsonName = proto.String("requestID")
+			},
+			kind:    compat.FieldJSONNameChanged,
+			element: "steprpc.v1.InvokeRequest.request_id",
+		},
+		{
+			name: "enum value removed",
+			mutate: func(_ *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				e := fd.GetEnumType()[0]
+				e.Value = e.Value[:len(e.Value)-1]
+			},
+			kind:    compat.EnumValueRemoved,
+			element: "steprpc.v1.OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED",
+		},
+		{
+			name: "enum value renamed",
+			mutate: func(_ *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				fd.GetEnumType()[0].GetValue()[1].Name = proto.String("OPERATION_EXECUTION_MODE_INLINE")
+			},
+			kind:    compat.EnumValueRenamed,
+			element: "steprpc.v1.OPERATION_EXECUTION_MODE_DIRECT",
+		},
+		{
+			name: "message removed",
+			mutate: func(_ *testing.T, fd *descriptorpb.FileDescriptorProto) {
+				var kept []*descriptorpb.DescriptorProto
+				for _, m := range fd.GetMessageType() {
+					if m.GetName() != "BridgeCompleteResponse" {
+						kept = append(kept, m)
+					}
+				}
+				fd.MessageType = kept
+			},
+			kind:    compat.MessageRemoved,
+			element: "steprpc.v1.BridgeCompleteResponse",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			newSet := contractSet(t, func(fd *descriptorpb.FileDescriptorProto) { tt.mutate(t, fd) })
+			changes, err := compat.CompareSets(contractSet(t, nil), newSet)
+			if err != nil {
+				t.Fatalf("CompareSets() error = %v", err)
+			}
+			for _, c := range changes {
+				if c.Kind == tt.kind && c.Element == tt.element {
+					return
+				}
+			}
+			t.Fatalf("changes = %v, want %s on %s", changes, tt.kind, tt.element)
+		})
+	}
+}
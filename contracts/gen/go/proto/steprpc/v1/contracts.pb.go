@@ -72,6 +72,76 @@ func (OperationExecutionMode) EnumDescriptor() ([]byte, []int) {
 	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{0}
 }
 
+// Lifecycle state of a run. Messages carry it in run_state alongside the
+// legacy lowercase state string ("queued", "running", ...), which servers keep
+// sending while clients migrate. Clients prefer run_state when it is set.
+//
+// Allowed transitions:
+//
+//	QUEUED  -> RUNNING, SUCCEEDED, FAILED, CANCELLED
+//	RUNNING -> SUCCEEDED, FAILED, CANCELLED
+//
+// SUCCEEDED, FAILED, and CANCELLED are terminal. Direct runs may be created
+// already terminal; bridge runs start QUEUED and become RUNNING when first
+// delivered to a worker.
+type RunState int32
+
+const (
+	RunState_RUN_STATE_UNSPECIFIED RunState = 0
+	RunState_RUN_STATE_QUEUED      RunState = 1
+	RunState_RUN_STATE_RUNNING     RunState = 2
+	RunState_RUN_STATE_SUCCEEDED   RunState = 3
+	RunState_RUN_STATE_FAILED      RunState = 4
+	RunState_RUN_STATE_CANCELLED   RunState = 5
+)
+
+// Enum value maps for RunState.
+var (
+	RunState_name = map[int32]string{
+		0: "RUN_STATE_UNSPECIFIED",
+		1: "RUN_STATE_QUEUED",
+		2: "RUN_STATE_RUNNING",
+		3: "RUN_STATE_SUCCEEDED",
+		4: "RUN_STATE_FAILED",
+		5: "RUN_STATE_CANCELLED",
+	}
+	RunState_value = map[string]int32{
+		"RUN_STATE_UNSPECIFIED": 0,
+		"RUN_STATE_QUEUED":      1,
+		"RUN_STATE_RUNNING":     2,
+		"RUN_STATE_SUCCEEDED":   3,
+		"RUN_STATE_FAILED":      4,
+		"RUN_STATE_CANCELLED":   5,
+	}
+)
+
+func (x RunState) Enum() *RunState {
+	p := new(RunState)
+	*p = x
+	return p
+}
+
+func (x RunState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_steprpc_v1_contracts_proto_enumTypes[1].Descriptor()
+}
+
+func (RunState) Type() protoreflect.EnumType {
+	return &file_proto_steprpc_v1_contracts_proto_enumTypes[1]
+}
+
+func (x RunState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunState.Descriptor instead.
+func (RunState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{1}
+}
+
 type Error struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -177,10 +247,13 @@ func (x *ErrorResponse) GetError() *Error {
 }
 
 type HealthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiVersion    string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Service    string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Status     string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Optional features this server supports. Servers that predate capability
+	// negotiation leave this empty; clients then assume the v1 baseline.
+	Capabilities  []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -236,6 +309,13 @@ func (x *HealthResponse) GetStatus() string {
 	return ""
 }
 
+func (x *HealthResponse) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type CatalogOperation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -340,12 +420,35 @@ func (x *CatalogResponse) GetOperations() []*CatalogOperation {
 	return nil
 }
 
+// Callback delivery. When an InvokeRequest sets callback_url, the server POSTs
+// the run's RunStatusResponse (proto JSON) to it once the run reaches a terminal
+// state. Servers that deliver callbacks advertise the "webhooks" capability.
+//
+// Each delivery carries three headers:
+//
+//	X-StepRpc-Delivery:  opaque ID, reused by every redelivery of the event
+//	X-StepRpc-Timestamp: send time in Unix seconds
+//	X-StepRpc-Signature: v1=<hex(HMAC-SHA256(callback_secret, signed))>
+//
+// where signed is delivery + "." + timestamp + "." + body, using the header
+// values verbatim and the raw request body.
+//
+// The signature header may list several comma-separated v1= values while a
+// secret is being rotated; any one matching is enough. Receivers must compare
+// signatures in constant time, reject timestamps more than five minutes from
+// their clock, and treat a repeated delivery ID as already handled. Any 2xx
+// response acknowledges the delivery; otherwise the server may redeliver.
 type InvokeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	RequestId      string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Operation      string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
 	Args           *structpb.Struct       `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
 	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Optional http(s) URL notified when the run reaches a terminal state.
+	CallbackUrl string `protobuf:"bytes,5,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	// HMAC key for callback signatures. Required with callback_url; never echoed
+	// back by the server.
+	CallbackSecret string `protobuf:"bytes,6,opt,name=callback_secret,json=callbackSecret,proto3" json:"callback_secret,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -408,12 +511,27 @@ func (x *InvokeRequest) GetIdempotencyKey() string {
 	return ""
 }
 
+func (x *InvokeRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *InvokeRequest) GetCallbackSecret() string {
+	if x != nil {
+		return x.CallbackSecret
+	}
+	return ""
+}
+
 type InvokeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	Error         *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	RunState      RunState               `protobuf:"varint,5,opt,name=run_state,json=runState,proto3,enum=steprpc.v1.RunState" json:"run_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -476,6 +594,178 @@ func (x *InvokeResponse) GetError() *Error {
 	return nil
 }
 
+func (x *InvokeResponse) GetRunState() RunState {
+	if x != nil {
+		return x.RunState
+	}
+	return RunState_RUN_STATE_UNSPECIFIED
+}
+
+// Invokes several operations in one round trip (POST /step-rpc/v1/batchInvoke).
+// Each request is handled exactly as a single invoke, including requestId
+// dedup, and results come back in request order. Servers advertise the
+// "batch_invoke" capability and reject batches larger than their limit with
+// 400 batch_too_large, naming the limit in details["maxBatchSize"].
+type BatchInvokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*InvokeRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchInvokeRequest) Reset() {
+	*x = BatchInvokeRequest{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchInvokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvokeRequest) ProtoMessage() {}
+
+func (x *BatchInvokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvokeRequest.ProtoReflect.Descriptor instead.
+func (*BatchInvokeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchInvokeRequest) GetRequests() []*InvokeRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchInvokeResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set when the request was accepted.
+	Response *InvokeResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Set when the request was rejected, with the HTTP status a single invoke
+	// would have returned.
+	Error         *Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status        int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchInvokeResult) Reset() {
+	*x = BatchInvokeResult{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchInvokeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvokeResult) ProtoMessage() {}
+
+func (x *BatchInvokeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvokeResult.ProtoReflect.Descriptor instead.
+func (*BatchInvokeResult) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchInvokeResult) GetResponse() *InvokeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BatchInvokeResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *BatchInvokeResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type BatchInvokeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchInvokeResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchInvokeResponse) Reset() {
+	*x = BatchInvokeResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchInvokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvokeResponse) ProtoMessage() {}
+
+func (x *BatchInvokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvokeResponse.ProtoReflect.Descriptor instead.
+func (*BatchInvokeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchInvokeResponse) GetResults() []*BatchInvokeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Next pending request for a run (GET /step-rpc/v1/bridge/pending). The
+// optional workerId query parameter names the fetching worker in
+// RunStatusResponse.worker_id; it defaults to the authenticated user.
+//
+// The optional waitSeconds query parameter makes the call a long poll: the
+// server holds it until a request is pending or the wait passes (capped at 30s
+// by the plugin) and then answers 204 No Content. Without it, nothing pending
+// is a 404 no_pending_request. Servers that predate waitSeconds ignore it and
+// answer immediately.
 type BridgePendingResponse struct {
 	state                     protoimpl.MessageState `protogen:"open.v1"`
 	RequestId                 string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
@@ -489,7 +779,7 @@ type BridgePendingResponse struct {
 
 func (x *BridgePendingResponse) Reset() {
 	*x = BridgePendingResponse{}
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[7]
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +791,7 @@ func (x *BridgePendingResponse) String() string {
 func (*BridgePendingResponse) ProtoMessage() {}
 
 func (x *BridgePendingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[7]
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +804,7 @@ func (x *BridgePendingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BridgePendingResponse.ProtoReflect.Descriptor instead.
 func (*BridgePendingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{7}
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BridgePendingResponse) GetRequestId() string {
@@ -552,30 +842,39 @@ func (x *BridgePendingResponse) GetTargetRunExternalizableId() string {
 	return ""
 }
 
-type BridgeCompleteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+// Leases a run's next pending request to a worker
+// (POST /step-rpc/v1/bridge/claim). While the lease is live the request is not
+// handed to anyone else; once it expires without a heartbeat the next claim
+// redelivers it under a new lease. Servers with claim and heartbeat advertise
+// the "bridge_leases" capability.
+type BridgeClaimRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RunExternalizableId string                 `protobuf:"bytes,1,opt,name=run_externalizable_id,json=runExternalizableId,proto3" json:"run_externalizable_id,omitempty"`
+	// Recorded in RunStatusResponse.worker_id; defaults to the authenticated
+	// user.
+	WorkerId string `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// Requested lease length. Zero means the server default (60s); servers cap
+	// it (600s for the plugin).
+	LeaseSeconds  int32 `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BridgeCompleteRequest) Reset() {
-	*x = BridgeCompleteRequest{}
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[8]
+func (x *BridgeClaimRequest) Reset() {
+	*x = BridgeClaimRequest{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeCompleteRequest) String() string {
+func (x *BridgeClaimRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeCompleteRequest) ProtoMessage() {}
+func (*BridgeClaimRequest) ProtoMessage() {}
 
-func (x *BridgeCompleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[8]
+func (x *BridgeClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,56 +885,58 @@ func (x *BridgeCompleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeCompleteRequest.ProtoReflect.Descriptor instead.
-func (*BridgeCompleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BridgeClaimRequest.ProtoReflect.Descriptor instead.
+func (*BridgeClaimRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BridgeCompleteRequest) GetRunId() string {
+func (x *BridgeClaimRequest) GetRunExternalizableId() string {
 	if x != nil {
-		return x.RunId
+		return x.RunExternalizableId
 	}
 	return ""
 }
 
-func (x *BridgeCompleteRequest) GetState() string {
+func (x *BridgeClaimRequest) GetWorkerId() string {
 	if x != nil {
-		return x.State
+		return x.WorkerId
 	}
 	return ""
 }
 
-func (x *BridgeCompleteRequest) GetError() *Error {
+func (x *BridgeClaimRequest) GetLeaseSeconds() int32 {
 	if x != nil {
-		return x.Error
+		return x.LeaseSeconds
 	}
-	return nil
+	return 0
 }
 
-type BridgeCompleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+type BridgeClaimResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Request        *BridgePendingResponse `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	LeaseId        string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	// Delivery number of this request, 1 on first delivery.
+	Attempt       int32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BridgeCompleteResponse) Reset() {
-	*x = BridgeCompleteResponse{}
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[9]
+func (x *BridgeClaimResponse) Reset() {
+	*x = BridgeClaimResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeCompleteResponse) String() string {
+func (x *BridgeClaimResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeCompleteResponse) ProtoMessage() {}
+func (*BridgeClaimResponse) ProtoMessage() {}
 
-func (x *BridgeCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[9]
+func (x *BridgeClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,59 +947,66 @@ func (x *BridgeCompleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeCompleteResponse.ProtoReflect.Descriptor instead.
-func (*BridgeCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BridgeClaimResponse.ProtoReflect.Descriptor instead.
+func (*BridgeClaimResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BridgeCompleteResponse) GetRequestId() string {
+func (x *BridgeClaimResponse) GetRequest() *BridgePendingResponse {
 	if x != nil {
-		return x.RequestId
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *BridgeCompleteResponse) GetRunId() string {
+func (x *BridgeClaimResponse) GetLeaseId() string {
 	if x != nil {
-		return x.RunId
+		return x.LeaseId
 	}
 	return ""
 }
 
-func (x *BridgeCompleteResponse) GetState() string {
+func (x *BridgeClaimResponse) GetLeaseExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.State
+		return x.LeaseExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type RunStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Error         *Error                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+func (x *BridgeClaimResponse) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// Extends a live lease (POST /step-rpc/v1/bridge/heartbeat). An expired lease
+// fails with lease_expired, a superseded one with lease_mismatch.
+type BridgeHeartbeatRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RunId   string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	LeaseId string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// New lease length from now; zero means the server default.
+	LeaseSeconds  int32 `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunStatusResponse) Reset() {
-	*x = RunStatusResponse{}
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[10]
+func (x *BridgeHeartbeatRequest) Reset() {
+	*x = BridgeHeartbeatRequest{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunStatusResponse) String() string {
+func (x *BridgeHeartbeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunStatusResponse) ProtoMessage() {}
+func (*BridgeHeartbeatRequest) ProtoMessage() {}
 
-func (x *RunStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[10]
+func (x *BridgeHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,56 +1017,875 @@ func (x *RunStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunStatusResponse.ProtoReflect.Descriptor instead.
-func (*RunStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BridgeHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*BridgeHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RunStatusResponse) GetRequestId() string {
+func (x *BridgeHeartbeatRequest) GetRunId() string {
 	if x != nil {
-		return x.RequestId
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *RunStatusResponse) GetRunId() string {
+func (x *BridgeHeartbeatRequest) GetLeaseId() string {
 	if x != nil {
-		return x.RunId
+		return x.LeaseId
 	}
 	return ""
 }
 
-func (x *RunStatusResponse) GetOperation() string {
+func (x *BridgeHeartbeatRequest) GetLeaseSeconds() int32 {
 	if x != nil {
-		return x.Operation
+		return x.LeaseSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *RunStatusResponse) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+type BridgeHeartbeatResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RunId          string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	LeaseId        string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RunStatusResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *BridgeHeartbeatResponse) Reset() {
+	*x = BridgeHeartbeatResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunStatusResponse) GetError() *Error {
-	if x != nil {
-		return x.Error
-	}
-	return nil
+func (x *BridgeHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var File_proto_steprpc_v1_contracts_proto protoreflect.FileDescriptor
+func (*BridgeHeartbeatResponse) ProtoMessage() {}
 
-const file_proto_steprpc_v1_contracts_proto_rawDesc = "" +
+func (x *BridgeHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*BridgeHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BridgeHeartbeatResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BridgeHeartbeatResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *BridgeHeartbeatResponse) GetLeaseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return nil
+}
+
+// Runs with queued CPS bridge requests
+// (GET /step-rpc/v1/bridge/runs[?folder=<path>]), so one worker can service
+// every build without knowing run ids in advance. folder limits the list to
+// jobs inside that folder. Servers with this endpoint advertise the
+// "bridge_discovery" capability.
+type BridgeRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*BridgeRunSummary    `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeRunsResponse) Reset() {
+	*x = BridgeRunsResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeRunsResponse) ProtoMessage() {}
+
+func (x *BridgeRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeRunsResponse.ProtoReflect.Descriptor instead.
+func (*BridgeRunsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BridgeRunsResponse) GetRuns() []*BridgeRunSummary {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type BridgeRunSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RunExternalizableId string                 `protobuf:"bytes,1,opt,name=run_externalizable_id,json=runExternalizableId,proto3" json:"run_externalizable_id,omitempty"`
+	// Requests a claim or bridge/pending would hand out now.
+	Pending int32 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Requests held under a live lease.
+	Leased        int32 `protobuf:"varint,3,opt,name=leased,proto3" json:"leased,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeRunSummary) Reset() {
+	*x = BridgeRunSummary{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeRunSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeRunSummary) ProtoMessage() {}
+
+func (x *BridgeRunSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeRunSummary.ProtoReflect.Descriptor instead.
+func (*BridgeRunSummary) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BridgeRunSummary) GetRunExternalizableId() string {
+	if x != nil {
+		return x.RunExternalizableId
+	}
+	return ""
+}
+
+func (x *BridgeRunSummary) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *BridgeRunSummary) GetLeased() int32 {
+	if x != nil {
+		return x.Leased
+	}
+	return 0
+}
+
+// Progress of a bridge request while a worker executes it
+// (POST /step-rpc/v1/bridge/progress). A leased request needs its current,
+// unexpired lease, as for completion. Servers accepting progress and
+// BridgeCompleteRequest.result advertise the "bridge_results" capability.
+type BridgeProgressRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RunId   string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	LeaseId string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Completion estimate from 0 to 100.
+	Percent       int32  `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeProgressRequest) Reset() {
+	*x = BridgeProgressRequest{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeProgressRequest) ProtoMessage() {}
+
+func (x *BridgeProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeProgressRequest.ProtoReflect.Descriptor instead.
+func (*BridgeProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BridgeProgressRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BridgeProgressRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *BridgeProgressRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *BridgeProgressRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BridgeProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Progress      *RunProgress           `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeProgressResponse) Reset() {
+	*x = BridgeProgressResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeProgressResponse) ProtoMessage() {}
+
+func (x *BridgeProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeProgressResponse.ProtoReflect.Descriptor instead.
+func (*BridgeProgressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BridgeProgressResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BridgeProgressResponse) GetProgress() *RunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Last progress a bridge worker reported for a run.
+type RunProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percent       int32                  `protobuf:"varint,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunProgress) Reset() {
+	*x = RunProgress{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunProgress) ProtoMessage() {}
+
+func (x *RunProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunProgress.ProtoReflect.Descriptor instead.
+func (*RunProgress) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RunProgress) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RunProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RunProgress) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type BridgeCompleteRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RunId    string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	State    string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Error    *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	RunState RunState               `protobuf:"varint,4,opt,name=run_state,json=runState,proto3,enum=steprpc.v1.RunState" json:"run_state,omitempty"`
+	// Lease from BridgeClaimResponse. Completing a leased request requires the
+	// current, unexpired lease; only cancellation may omit it.
+	LeaseId string `protobuf:"bytes,5,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Value the operation returned, such as readFile's content. Served to the
+	// caller as RunStatusResponse.result and RunResultResponse.result.
+	Result        *structpb.Struct `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeCompleteRequest) Reset() {
+	*x = BridgeCompleteRequest{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeCompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeCompleteRequest) ProtoMessage() {}
+
+func (x *BridgeCompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeCompleteRequest.ProtoReflect.Descriptor instead.
+func (*BridgeCompleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BridgeCompleteRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BridgeCompleteRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BridgeCompleteRequest) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *BridgeCompleteRequest) GetRunState() RunState {
+	if x != nil {
+		return x.RunState
+	}
+	return RunState_RUN_STATE_UNSPECIFIED
+}
+
+func (x *BridgeCompleteRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *BridgeCompleteRequest) GetResult() *structpb.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type BridgeCompleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	RunState      RunState               `protobuf:"varint,4,opt,name=run_state,json=runState,proto3,enum=steprpc.v1.RunState" json:"run_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeCompleteResponse) Reset() {
+	*x = BridgeCompleteResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeCompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeCompleteResponse) ProtoMessage() {}
+
+func (x *BridgeCompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeCompleteResponse.ProtoReflect.Descriptor instead.
+func (*BridgeCompleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BridgeCompleteResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BridgeCompleteResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *BridgeCompleteResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BridgeCompleteResponse) GetRunState() RunState {
+	if x != nil {
+		return x.RunState
+	}
+	return RunState_RUN_STATE_UNSPECIFIED
+}
+
+type RunStatusResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RunId     string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Operation string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	State     string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Error     *Error                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// When execution began: the direct step started, or a bridge worker first
+	// fetched the request. Unset while queued.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Last change to the run's state, attempts, or worker.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// When the run reached a terminal state.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Executions handed out: 1 for direct runs, one per bridge delivery.
+	Attempts int32 `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Lane the run executes in.
+	ExecutionMode OperationExecutionMode `protobuf:"varint,11,opt,name=execution_mode,json=executionMode,proto3,enum=steprpc.v1.OperationExecutionMode" json:"execution_mode,omitempty"`
+	// Bridge worker that last fetched the request; empty for direct runs.
+	WorkerId string   `protobuf:"bytes,12,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	RunState RunState `protobuf:"varint,13,opt,name=run_state,json=runState,proto3,enum=steprpc.v1.RunState" json:"run_state,omitempty"`
+	// Last progress a bridge worker reported; unset when none has.
+	Progress *RunProgress `protobuf:"bytes,14,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Result data once the run is terminal, as in RunResultResponse.result.
+	Result        *structpb.Struct `protobuf:"bytes,15,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunStatusResponse) Reset() {
+	*x = RunStatusResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStatusResponse) ProtoMessage() {}
+
+func (x *RunStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStatusResponse.ProtoReflect.Descriptor instead.
+func (*RunStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunStatusResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RunStatusResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RunStatusResponse) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RunStatusResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RunStatusResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RunStatusResponse) GetExecutionMode() OperationExecutionMode {
+	if x != nil {
+		return x.ExecutionMode
+	}
+	return OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED
+}
+
+func (x *RunStatusResponse) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *RunStatusResponse) GetRunState() RunState {
+	if x != nil {
+		return x.RunState
+	}
+	return RunState_RUN_STATE_UNSPECIFIED
+}
+
+func (x *RunStatusResponse) GetProgress() *RunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *RunStatusResponse) GetResult() *structpb.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Operation-specific output of a run (GET /step-rpc/v1/runs/{runId}/result).
+// Servers with this endpoint and the log endpoint advertise the "run_output"
+// capability.
+type RunResultResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	State string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Result data, such as junit's test counts or the files archiveArtifacts
+	// stored. Empty while the run is not terminal or when the step reports none.
+	Result        *structpb.Struct `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Error         *Error           `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	RunState      RunState         `protobuf:"varint,5,opt,name=run_state,json=runState,proto3,enum=steprpc.v1.RunState" json:"run_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunResultResponse) Reset() {
+	*x = RunResultResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResultResponse) ProtoMessage() {}
+
+func (x *RunResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResultResponse.ProtoReflect.Descriptor instead.
+func (*RunResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RunResultResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RunResultResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RunResultResponse) GetResult() *structpb.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RunResultResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RunResultResponse) GetRunState() RunState {
+	if x != nil {
+		return x.RunState
+	}
+	return RunState_RUN_STATE_UNSPECIFIED
+}
+
+// A chunk of a run's console log (GET /step-rpc/v1/runs/{runId}/log?offset=N).
+// Offsets count UTF-8 bytes from the start of the log. A request past the end
+// returns empty text; clients resume from next_offset.
+type RunLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Offset of text within the log.
+	Offset     int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Text       string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	NextOffset int64  `protobuf:"varint,4,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	// True when the run is terminal and text reaches the end of the log.
+	Complete      bool `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunLogResponse) Reset() {
+	*x = RunLogResponse{}
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLogResponse) ProtoMessage() {}
+
+func (x *RunLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_contracts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLogResponse.ProtoReflect.Descriptor instead.
+func (*RunLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_contracts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RunLogResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RunLogResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RunLogResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RunLogResponse) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *RunLogResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+var File_proto_steprpc_v1_contracts_proto protoreflect.FileDescriptor
+
+const file_proto_steprpc_v1_contracts_proto_rawDesc = "" +
 	"\n" +
 	" proto/steprpc/v1/contracts.proto\x12\n" +
 	"steprpc.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x01\n" +
@@ -770,12 +1897,13 @@ const file_proto_steprpc_v1_contracts_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
 	"\rErrorResponse\x12'\n" +
-	"\x05error\x18\x01 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\"c\n" +
+	"\x05error\x18\x01 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\"\x87\x01\n" +
 	"\x0eHealthResponse\x12\x1f\n" +
 	"\vapi_version\x18\x01 \x01(\tR\n" +
 	"apiVersion\x12\x18\n" +
 	"\aservice\x18\x02 \x01(\tR\aservice\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\x93\x01\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\"\n" +
+	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\"\x93\x01\n" +
 	"\x10CatalogOperation\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12I\n" +
@@ -783,35 +1911,86 @@ const file_proto_steprpc_v1_contracts_proto_rawDesc = "" +
 	"\x0fCatalogResponse\x12<\n" +
 	"\n" +
 	"operations\x18\x01 \x03(\v2\x1c.steprpc.v1.CatalogOperationR\n" +
-	"operations\"\xa2\x01\n" +
+	"operations\"\xee\x01\n" +
 	"\rInvokeRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1c\n" +
 	"\toperation\x18\x02 \x01(\tR\toperation\x12+\n" +
 	"\x04args\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04args\x12'\n" +
-	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x85\x01\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\fcallback_url\x18\x05 \x01(\tR\vcallbackUrl\x12'\n" +
+	"\x0fcallback_secret\x18\x06 \x01(\tR\x0ecallbackSecret\"\xb8\x01\n" +
 	"\x0eInvokeResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x14\n" +
 	"\x05state\x18\x03 \x01(\tR\x05state\x12'\n" +
-	"\x05error\x18\x04 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\"\xd9\x01\n" +
+	"\x05error\x18\x04 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\x121\n" +
+	"\trun_state\x18\x05 \x01(\x0e2\x14.steprpc.v1.RunStateR\brunState\"K\n" +
+	"\x12BatchInvokeRequest\x125\n" +
+	"\brequests\x18\x01 \x03(\v2\x19.steprpc.v1.InvokeRequestR\brequests\"\x8c\x01\n" +
+	"\x11BatchInvokeResult\x126\n" +
+	"\bresponse\x18\x01 \x01(\v2\x1a.steprpc.v1.InvokeResponseR\bresponse\x12'\n" +
+	"\x05error\x18\x02 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\"N\n" +
+	"\x13BatchInvokeResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.steprpc.v1.BatchInvokeResultR\aresults\"\xd9\x01\n" +
 	"\x15BridgePendingResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x1c\n" +
 	"\toperation\x18\x03 \x01(\tR\toperation\x12+\n" +
 	"\x04args\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04args\x12?\n" +
-	"\x1ctarget_run_externalizable_id\x18\x05 \x01(\tR\x19targetRunExternalizableId\"m\n" +
+	"\x1ctarget_run_externalizable_id\x18\x05 \x01(\tR\x19targetRunExternalizableId\"\x8a\x01\n" +
+	"\x12BridgeClaimRequest\x122\n" +
+	"\x15run_externalizable_id\x18\x01 \x01(\tR\x13runExternalizableId\x12\x1b\n" +
+	"\tworker_id\x18\x02 \x01(\tR\bworkerId\x12#\n" +
+	"\rlease_seconds\x18\x03 \x01(\x05R\fleaseSeconds\"\xcd\x01\n" +
+	"\x13BridgeClaimResponse\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.steprpc.v1.BridgePendingResponseR\arequest\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12D\n" +
+	"\x10lease_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eleaseExpiresAt\x12\x18\n" +
+	"\aattempt\x18\x04 \x01(\x05R\aattempt\"o\n" +
+	"\x16BridgeHeartbeatRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12#\n" +
+	"\rlease_seconds\x18\x03 \x01(\x05R\fleaseSeconds\"\x91\x01\n" +
+	"\x17BridgeHeartbeatResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12D\n" +
+	"\x10lease_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eleaseExpiresAt\"F\n" +
+	"\x12BridgeRunsResponse\x120\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1c.steprpc.v1.BridgeRunSummaryR\x04runs\"x\n" +
+	"\x10BridgeRunSummary\x122\n" +
+	"\x15run_externalizable_id\x18\x01 \x01(\tR\x13runExternalizableId\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\x12\x16\n" +
+	"\x06leased\x18\x03 \x01(\x05R\x06leased\"}\n" +
+	"\x15BridgeProgressRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x05R\apercent\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"d\n" +
+	"\x16BridgeProgressResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x123\n" +
+	"\bprogress\x18\x02 \x01(\v2\x17.steprpc.v1.RunProgressR\bprogress\"|\n" +
+	"\vRunProgress\x12\x18\n" +
+	"\apercent\x18\x01 \x01(\x05R\apercent\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xec\x01\n" +
 	"\x15BridgeCompleteRequest\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12'\n" +
-	"\x05error\x18\x03 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\"d\n" +
+	"\x05error\x18\x03 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\x121\n" +
+	"\trun_state\x18\x04 \x01(\x0e2\x14.steprpc.v1.RunStateR\brunState\x12\x19\n" +
+	"\blease_id\x18\x05 \x01(\tR\aleaseId\x12/\n" +
+	"\x06result\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06result\"\x97\x01\n" +
 	"\x16BridgeCompleteResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x14\n" +
-	"\x05state\x18\x03 \x01(\tR\x05state\"\xe1\x01\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x121\n" +
+	"\trun_state\x18\x04 \x01(\x0e2\x14.steprpc.v1.RunStateR\brunState\"\xb3\x05\n" +
 	"\x11RunStatusResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x15\n" +
@@ -820,11 +1999,43 @@ const file_proto_steprpc_v1_contracts_proto_rawDesc = "" +
 	"\x05state\x18\x04 \x01(\tR\x05state\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
-	"\x05error\x18\x06 \x01(\v2\x11.steprpc.v1.ErrorR\x05error*\x99\x01\n" +
+	"\x05error\x18\x06 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x1a\n" +
+	"\battempts\x18\n" +
+	" \x01(\x05R\battempts\x12I\n" +
+	"\x0eexecution_mode\x18\v \x01(\x0e2\".steprpc.v1.OperationExecutionModeR\rexecutionMode\x12\x1b\n" +
+	"\tworker_id\x18\f \x01(\tR\bworkerId\x121\n" +
+	"\trun_state\x18\r \x01(\x0e2\x14.steprpc.v1.RunStateR\brunState\x123\n" +
+	"\bprogress\x18\x0e \x01(\v2\x17.steprpc.v1.RunProgressR\bprogress\x12/\n" +
+	"\x06result\x18\x0f \x01(\v2\x17.google.protobuf.StructR\x06result\"\xcd\x01\n" +
+	"\x11RunResultResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12/\n" +
+	"\x06result\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06result\x12'\n" +
+	"\x05error\x18\x04 \x01(\v2\x11.steprpc.v1.ErrorR\x05error\x121\n" +
+	"\trun_state\x18\x05 \x01(\x0e2\x14.steprpc.v1.RunStateR\brunState\"\x90\x01\n" +
+	"\x0eRunLogResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1f\n" +
+	"\vnext_offset\x18\x04 \x01(\x03R\n" +
+	"nextOffset\x12\x1a\n" +
+	"\bcomplete\x18\x05 \x01(\bR\bcomplete*\x99\x01\n" +
 	"\x16OperationExecutionMode\x12(\n" +
 	"$OPERATION_EXECUTION_MODE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fOPERATION_EXECUTION_MODE_DIRECT\x10\x01\x120\n" +
-	",OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED\x10\x02B\x81\x01\n" +
+	",OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED\x10\x02*\x9a\x01\n" +
+	"\bRunState\x12\x19\n" +
+	"\x15RUN_STATE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10RUN_STATE_QUEUED\x10\x01\x12\x15\n" +
+	"\x11RUN_STATE_RUNNING\x10\x02\x12\x17\n" +
+	"\x13RUN_STATE_SUCCEEDED\x10\x03\x12\x14\n" +
+	"\x10RUN_STATE_FAILED\x10\x04\x12\x17\n" +
+	"\x13RUN_STATE_CANCELLED\x10\x05B\x81\x01\n" +
 	"'io.albertocavalcante.jenkins.steprpc.v1P\x01ZTgithub.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1;steprpcv1b\x06proto3"
 
 var (
@@ -839,41 +2050,80 @@ func file_proto_steprpc_v1_contracts_proto_rawDescGZIP() []byte {
 	return file_proto_steprpc_v1_contracts_proto_rawDescData
 }
 
-var file_proto_steprpc_v1_contracts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_steprpc_v1_contracts_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_steprpc_v1_contracts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_steprpc_v1_contracts_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_proto_steprpc_v1_contracts_proto_goTypes = []any{
-	(OperationExecutionMode)(0),    // 0: steprpc.v1.OperationExecutionMode
-	(*Error)(nil),                  // 1: steprpc.v1.Error
-	(*ErrorResponse)(nil),          // 2: steprpc.v1.ErrorResponse
-	(*HealthResponse)(nil),         // 3: steprpc.v1.HealthResponse
-	(*CatalogOperation)(nil),       // 4: steprpc.v1.CatalogOperation
-	(*CatalogResponse)(nil),        // 5: steprpc.v1.CatalogResponse
-	(*InvokeRequest)(nil),          // 6: steprpc.v1.InvokeRequest
-	(*InvokeResponse)(nil),         // 7: steprpc.v1.InvokeResponse
-	(*BridgePendingResponse)(nil),  // 8: steprpc.v1.BridgePendingResponse
-	(*BridgeCompleteRequest)(nil),  // 9: steprpc.v1.BridgeCompleteRequest
-	(*BridgeCompleteResponse)(nil), // 10: steprpc.v1.BridgeCompleteResponse
-	(*RunStatusResponse)(nil),      // 11: steprpc.v1.RunStatusResponse
-	nil,                            // 12: steprpc.v1.Error.DetailsEntry
-	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
+	(OperationExecutionMode)(0),     // 0: steprpc.v1.OperationExecutionMode
+	(RunState)(0),                   // 1: steprpc.v1.RunState
+	(*Error)(nil),                   // 2: steprpc.v1.Error
+	(*ErrorResponse)(nil),           // 3: steprpc.v1.ErrorResponse
+	(*HealthResponse)(nil),          // 4: steprpc.v1.HealthResponse
+	(*CatalogOperation)(nil),        // 5: steprpc.v1.CatalogOperation
+	(*CatalogResponse)(nil),         // 6: steprpc.v1.CatalogResponse
+	(*InvokeRequest)(nil),           // 7: steprpc.v1.InvokeRequest
+	(*InvokeResponse)(nil),          // 8: steprpc.v1.InvokeResponse
+	(*BatchInvokeRequest)(nil),      // 9: steprpc.v1.BatchInvokeRequest
+	(*BatchInvokeResult)(nil),       // 10: steprpc.v1.BatchInvokeResult
+	(*BatchInvokeResponse)(nil),     // 11: steprpc.v1.BatchInvokeResponse
+	(*BridgePendingResponse)(nil),   // 12: steprpc.v1.BridgePendingResponse
+	(*BridgeClaimRequest)(nil),      // 13: steprpc.v1.BridgeClaimRequest
+	(*BridgeClaimResponse)(nil),     // 14: steprpc.v1.BridgeClaimResponse
+	(*BridgeHeartbeatRequest)(nil),  // 15: steprpc.v1.BridgeHeartbeatRequest
+	(*BridgeHeartbeatResponse)(nil), // 16: steprpc.v1.BridgeHeartbeatResponse
+	(*BridgeRunsResponse)(nil),      // 17: steprpc.v1.BridgeRunsResponse
+	(*BridgeRunSummary)(nil),        // 18: steprpc.v1.BridgeRunSummary
+	(*BridgeProgressRequest)(nil),   // 19: steprpc.v1.BridgeProgressRequest
+	(*BridgeProgressResponse)(nil),  // 20: steprpc.v1.BridgeProgressResponse
+	(*RunProgress)(nil),             // 21: steprpc.v1.RunProgress
+	(*BridgeCompleteRequest)(nil),   // 22: steprpc.v1.BridgeCompleteRequest
+	(*BridgeCompleteResponse)(nil),  // 23: steprpc.v1.BridgeCompleteResponse
+	(*RunStatusResponse)(nil),       // 24: steprpc.v1.RunStatusResponse
+	(*RunResultResponse)(nil),       // 25: steprpc.v1.RunResultResponse
+	(*RunLogResponse)(nil),          // 26: steprpc.v1.RunLogResponse
+	nil,                             // 27: steprpc.v1.Error.DetailsEntry
+	(*structpb.Struct)(nil),         // 28: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),   // 29: google.protobuf.Timestamp
 }
 var file_proto_steprpc_v1_contracts_proto_depIdxs = []int32{
-	12, // 0: steprpc.v1.Error.details:type_name -> steprpc.v1.Error.DetailsEntry
-	1,  // 1: steprpc.v1.ErrorResponse.error:type_name -> steprpc.v1.Error
+	27, // 0: steprpc.v1.Error.details:type_name -> steprpc.v1.Error.DetailsEntry
+	2,  // 1: steprpc.v1.ErrorResponse.error:type_name -> steprpc.v1.Error
 	0,  // 2: steprpc.v1.CatalogOperation.execution_mode:type_name -> steprpc.v1.OperationExecutionMode
-	4,  // 3: steprpc.v1.CatalogResponse.operations:type_name -> steprpc.v1.CatalogOperation
-	13, // 4: steprpc.v1.InvokeRequest.args:type_name -> google.protobuf.Struct
-	1,  // 5: steprpc.v1.InvokeResponse.error:type_name -> steprpc.v1.Error
-	13, // 6: steprpc.v1.BridgePendingResponse.args:type_name -> google.protobuf.Struct
-	1,  // 7: steprpc.v1.BridgeCompleteRequest.error:type_name -> steprpc.v1.Error
-	14, // 8: steprpc.v1.RunStatusResponse.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: steprpc.v1.RunStatusResponse.error:type_name -> steprpc.v1.Error
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 3: steprpc.v1.CatalogResponse.operations:type_name -> steprpc.v1.CatalogOperation
+	28, // 4: steprpc.v1.InvokeRequest.args:type_name -> google.protobuf.Struct
+	2,  // 5: steprpc.v1.InvokeResponse.error:type_name -> steprpc.v1.Error
+	1,  // 6: steprpc.v1.InvokeResponse.run_state:type_name -> steprpc.v1.RunState
+	7,  // 7: steprpc.v1.BatchInvokeRequest.requests:type_name -> steprpc.v1.InvokeRequest
+	8,  // 8: steprpc.v1.BatchInvokeResult.response:type_name -> steprpc.v1.InvokeResponse
+	2,  // 9: steprpc.v1.BatchInvokeResult.error:type_name -> steprpc.v1.Error
+	10, // 10: steprpc.v1.BatchInvokeResponse.results:type_name -> steprpc.v1.BatchInvokeResult
+	28, // 11: steprpc.v1.BridgePendingResponse.args:type_name -> google.protobuf.Struct
+	12, // 12: steprpc.v1.BridgeClaimResponse.request:type_name -> steprpc.v1.BridgePendingResponse
+	29, // 13: steprpc.v1.BridgeClaimResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	29, // 14: steprpc.v1.BridgeHeartbeatResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	18, // 15: steprpc.v1.BridgeRunsResponse.runs:type_name -> steprpc.v1.BridgeRunSummary
+	21, // 16: steprpc.v1.BridgeProgressResponse.progress:type_name -> steprpc.v1.RunProgress
+	29, // 17: steprpc.v1.RunProgress.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: steprpc.v1.BridgeCompleteRequest.error:type_name -> steprpc.v1.Error
+	1,  // 19: steprpc.v1.BridgeCompleteRequest.run_state:type_name -> steprpc.v1.RunState
+	28, // 20: steprpc.v1.BridgeCompleteRequest.result:type_name -> google.protobuf.Struct
+	1,  // 21: steprpc.v1.BridgeCompleteResponse.run_state:type_name -> steprpc.v1.RunState
+	29, // 22: steprpc.v1.RunStatusResponse.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 23: steprpc.v1.RunStatusResponse.error:type_name -> steprpc.v1.Error
+	29, // 24: steprpc.v1.RunStatusResponse.started_at:type_name -> google.protobuf.Timestamp
+	29, // 25: steprpc.v1.RunStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 26: steprpc.v1.RunStatusResponse.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 27: steprpc.v1.RunStatusResponse.execution_mode:type_name -> steprpc.v1.OperationExecutionMode
+	1,  // 28: steprpc.v1.RunStatusResponse.run_state:type_name -> steprpc.v1.RunState
+	21, // 29: steprpc.v1.RunStatusResponse.progress:type_name -> steprpc.v1.RunProgress
+	28, // 30: steprpc.v1.RunStatusResponse.result:type_name -> google.protobuf.Struct
+	28, // 31: steprpc.v1.RunResultResponse.result:type_name -> google.protobuf.Struct
+	2,  // 32: steprpc.v1.RunResultResponse.error:type_name -> steprpc.v1.Error
+	1,  // 33: steprpc.v1.RunResultResponse.run_state:type_name -> steprpc.v1.RunState
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_proto_steprpc_v1_contracts_proto_init() }
@@ -886,8 +2136,8 @@ func file_proto_steprpc_v1_contracts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_steprpc_v1_contracts_proto_rawDesc), len(file_proto_steprpc_v1_contracts_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/steprpc/v1/service.proto
+
+package steprpcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+type CatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogRequest) Reset() {
+	*x = CatalogRequest{}
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogRequest) ProtoMessage() {}
+
+func (x *CatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogRequest.ProtoReflect.Descriptor instead.
+func (*CatalogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+type GetRunStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRunStatusRequest) Reset() {
+	*x = GetRunStatusRequest{}
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRunStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunStatusRequest) ProtoMessage() {}
+
+func (x *GetRunStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRunStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRunStatusRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type WatchRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRunRequest) Reset() {
+	*x = WatchRunRequest{}
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRunRequest) ProtoMessage() {}
+
+func (x *WatchRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRunRequest.ProtoReflect.Descriptor instead.
+func (*WatchRunRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type BridgePendingRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RunExternalizableId string                 `protobuf:"bytes,1,opt,name=run_externalizable_id,json=runExternalizableId,proto3" json:"run_externalizable_id,omitempty"`
+	// Long-poll: hold the call up to this long for a request to become pending.
+	// When none does, BridgePending fails with NOT_FOUND as it does without a
+	// wait.
+	WaitSeconds   int32 `protobuf:"varint,2,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgePendingRequest) Reset() {
+	*x = BridgePendingRequest{}
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgePendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePendingRequest) ProtoMessage() {}
+
+func (x *BridgePendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_steprpc_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePendingRequest.ProtoReflect.Descriptor instead.
+func (*BridgePendingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_steprpc_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BridgePendingRequest) GetRunExternalizableId() string {
+	if x != nil {
+		return x.RunExternalizableId
+	}
+	return ""
+}
+
+func (x *BridgePendingRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+var File_proto_steprpc_v1_service_proto protoreflect.FileDescriptor
+
+const file_proto_steprpc_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/steprpc/v1/service.proto\x12\n" +
+	"steprpc.v1\x1a proto/steprpc/v1/contracts.proto\"\x0f\n" +
+	"\rHealthRequest\"\x10\n" +
+	"\x0eCatalogRequest\",\n" +
+	"\x13GetRunStatusRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"(\n" +
+	"\x0fWatchRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"m\n" +
+	"\x14BridgePendingRequest\x122\n" +
+	"\x15run_externalizable_id\x18\x01 \x01(\tR\x13runExternalizableId\x12!\n" +
+	"\fwait_seconds\x18\x02 \x01(\x05R\vwaitSeconds2\x9f\x04\n" +
+	"\x0eStepRpcService\x12?\n" +
+	"\x06Health\x12\x19.steprpc.v1.HealthRequest\x1a\x1a.steprpc.v1.HealthResponse\x12B\n" +
+	"\aCatalog\x12\x1a.steprpc.v1.CatalogRequest\x1a\x1b.steprpc.v1.CatalogResponse\x12?\n" +
+	"\x06Invoke\x12\x19.steprpc.v1.InvokeRequest\x1a\x1a.steprpc.v1.InvokeResponse\x12N\n" +
+	"\fGetRunStatus\x12\x1f.steprpc.v1.GetRunStatusRequest\x1a\x1d.steprpc.v1.RunStatusResponse\x12H\n" +
+	"\bWatchRun\x12\x1b.steprpc.v1.WatchRunRequest\x1a\x1d.steprpc.v1.RunStatusResponse0\x01\x12T\n" +
+	"\rBridgePending\x12 .steprpc.v1.BridgePendingRequest\x1a!.steprpc.v1.BridgePendingResponse\x12W\n" +
+	"\x0eBridgeComplete\x12!.steprpc.v1.BridgeCompleteRequest\x1a\".steprpc.v1.BridgeCompleteResponseB\x81\x01\n" +
+	"'io.albertocavalcante.jenkins.steprpc.v1P\x01ZTgithub.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1;steprpcv1b\x06proto3"
+
+var (
+	file_proto_steprpc_v1_service_proto_rawDescOnce sync.Once
+	file_proto_steprpc_v1_service_proto_rawDescData []byte
+)
+
+func file_proto_steprpc_v1_service_proto_rawDescGZIP() []byte {
+	file_proto_steprpc_v1_service_proto_rawDescOnce.Do(func() {
+		file_proto_steprpc_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_steprpc_v1_service_proto_rawDesc), len(file_proto_steprpc_v1_service_proto_rawDesc)))
+	})
+	return file_proto_steprpc_v1_service_proto_rawDescData
+}
+
+var file_proto_steprpc_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_steprpc_v1_service_proto_goTypes = []any{
+	(*HealthRequest)(nil),          // 0: steprpc.v1.HealthRequest
+	(*CatalogRequest)(nil),         // 1: steprpc.v1.CatalogRequest
+	(*GetRunStatusRequest)(nil),    // 2: steprpc.v1.GetRunStatusRequest
+	(*WatchRunRequest)(nil),        // 3: steprpc.v1.WatchRunRequest
+	(*BridgePendingRequest)(nil),   // 4: steprpc.v1.BridgePendingRequest
+	(*InvokeRequest)(nil),          // 5: steprpc.v1.InvokeRequest
+	(*BridgeCompleteRequest)(nil),  // 6: steprpc.v1.BridgeCompleteRequest
+	(*HealthResponse)(nil),         // 7: steprpc.v1.HealthResponse
+	(*CatalogResponse)(nil),        // 8: steprpc.v1.CatalogResponse
+	(*InvokeResponse)(nil),         // 9: steprpc.v1.InvokeResponse
+	(*RunStatusResponse)(nil),      // 10: steprpc.v1.RunStatusResponse
+	(*BridgePendingResponse)(nil),  // 11: steprpc.v1.BridgePendingResponse
+	(*BridgeCompleteResponse)(nil), // 12: steprpc.v1.BridgeCompleteResponse
+}
+var file_proto_steprpc_v1_service_proto_depIdxs = []int32{
+	0,  // 0: steprpc.v1.StepRpcService.Health:input_type -> steprpc.v1.HealthRequest
+	1,  // 1: steprpc.v1.StepRpcService.Catalog:input_type -> steprpc.v1.CatalogRequest
+	5,  // 2: steprpc.v1.StepRpcService.Invoke:input_type -> steprpc.v1.InvokeRequest
+	2,  // 3: steprpc.v1.StepRpcService.GetRunStatus:input_type -> steprpc.v1.GetRunStatusRequest
+	3,  // 4: steprpc.v1.StepRpcService.WatchRun:input_type -> steprpc.v1.WatchRunRequest
+	4,  // 5: steprpc.v1.StepRpcService.BridgePending:input_type -> steprpc.v1.BridgePendingRequest
+	6,  // 6: steprpc.v1.StepRpcService.BridgeComplete:input_type -> steprpc.v1.BridgeCompleteRequest
+	7,  // 7: steprpc.v1.StepRpcService.Health:output_type -> steprpc.v1.HealthResponse
+	8,  // 8: steprpc.v1.StepRpcService.Catalog:output_type -> steprpc.v1.CatalogResponse
+	9,  // 9: steprpc.v1.StepRpcService.Invoke:output_type -> steprpc.v1.InvokeResponse
+	10, // 10: steprpc.v1.StepRpcService.GetRunStatus:output_type -> steprpc.v1.RunStatusResponse
+	10, // 11: steprpc.v1.StepRpcService.WatchRun:output_type -> steprpc.v1.RunStatusResponse
+	11, // 12: steprpc.v1.StepRpcService.BridgePending:output_type -> steprpc.v1.BridgePendingResponse
+	12, // 13: steprpc.v1.StepRpcService.BridgeComplete:output_type -> steprpc.v1.BridgeCompleteResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_steprpc_v1_service_proto_init() }
+func file_proto_steprpc_v1_service_proto_init() {
+	if File_proto_steprpc_v1_service_proto != nil {
+		return
+	}
+	file_proto_steprpc_v1_contracts_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_steprpc_v1_service_proto_rawDesc), len(file_proto_steprpc_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_steprpc_v1_service_proto_goTypes,
+		DependencyIndexes: file_proto_steprpc_v1_service_proto_depIdxs,
+		MessageInfos:      file_proto_steprpc_v1_service_proto_msgTypes,
+	}.Build()
+	File_proto_steprpc_v1_service_proto = out.File
+	file_proto_steprpc_v1_service_proto_goTypes = nil
+	file_proto_steprpc_v1_service_proto_depIdxs = nil
+}
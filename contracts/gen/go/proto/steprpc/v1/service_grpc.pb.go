@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: proto/steprpc/v1/service.proto
+
+package steprpcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StepRpcService_Health_FullMethodName         = "/steprpc.v1.StepRpcService/Health"
+	StepRpcService_Catalog_FullMethodName        = "/steprpc.v1.StepRpcService/Catalog"
+	StepRpcService_Invoke_FullMethodName         = "/steprpc.v1.StepRpcService/Invoke"
+	StepRpcService_GetRunStatus_FullMethodName   = "/steprpc.v1.StepRpcService/GetRunStatus"
+	StepRpcService_WatchRun_FullMethodName       = "/steprpc.v1.StepRpcService/WatchRun"
+	StepRpcService_BridgePending_FullMethodName  = "/steprpc.v1.StepRpcService/BridgePending"
+	StepRpcService_BridgeComplete_FullMethodName = "/steprpc.v1.StepRpcService/BridgeComplete"
+)
+
+// StepRpcServiceClient is the client API for StepRpcService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StepRpcService is the gRPC facade over the plugin's HTTP API. Responses reuse
+// the HTTP JSON messages so a gateway can pass them through unchanged.
+//
+// Failures are reported as gRPC status codes. When the plugin returned a
+// structured error, the status details carry the steprpc.v1.Error.
+type StepRpcServiceClient interface {
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	Catalog(ctx context.Context, in *CatalogRequest, opts ...grpc.CallOption) (*CatalogResponse, error)
+	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+	GetRunStatus(ctx context.Context, in *GetRunStatusRequest, opts ...grpc.CallOption) (*RunStatusResponse, error)
+	// WatchRun streams the run's status each time its state changes, ending
+	// after the first terminal state.
+	WatchRun(ctx context.Context, in *WatchRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunStatusResponse], error)
+	BridgePending(ctx context.Context, in *BridgePendingRequest, opts ...grpc.CallOption) (*BridgePendingResponse, error)
+	BridgeComplete(ctx context.Context, in *BridgeCompleteRequest, opts ...grpc.CallOption) (*BridgeCompleteResponse, error)
+}
+
+type stepRpcServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStepRpcServiceClient(cc grpc.ClientConnInterface) StepRpcServiceClient {
+	return &stepRpcServiceClient{cc}
+}
+
+func (c *stepRpcServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepRpcServiceClient) Catalog(ctx context.Context, in *CatalogRequest, opts ...grpc.CallOption) (*CatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_Catalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepRpcServiceClient) Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvokeResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_Invoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepRpcServiceClient) GetRunStatus(ctx context.Context, in *GetRunStatusRequest, opts ...grpc.CallOption) (*RunStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunStatusResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_GetRunStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepRpcServiceClient) WatchRun(ctx context.Context, in *WatchRunRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunStatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StepRpcService_ServiceDesc.Streams[0], StepRpcService_WatchRun_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRunRequest, RunStatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StepRpcService_WatchRunClient = grpc.ServerStreamingClient[RunStatusResponse]
+
+func (c *stepRpcServiceClient) BridgePending(ctx context.Context, in *BridgePendingRequest, opts ...grpc.CallOption) (*BridgePendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BridgePendingResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_BridgePending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepRpcServiceClient) BridgeComplete(ctx context.Context, in *BridgeCompleteRequest, opts ...grpc.CallOption) (*BridgeCompleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BridgeCompleteResponse)
+	err := c.cc.Invoke(ctx, StepRpcService_BridgeComplete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StepRpcServiceServer is the server API for StepRpcService service.
+// All implementations must embed UnimplementedStepRpcServiceServer
+// for forward compatibility.
+//
+// StepRpcService is the gRPC facade over the plugin's HTTP API. Responses reuse
+// the HTTP JSON messages so a gateway can pass them through unchanged.
+//
+// Failures are reported as gRPC status codes. When the plugin returned a
+// structured error, the status details carry the steprpc.v1.Error.
+type StepRpcServiceServer interface {
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	Catalog(context.Context, *CatalogRequest) (*CatalogResponse, error)
+	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
+	GetRunStatus(context.Context, *GetRunStatusRequest) (*RunStatusResponse, error)
+	// WatchRun streams the run's status each time its state changes, ending
+	// after the first terminal state.
+	WatchRun(*WatchRunRequest, grpc.ServerStreamingServer[RunStatusResponse]) error
+	BridgePending(context.Context, *BridgePendingRequest) (*BridgePendingResponse, error)
+	BridgeComplete(context.Context, *BridgeCompleteRequest) (*BridgeCompleteResponse, error)
+	mustEmbedUnimplementedStepRpcServiceServer()
+}
+
+// UnimplementedStepRpcServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStepRpcServiceServer struct{}
+
+func (UnimplementedStepRpcServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedStepRpcServiceServer) Catalog(context.Context, *CatalogRequest) (*CatalogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Catalog not implemented")
+}
+func (UnimplementedStepRpcServiceServer) Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedStepRpcServiceServer) GetRunStatus(context.Context, *GetRunStatusRequest) (*RunStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRunStatus not implemented")
+}
+func (UnimplementedStepRpcServiceServer) WatchRun(*WatchRunRequest, grpc.ServerStreamingServer[RunStatusResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchRun not implemented")
+}
+func (UnimplementedStepRpcServiceServer) BridgePending(context.Context, *BridgePendingRequest) (*BridgePendingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BridgePending not implemented")
+}
+func (UnimplementedStepRpcServiceServer) BridgeComplete(context.Context, *BridgeCompleteRequest) (*BridgeCompleteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BridgeComplete not implemented")
+}
+func (UnimplementedStepRpcServiceServer) mustEmbedUnimplementedStepRpcServiceServer() {}
+func (UnimplementedStepRpcServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeStepRpcServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StepRpcServiceServer will
+// result in compilation errors.
+type UnsafeStepRpcServiceServer interface {
+	mustEmbedUnimplementedStepRpcServiceServer()
+}
+
+func RegisterStepRpcServiceServer(s grpc.ServiceRegistrar, srv StepRpcServiceServer) {
+	// If the following call panics, it indicates UnimplementedStepRpcServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StepRpcService_ServiceDesc, srv)
+}
+
+func _StepRpcService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepRpcService_Catalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).Catalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_Catalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).Catalog(ctx, req.(*CatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepRpcService_Invoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).Invoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_Invoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).Invoke(ctx, req.(*InvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepRpcService_GetRunStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).GetRunStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_GetRunStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).GetRunStatus(ctx, req.(*GetRunStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepRpcService_WatchRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StepRpcServiceServer).WatchRun(m, &grpc.GenericServerStream[WatchRunRequest, RunStatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StepRpcService_WatchRunServer = grpc.ServerStreamingServer[RunStatusResponse]
+
+func _StepRpcService_BridgePending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgePendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).BridgePending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_BridgePending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).BridgePending(ctx, req.(*BridgePendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepRpcService_BridgeComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeCompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepRpcServiceServer).BridgeComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepRpcService_BridgeComplete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepRpcServiceServer).BridgeComplete(ctx, req.(*BridgeCompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StepRpcService_ServiceDesc is the grpc.ServiceDesc for StepRpcService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StepRpcService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "steprpc.v1.StepRpcService",
+	HandlerType: (*StepRpcServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _StepRpcService_Health_Handler,
+		},
+		{
+			MethodName: "Catalog",
+			Handler:    _StepRpcService_Catalog_Handler,
+		},
+		{
+			MethodName: "Invoke",
+			Handler:    _StepRpcService_Invoke_Handler,
+		},
+		{
+			MethodName: "GetRunStatus",
+			Handler:    _StepRpcService_GetRunStatus_Handler,
+		},
+		{
+			MethodName: "BridgePending",
+			Handler:    _StepRpcService_BridgePending_Handler,
+		},
+		{
+			MethodName: "BridgeComplete",
+			Handler:    _StepRpcService_BridgeComplete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRun",
+			Handler:       _StepRpcService_WatchRun_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/steprpc/v1/service.proto",
+}
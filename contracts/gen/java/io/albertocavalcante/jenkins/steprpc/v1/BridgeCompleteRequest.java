@@ -29,6 +29,8 @@ private static final long serialVersionUID = 0L;
   private BridgeCompleteRequest() {
     runId_ = "";
     state_ = "";
+    runState_ = 0;
+    leaseId_ = "";
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -149,6 +151,114 @@ private static final long serialVersionUID = 0L;
     return error_ == null ? io.albertocavalcante.jenkins.steprpc.v1.Error.getDefaultInstance() : error_;
   }
 
+  public static final int RUN_STATE_FIELD_NUMBER = 4;
+  private int runState_ = 0;
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  @java.lang.Override public int getRunStateValue() {
+    return runState_;
+  }
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  @java.lang.Override public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+    io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+    return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+  }
+
+  public static final int LEASE_ID_FIELD_NUMBER = 5;
+  @SuppressWarnings("serial")
+  private volatile java.lang.Object leaseId_ = "";
+  /**
+   * <pre>
+   * Lease from BridgeClaimResponse. Completing a leased request requires the
+   * current, unexpired lease; only cancellation may omit it.
+   * </pre>
+   *
+   * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+   * @return The leaseId.
+   */
+  @java.lang.Override
+  public java.lang.String getLeaseId() {
+    java.lang.Object ref = leaseId_;
+    if (ref instanceof java.lang.String) {
+      return (java.lang.String) ref;
+    } else {
+      com.google.protobuf.ByteString bs = 
+          (com.google.protobuf.ByteString) ref;
+      java.lang.String s = bs.toStringUtf8();
+      leaseId_ = s;
+      return s;
+    }
+  }
+  /**
+   * <pre>
+   * Lease from BridgeClaimResponse. Completing a leased request requires the
+   * current, unexpired lease; only cancellation may omit it.
+   * </pre>
+   *
+   * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+   * @return The bytes for leaseId.
+   */
+  @java.lang.Override
+  public com.google.protobuf.ByteString
+      getLeaseIdBytes() {
+    java.lang.Object ref = leaseId_;
+    if (ref instanceof java.lang.String) {
+      com.google.protobuf.ByteString b = 
+          com.google.protobuf.ByteString.copyFromUtf8(
+              (java.lang.String) ref);
+      leaseId_ = b;
+      return b;
+    } else {
+      return (com.google.protobuf.ByteString) ref;
+    }
+  }
+
+  public static final int RESULT_FIELD_NUMBER = 6;
+  private com.google.protobuf.Struct result_;
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   * @return Whether the result field is set.
+   */
+  @java.lang.Override
+  public boolean hasResult() {
+    return ((bitField0_ & 0x00000002) != 0);
+  }
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   * @return The result.
+   */
+  @java.lang.Override
+  public com.google.protobuf.Struct getResult() {
+    return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+  }
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   */
+  @java.lang.Override
+  public com.google.protobuf.StructOrBuilder getResultOrBuilder() {
+    return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -172,6 +282,15 @@ private static final long serialVersionUID = 0L;
     if (((bitField0_ & 0x00000001) != 0)) {
       output.writeMessage(3, getError());
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      output.writeEnum(4, runState_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(leaseId_)) {
+      com.google.protobuf.GeneratedMessage.writeString(output, 5, leaseId_);
+    }
+    if (((bitField0_ & 0x00000002) != 0)) {
+      output.writeMessage(6, getResult());
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -191,6 +310,17 @@ private static final long serialVersionUID = 0L;
       size += com.google.protobuf.CodedOutputStream
         .computeMessageSize(3, getError());
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeEnumSize(4, runState_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(leaseId_)) {
+      size += com.google.protobuf.GeneratedMessage.computeStringSize(5, leaseId_);
+    }
+    if (((bitField0_ & 0x00000002) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(6, getResult());
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -215,6 +345,14 @@ private static final long serialVersionUID = 0L;
       if (!getError()
           .equals(other.getError())) return false;
     }
+    if (runState_ != other.runState_) return false;
+    if (!getLeaseId()
+        .equals(other.getLeaseId())) return false;
+    if (hasResult() != other.hasResult()) return false;
+    if (hasResult()) {
+      if (!getResult()
+          .equals(other.getResult())) return false;
+    }
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -234,6 +372,14 @@ private static final long serialVersionUID = 0L;
       hash = (37 * hash) + ERROR_FIELD_NUMBER;
       hash = (53 * hash) + getError().hashCode();
     }
+    hash = (37 * hash) + RUN_STATE_FIELD_NUMBER;
+    hash = (53 * hash) + runState_;
+    hash = (37 * hash) + LEASE_ID_FIELD_NUMBER;
+    hash = (53 * hash) + getLeaseId().hashCode();
+    if (hasResult()) {
+      hash = (37 * hash) + RESULT_FIELD_NUMBER;
+      hash = (53 * hash) + getResult().hashCode();
+    }
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -365,6 +511,7 @@ private static final long serialVersionUID = 0L;
       if (com.google.protobuf.GeneratedMessage
               .alwaysUseFieldBuilders) {
         getErrorFieldBuilder();
+        getResultFieldBuilder();
       }
     }
     @java.lang.Override
@@ -378,6 +525,13 @@ private static final long serialVersionUID = 0L;
         errorBuilder_.dispose();
         errorBuilder_ = null;
       }
+      runState_ = 0;
+      leaseId_ = "";
+      result_ = null;
+      if (resultBuilder_ != null) {
+        resultBuilder_.dispose();
+        resultBuilder_ = null;
+      }
       return this;
     }
 
@@ -424,6 +578,18 @@ private static final long serialVersionUID = 0L;
             : errorBuilder_.build();
         to_bitField0_ |= 0x00000001;
       }
+      if (((from_bitField0_ & 0x00000008) != 0)) {
+        result.runState_ = runState_;
+      }
+      if (((from_bitField0_ & 0x00000010) != 0)) {
+        result.leaseId_ = leaseId_;
+      }
+      if (((from_bitField0_ & 0x00000020) != 0)) {
+        result.result_ = resultBuilder_ == null
+            ? result_
+            : resultBuilder_.build();
+        to_bitField0_ |= 0x00000002;
+      }
       result.bitField0_ |= to_bitField0_;
     }
 
@@ -452,6 +618,17 @@ private static final long serialVersionUID = 0L;
       if (other.hasError()) {
         mergeError(other.getError());
       }
+      if (other.runState_ != 0) {
+        setRunStateValue(other.getRunStateValue());
+      }
+      if (!other.getLeaseId().isEmpty()) {
+        leaseId_ = other.leaseId_;
+        bitField0_ |= 0x00000010;
+        onChanged();
+      }
+      if (other.hasResult()) {
+        mergeResult(other.getResult());
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -495,6 +672,23 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000004;
               break;
             } // case 26
+            case 32: {
+              runState_ = input.readEnum();
+              bitField0_ |= 0x00000008;
+              break;
+            } // case 32
+            case 42: {
+              leaseId_ = input.readStringRequireUtf8();
+              bitField0_ |= 0x00000010;
+              break;
+            } // case 42
+            case 50: {
+              input.readMessage(
+                  getResultFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00000020;
+              break;
+            } // case 50
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -777,6 +971,322 @@ private static final long serialVersionUID = 0L;
       return errorBuilder_;
     }
 
+    private int runState_ = 0;
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return The enum numeric value on the wire for runState.
+     */
+    @java.lang.Override public int getRunStateValue() {
+      return runState_;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @param value The enum numeric value on the wire for runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunStateValue(int value) {
+      runState_ = value;
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return The runState.
+     */
+    @java.lang.Override
+    public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+      io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+      return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @param value The runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunState(io.albertocavalcante.jenkins.steprpc.v1.RunState value) {
+      if (value == null) {
+        throw new NullPointerException();
+      }
+      bitField0_ |= 0x00000008;
+      runState_ = value.getNumber();
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearRunState() {
+      bitField0_ = (bitField0_ & ~0x00000008);
+      runState_ = 0;
+      onChanged();
+      return this;
+    }
+
+    private java.lang.Object leaseId_ = "";
+    /**
+     * <pre>
+     * Lease from BridgeClaimResponse. Completing a leased request requires the
+     * current, unexpired lease; only cancellation may omit it.
+     * </pre>
+     *
+     * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+     * @return The leaseId.
+     */
+    public java.lang.String getLeaseId() {
+      java.lang.Object ref = leaseId_;
+      if (!(ref instanceof java.lang.String)) {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        leaseId_ = s;
+        return s;
+      } else {
+        return (java.lang.String) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Lease from BridgeClaimResponse. Completing a leased request requires the
+     * current, unexpired lease; only cancellation may omit it.
+     * </pre>
+     *
+     * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+     * @return The bytes for leaseId.
+     */
+    public com.google.protobuf.ByteString
+        getLeaseIdBytes() {
+      java.lang.Object ref = leaseId_;
+      if (ref instanceof String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        leaseId_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Lease from BridgeClaimResponse. Completing a leased request requires the
+     * current, unexpired lease; only cancellation may omit it.
+     * </pre>
+     *
+     * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+     * @param value The leaseId to set.
+     * @return This builder for chaining.
+     */
+    public Builder setLeaseId(
+        java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      leaseId_ = value;
+      bitField0_ |= 0x00000010;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Lease from BridgeClaimResponse. Completing a leased request requires the
+     * current, unexpired lease; only cancellation may omit it.
+     * </pre>
+     *
+     * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearLeaseId() {
+      leaseId_ = getDefaultInstance().getLeaseId();
+      bitField0_ = (bitField0_ & ~0x00000010);
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Lease from BridgeClaimResponse. Completing a leased request requires the
+     * current, unexpired lease; only cancellation may omit it.
+     * </pre>
+     *
+     * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+     * @param value The bytes for leaseId to set.
+     * @return This builder for chaining.
+     */
+    public Builder setLeaseIdBytes(
+        com.google.protobuf.ByteString value) {
+      if (value == null) { throw new NullPointerException(); }
+      checkByteStringIsUtf8(value);
+      leaseId_ = value;
+      bitField0_ |= 0x00000010;
+      onChanged();
+      return this;
+    }
+
+    private com.google.protobuf.Struct result_;
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder> resultBuilder_;
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     * @return Whether the result field is set.
+     */
+    public boolean hasResult() {
+      return ((bitField0_ & 0x00000020) != 0);
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     * @return The result.
+     */
+    public com.google.protobuf.Struct getResult() {
+      if (resultBuilder_ == null) {
+        return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+      } else {
+        return resultBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public Builder setResult(com.google.protobuf.Struct value) {
+      if (resultBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        result_ = value;
+      } else {
+        resultBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00000020;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public Builder setResult(
+        com.google.protobuf.Struct.Builder builderForValue) {
+      if (resultBuilder_ == null) {
+        result_ = builderForValue.build();
+      } else {
+        resultBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00000020;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public Builder mergeResult(com.google.protobuf.Struct value) {
+      if (resultBuilder_ == null) {
+        if (((bitField0_ & 0x00000020) != 0) &&
+          result_ != null &&
+          result_ != com.google.protobuf.Struct.getDefaultInstance()) {
+          getResultBuilder().mergeFrom(value);
+        } else {
+          result_ = value;
+        }
+      } else {
+        resultBuilder_.mergeFrom(value);
+      }
+      if (result_ != null) {
+        bitField0_ |= 0x00000020;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public Builder clearResult() {
+      bitField0_ = (bitField0_ & ~0x00000020);
+      result_ = null;
+      if (resultBuilder_ != null) {
+        resultBuilder_.dispose();
+        resultBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public com.google.protobuf.Struct.Builder getResultBuilder() {
+      bitField0_ |= 0x00000020;
+      onChanged();
+      return getResultFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    public com.google.protobuf.StructOrBuilder getResultOrBuilder() {
+      if (resultBuilder_ != null) {
+        return resultBuilder_.getMessageOrBuilder();
+      } else {
+        return result_ == null ?
+            com.google.protobuf.Struct.getDefaultInstance() : result_;
+      }
+    }
+    /**
+     * <pre>
+     * Value the operation returned, such as readFile's content. Served to the
+     * caller as RunStatusResponse.result and RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder> 
+        getResultFieldBuilder() {
+      if (resultBuilder_ == null) {
+        resultBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder>(
+                getResult(),
+                getParentForChildren(),
+                isClean());
+        result_ = null;
+      }
+      return resultBuilder_;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.BridgeCompleteRequest)
   }
 
@@ -47,4 +47,67 @@ public interface BridgeCompleteRequestOrBuilder extends
    * <code>.steprpc.v1.Error error = 3 [json_name = "error"];</code>
    */
   io.albertocavalcante.jenkins.steprpc.v1.ErrorOrBuilder getErrorOrBuilder();
+
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  int getRunStateValue();
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState();
+
+  /**
+   * <pre>
+   * Lease from BridgeClaimResponse. Completing a leased request requires the
+   * current, unexpired lease; only cancellation may omit it.
+   * </pre>
+   *
+   * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+   * @return The leaseId.
+   */
+  java.lang.String getLeaseId();
+  /**
+   * <pre>
+   * Lease from BridgeClaimResponse. Completing a leased request requires the
+   * current, unexpired lease; only cancellation may omit it.
+   * </pre>
+   *
+   * <code>string lease_id = 5 [json_name = "leaseId"];</code>
+   * @return The bytes for leaseId.
+   */
+  com.google.protobuf.ByteString
+      getLeaseIdBytes();
+
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   * @return Whether the result field is set.
+   */
+  boolean hasResult();
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   * @return The result.
+   */
+  com.google.protobuf.Struct getResult();
+  /**
+   * <pre>
+   * Value the operation returned, such as readFile's content. Served to the
+   * caller as RunStatusResponse.result and RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 6 [json_name = "result"];</code>
+   */
+  com.google.protobuf.StructOrBuilder getResultOrBuilder();
 }
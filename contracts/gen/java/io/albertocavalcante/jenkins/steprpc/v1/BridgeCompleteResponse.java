@@ -30,6 +30,7 @@ private static final long serialVersionUID = 0L;
     requestId_ = "";
     runId_ = "";
     state_ = "";
+    runState_ = 0;
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -162,6 +163,24 @@ private static final long serialVersionUID = 0L;
     }
   }
 
+  public static final int RUN_STATE_FIELD_NUMBER = 4;
+  private int runState_ = 0;
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  @java.lang.Override public int getRunStateValue() {
+    return runState_;
+  }
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  @java.lang.Override public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+    io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+    return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -185,6 +204,9 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(state_)) {
       com.google.protobuf.GeneratedMessage.writeString(output, 3, state_);
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      output.writeEnum(4, runState_);
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -203,6 +225,10 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(state_)) {
       size += com.google.protobuf.GeneratedMessage.computeStringSize(3, state_);
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeEnumSize(4, runState_);
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -224,6 +250,7 @@ private static final long serialVersionUID = 0L;
         .equals(other.getRunId())) return false;
     if (!getState()
         .equals(other.getState())) return false;
+    if (runState_ != other.runState_) return false;
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -241,6 +268,8 @@ private static final long serialVersionUID = 0L;
     hash = (53 * hash) + getRunId().hashCode();
     hash = (37 * hash) + STATE_FIELD_NUMBER;
     hash = (53 * hash) + getState().hashCode();
+    hash = (37 * hash) + RUN_STATE_FIELD_NUMBER;
+    hash = (53 * hash) + runState_;
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -375,6 +404,7 @@ private static final long serialVersionUID = 0L;
       requestId_ = "";
       runId_ = "";
       state_ = "";
+      runState_ = 0;
       return this;
     }
 
@@ -417,6 +447,9 @@ private static final long serialVersionUID = 0L;
       if (((from_bitField0_ & 0x00000004) != 0)) {
         result.state_ = state_;
       }
+      if (((from_bitField0_ & 0x00000008) != 0)) {
+        result.runState_ = runState_;
+      }
     }
 
     @java.lang.Override
@@ -446,6 +479,9 @@ private static final long serialVersionUID = 0L;
         bitField0_ |= 0x00000004;
         onChanged();
       }
+      if (other.runState_ != 0) {
+        setRunStateValue(other.getRunStateValue());
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -487,6 +523,11 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000004;
               break;
             } // case 26
+            case 32: {
+              runState_ = input.readEnum();
+              bitField0_ |= 0x00000008;
+              break;
+            } // case 32
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -720,6 +761,59 @@ private static final long serialVersionUID = 0L;
       return this;
     }
 
+    private int runState_ = 0;
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return The enum numeric value on the wire for runState.
+     */
+    @java.lang.Override public int getRunStateValue() {
+      return runState_;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @param value The enum numeric value on the wire for runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunStateValue(int value) {
+      runState_ = value;
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return The runState.
+     */
+    @java.lang.Override
+    public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+      io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+      return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @param value The runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunState(io.albertocavalcante.jenkins.steprpc.v1.RunState value) {
+      if (value == null) {
+        throw new NullPointerException();
+      }
+      bitField0_ |= 0x00000008;
+      runState_ = value.getNumber();
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearRunState() {
+      bitField0_ = (bitField0_ & ~0x00000008);
+      runState_ = 0;
+      onChanged();
+      return this;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.BridgeCompleteResponse)
   }
 
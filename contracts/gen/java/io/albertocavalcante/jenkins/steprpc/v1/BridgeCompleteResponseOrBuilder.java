@@ -44,4 +44,15 @@ public interface BridgeCompleteResponseOrBuilder extends
    */
   com.google.protobuf.ByteString
       getStateBytes();
+
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  int getRunStateValue();
+  /**
+   * <code>.steprpc.v1.RunState run_state = 4 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState();
 }
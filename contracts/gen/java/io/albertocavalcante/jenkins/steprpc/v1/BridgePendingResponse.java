@@ -6,6 +6,18 @@
 package io.albertocavalcante.jenkins.steprpc.v1;
 
 /**
+ * <pre>
+ * Next pending request for a run (GET /step-rpc/v1/bridge/pending). The
+ * optional workerId query parameter names the fetching worker in
+ * RunStatusResponse.worker_id; it defaults to the authenticated user.
+ *
+ * The optional waitSeconds query parameter makes the call a long poll: the
+ * server holds it until a request is pending or the wait passes (capped at 30s
+ * by the plugin) and then answers 204 No Content. Without it, nothing pending
+ * is a 404 no_pending_request. Servers that predate waitSeconds ignore it and
+ * answer immediately.
+ * </pre>
+ *
  * Protobuf type {@code steprpc.v1.BridgePendingResponse}
  */
 public final class BridgePendingResponse extends
@@ -432,6 +444,18 @@ private static final long serialVersionUID = 0L;
     return builder;
   }
   /**
+   * <pre>
+   * Next pending request for a run (GET /step-rpc/v1/bridge/pending). The
+   * optional workerId query parameter names the fetching worker in
+   * RunStatusResponse.worker_id; it defaults to the authenticated user.
+   *
+   * The optional waitSeconds query parameter makes the call a long poll: the
+   * server holds it until a request is pending or the wait passes (capped at 30s
+   * by the plugin) and then answers 204 No Content. Without it, nothing pending
+   * is a 404 no_pending_request. Servers that predate waitSeconds ignore it and
+   * answer immediately.
+   * </pre>
+   *
    * Protobuf type {@code steprpc.v1.BridgePendingResponse}
    */
   public static final class Builder extends
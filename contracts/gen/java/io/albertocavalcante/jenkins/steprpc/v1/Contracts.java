@@ -65,11 +65,71 @@ public final class Contracts {
   static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_steprpc_v1_InvokeResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BatchInvokeRequest_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BatchInvokeRequest_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BatchInvokeResult_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BatchInvokeResult_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BatchInvokeResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BatchInvokeResponse_fieldAccessorTable;
   static final com.google.protobuf.Descriptors.Descriptor
     internal_static_steprpc_v1_BridgePendingResponse_descriptor;
   static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_steprpc_v1_BridgePendingResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeClaimRequest_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeClaimRequest_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeClaimResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeClaimResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeHeartbeatRequest_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeHeartbeatRequest_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeHeartbeatResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeHeartbeatResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeRunsResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeRunsResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeRunSummary_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeRunSummary_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeProgressRequest_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeProgressRequest_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_BridgeProgressResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_BridgeProgressResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_RunProgress_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_RunProgress_fieldAccessorTable;
   static final com.google.protobuf.Descriptors.Descriptor
     internal_static_steprpc_v1_BridgeCompleteRequest_descriptor;
   static final 
@@ -85,6 +145,16 @@ public final class Contracts {
   static final 
     com.google.protobuf.GeneratedMessage.FieldAccessorTable
       internal_static_steprpc_v1_RunStatusResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_RunResultResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_RunResultResponse_fieldAccessorTable;
+  static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_steprpc_v1_RunLogResponse_descriptor;
+  static final 
+    com.google.protobuf.GeneratedMessage.FieldAccessorTable
+      internal_static_steprpc_v1_RunLogResponse_fieldAccessorTable;
 
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
@@ -102,47 +172,116 @@ public final class Contracts {
       "r.DetailsEntryR\007details\032:\n\014DetailsEntry\022" +
       "\020\n\003key\030\001 \001(\tR\003key\022\024\n\005value\030\002 \001(\tR\005value:" +
       "\0028\001\"8\n\rErrorResponse\022\'\n\005error\030\001 \001(\0132\021.st" +
-      "eprpc.v1.ErrorR\005error\"c\n\016HealthResponse\022" +
-      "\037\n\013api_version\030\001 \001(\tR\napiVersion\022\030\n\007serv" +
-      "ice\030\002 \001(\tR\007service\022\026\n\006status\030\003 \001(\tR\006stat" +
-      "us\"\223\001\n\020CatalogOperation\022\022\n\004name\030\001 \001(\tR\004n" +
-      "ame\022 \n\013description\030\002 \001(\tR\013description\022I\n" +
-      "\016execution_mode\030\003 \001(\0162\".steprpc.v1.Opera" +
-      "tionExecutionModeR\rexecutionMode\"O\n\017Cata" +
-      "logResponse\022<\n\noperations\030\001 \003(\0132\034.steprp" +
-      "c.v1.CatalogOperationR\noperations\"\242\001\n\rIn" +
-      "vokeRequest\022\035\n\nrequest_id\030\001 \001(\tR\trequest" +
-      "Id\022\034\n\toperation\030\002 \001(\tR\toperation\022+\n\004args" +
-      "\030\003 \001(\0132\027.google.protobuf.StructR\004args\022\'\n" +
-      "\017idempotency_key\030\004 \001(\tR\016idempotencyKey\"\205" +
-      "\001\n\016InvokeResponse\022\035\n\nrequest_id\030\001 \001(\tR\tr" +
-      "equestId\022\025\n\006run_id\030\002 \001(\tR\005runId\022\024\n\005state" +
-      "\030\003 \001(\tR\005state\022\'\n\005error\030\004 \001(\0132\021.steprpc.v" +
-      "1.ErrorR\005error\"\331\001\n\025BridgePendingResponse" +
-      "\022\035\n\nrequest_id\030\001 \001(\tR\trequestId\022\025\n\006run_i" +
-      "d\030\002 \001(\tR\005runId\022\034\n\toperation\030\003 \001(\tR\topera" +
-      "tion\022+\n\004args\030\004 \001(\0132\027.google.protobuf.Str" +
-      "uctR\004args\022?\n\034target_run_externalizable_i" +
-      "d\030\005 \001(\tR\031targetRunExternalizableId\"m\n\025Br" +
-      "idgeCompleteRequest\022\025\n\006run_id\030\001 \001(\tR\005run" +
-      "Id\022\024\n\005state\030\002 \001(\tR\005state\022\'\n\005error\030\003 \001(\0132" +
-      "\021.steprpc.v1.ErrorR\005error\"d\n\026BridgeCompl" +
-      "eteResponse\022\035\n\nrequest_id\030\001 \001(\tR\trequest" +
-      "Id\022\025\n\006run_id\030\002 \001(\tR\005runId\022\024\n\005state\030\003 \001(\t" +
-      "R\005state\"\341\001\n\021RunStatusResponse\022\035\n\nrequest" +
-      "_id\030\001 \001(\tR\trequestId\022\025\n\006run_id\030\002 \001(\tR\005ru" +
-      "nId\022\034\n\toperation\030\003 \001(\tR\toperation\022\024\n\005sta" +
-      "te\030\004 \001(\tR\005state\0229\n\ncreated_at\030\005 \001(\0132\032.go" +
-      "ogle.protobuf.TimestampR\tcreatedAt\022\'\n\005er" +
-      "ror\030\006 \001(\0132\021.steprpc.v1.ErrorR\005error*\231\001\n\026" +
-      "OperationExecutionMode\022(\n$OPERATION_EXEC" +
-      "UTION_MODE_UNSPECIFIED\020\000\022#\n\037OPERATION_EX" +
-      "ECUTION_MODE_DIRECT\020\001\0220\n,OPERATION_EXECU" +
-      "TION_MODE_CPS_BRIDGE_REQUIRED\020\002B\201\001\n\'io.a" +
-      "lbertocavalcante.jenkins.steprpc.v1P\001ZTg" +
-      "ithub.com/albertocavalcante/jenkins-rpc/" +
-      "contracts/gen/go/proto/steprpc/v1;steprp" +
-      "cv1b\006proto3"
+      "eprpc.v1.ErrorR\005error\"\207\001\n\016HealthResponse" +
+      "\022\037\n\013api_version\030\001 \001(\tR\napiVersion\022\030\n\007ser" +
+      "vice\030\002 \001(\tR\007service\022\026\n\006status\030\003 \001(\tR\006sta" +
+      "tus\022\"\n\014capabilities\030\004 \003(\tR\014capabilities\"" +
+      "\223\001\n\020CatalogOperation\022\022\n\004name\030\001 \001(\tR\004name" +
+      "\022 \n\013description\030\002 \001(\tR\013description\022I\n\016ex" +
+      "ecution_mode\030\003 \001(\0162\".steprpc.v1.Operatio" +
+      "nExecutionModeR\rexecutionMode\"O\n\017Catalog" +
+      "Response\022<\n\noperations\030\001 \003(\0132\034.steprpc.v" +
+      "1.CatalogOperationR\noperations\"\356\001\n\rInvok" +
+      "eRequest\022\035\n\nrequest_id\030\001 \001(\tR\trequestId\022" +
+      "\034\n\toperation\030\002 \001(\tR\toperation\022+\n\004args\030\003 " +
+      "\001(\0132\027.google.protobuf.StructR\004args\022\'\n\017id" +
+      "empotency_key\030\004 \001(\tR\016idempotencyKey\022!\n\014c" +
+      "allback_url\030\005 \001(\tR\013callbackUrl\022\'\n\017callba" +
+      "ck_secret\030\006 \001(\tR\016callbackSecret\"\270\001\n\016Invo" +
+      "keResponse\022\035\n\nrequest_id\030\001 \001(\tR\trequestI" +
+      "d\022\025\n\006run_id\030\002 \001(\tR\005runId\022\024\n\005state\030\003 \001(\tR" +
+      "\005state\022\'\n\005error\030\004 \001(\0132\021.steprpc.v1.Error" +
+      "R\005error\0221\n\trun_state\030\005 \001(\0162\024.steprpc.v1." +
+      "RunStateR\010runState\"K\n\022BatchInvokeRequest" +
+      "\0225\n\010requests\030\001 \003(\0132\031.steprpc.v1.InvokeRe" +
+      "questR\010requests\"\214\001\n\021BatchInvokeResult\0226\n" +
+      "\010response\030\001 \001(\0132\032.steprpc.v1.InvokeRespo" +
+      "nseR\010response\022\'\n\005error\030\002 \001(\0132\021.steprpc.v" +
+      "1.ErrorR\005error\022\026\n\006status\030\003 \001(\005R\006status\"N" +
+      "\n\023BatchInvokeResponse\0227\n\007results\030\001 \003(\0132\035" +
+      ".steprpc.v1.BatchInvokeResultR\007results\"\331" +
+      "\001\n\025BridgePendingResponse\022\035\n\nrequest_id\030\001" +
+      " \001(\tR\trequestId\022\025\n\006run_id\030\002 \001(\tR\005runId\022\034" +
+      "\n\toperation\030\003 \001(\tR\toperation\022+\n\004args\030\004 \001" +
+      "(\0132\027.google.protobuf.StructR\004args\022?\n\034tar" +
+      "get_run_externalizable_id\030\005 \001(\tR\031targetR" +
+      "unExternalizableId\"\212\001\n\022BridgeClaimReques" +
+      "t\0222\n\025run_externalizable_id\030\001 \001(\tR\023runExt" +
+      "ernalizableId\022\033\n\tworker_id\030\002 \001(\tR\010worker" +
+      "Id\022#\n\rlease_seconds\030\003 \001(\005R\014leaseSeconds\"" +
+      "\315\001\n\023BridgeClaimResponse\022;\n\007request\030\001 \001(\013" +
+      "2!.steprpc.v1.BridgePendingResponseR\007req" +
+      "uest\022\031\n\010lease_id\030\002 \001(\tR\007leaseId\022D\n\020lease" +
+      "_expires_at\030\003 \001(\0132\032.google.protobuf.Time" +
+      "stampR\016leaseExpiresAt\022\030\n\007attempt\030\004 \001(\005R\007" +
+      "attempt\"o\n\026BridgeHeartbeatRequest\022\025\n\006run" +
+      "_id\030\001 \001(\tR\005runId\022\031\n\010lease_id\030\002 \001(\tR\007leas" +
+      "eId\022#\n\rlease_seconds\030\003 \001(\005R\014leaseSeconds" +
+      "\"\221\001\n\027BridgeHeartbeatResponse\022\025\n\006run_id\030\001" +
+      " \001(\tR\005runId\022\031\n\010lease_id\030\002 \001(\tR\007leaseId\022D" +
+      "\n\020lease_expires_at\030\003 \001(\0132\032.google.protob" +
+      "uf.TimestampR\016leaseExpiresAt\"F\n\022BridgeRu" +
+      "nsResponse\0220\n\004runs\030\001 \003(\0132\034.steprpc.v1.Br" +
+      "idgeRunSummaryR\004runs\"x\n\020BridgeRunSummary" +
+      "\0222\n\025run_externalizable_id\030\001 \001(\tR\023runExte" +
+      "rnalizableId\022\030\n\007pending\030\002 \001(\005R\007pending\022\026" +
+      "\n\006leased\030\003 \001(\005R\006leased\"}\n\025BridgeProgress" +
+      "Request\022\025\n\006run_id\030\001 \001(\tR\005runId\022\031\n\010lease_" +
+      "id\030\002 \001(\tR\007leaseId\022\030\n\007percent\030\003 \001(\005R\007perc" +
+      "ent\022\030\n\007message\030\004 \001(\tR\007message\"d\n\026BridgeP" +
+      "rogressResponse\022\025\n\006run_id\030\001 \001(\tR\005runId\0223" +
+      "\n\010progress\030\002 \001(\0132\027.steprpc.v1.RunProgres" +
+      "sR\010progress\"|\n\013RunProgress\022\030\n\007percent\030\001 " +
+      "\001(\005R\007percent\022\030\n\007message\030\002 \001(\tR\007message\0229" +
+      "\n\nupdated_at\030\003 \001(\0132\032.google.protobuf.Tim" +
+      "estampR\tupdatedAt\"\354\001\n\025BridgeCompleteRequ" +
+      "est\022\025\n\006run_id\030\001 \001(\tR\005runId\022\024\n\005state\030\002 \001(" +
+      "\tR\005state\022\'\n\005error\030\003 \001(\0132\021.steprpc.v1.Err" +
+      "orR\005error\0221\n\trun_state\030\004 \001(\0162\024.steprpc.v" +
+      "1.RunStateR\010runState\022\031\n\010lease_id\030\005 \001(\tR\007" +
+      "leaseId\022/\n\006result\030\006 \001(\0132\027.google.protobu" +
+      "f.StructR\006result\"\227\001\n\026BridgeCompleteRespo" +
+      "nse\022\035\n\nrequest_id\030\001 \001(\tR\trequestId\022\025\n\006ru" +
+      "n_id\030\002 \001(\tR\005runId\022\024\n\005state\030\003 \001(\tR\005state\022" +
+      "1\n\trun_state\030\004 \001(\0162\024.steprpc.v1.RunState" +
+      "R\010runState\"\263\005\n\021RunStatusResponse\022\035\n\nrequ" +
+      "est_id\030\001 \001(\tR\trequestId\022\025\n\006run_id\030\002 \001(\tR" +
+      "\005runId\022\034\n\toperation\030\003 \001(\tR\toperation\022\024\n\005" +
+      "state\030\004 \001(\tR\005state\0229\n\ncreated_at\030\005 \001(\0132\032" +
+      ".google.protobuf.TimestampR\tcreatedAt\022\'\n" +
+      "\005error\030\006 \001(\0132\021.steprpc.v1.ErrorR\005error\0229" +
+      "\n\nstarted_at\030\007 \001(\0132\032.google.protobuf.Tim" +
+      "estampR\tstartedAt\0229\n\nupdated_at\030\010 \001(\0132\032." +
+      "google.protobuf.TimestampR\tupdatedAt\022=\n\014" +
+      "completed_at\030\t \001(\0132\032.google.protobuf.Tim" +
+      "estampR\013completedAt\022\032\n\010attempts\030\n \001(\005R\010a" +
+      "ttempts\022I\n\016execution_mode\030\013 \001(\0162\".steprp" +
+      "c.v1.OperationExecutionModeR\rexecutionMo" +
+      "de\022\033\n\tworker_id\030\014 \001(\tR\010workerId\0221\n\trun_s" +
+      "tate\030\r \001(\0162\024.steprpc.v1.RunStateR\010runSta" +
+      "te\0223\n\010progress\030\016 \001(\0132\027.steprpc.v1.RunPro" +
+      "gressR\010progress\022/\n\006result\030\017 \001(\0132\027.google" +
+      ".protobuf.StructR\006result\"\315\001\n\021RunResultRe" +
+      "sponse\022\025\n\006run_id\030\001 \001(\tR\005runId\022\024\n\005state\030\002" +
+      " \001(\tR\005state\022/\n\006result\030\003 \001(\0132\027.google.pro" +
+      "tobuf.StructR\006result\022\'\n\005error\030\004 \001(\0132\021.st" +
+      "eprpc.v1.ErrorR\005error\0221\n\trun_state\030\005 \001(\016" +
+      "2\024.steprpc.v1.RunStateR\010runState\"\220\001\n\016Run" +
+      "LogResponse\022\025\n\006run_id\030\001 \001(\tR\005runId\022\026\n\006of" +
+      "fset\030\002 \001(\003R\006offset\022\022\n\004text\030\003 \001(\tR\004text\022\037" +
+      "\n\013next_offset\030\004 \001(\003R\nnextOffset\022\032\n\010compl" +
+      "ete\030\005 \001(\010R\010complete*\231\001\n\026OperationExecuti" +
+      "onMode\022(\n$OPERATION_EXECUTION_MODE_UNSPE" +
+      "CIFIED\020\000\022#\n\037OPERATION_EXECUTION_MODE_DIR" +
+      "ECT\020\001\0220\n,OPERATION_EXECUTION_MODE_CPS_BR" +
+      "IDGE_REQUIRED\020\002*\232\001\n\010RunState\022\031\n\025RUN_STAT" +
+      "E_UNSPECIFIED\020\000\022\024\n\020RUN_STATE_QUEUED\020\001\022\025\n" +
+      "\021RUN_STATE_RUNNING\020\002\022\027\n\023RUN_STATE_SUCCEE" +
+      "DED\020\003\022\024\n\020RUN_STATE_FAILED\020\004\022\027\n\023RUN_STATE" +
+      "_CANCELLED\020\005B\201\001\n\'io.albertocavalcante.je" +
+      "nkins.steprpc.v1P\001ZTgithub.com/albertoca" +
+      "valcante/jenkins-rpc/contracts/gen/go/pr" +
+      "oto/steprpc/v1;steprpcv1b\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -173,7 +312,7 @@ public final class Contracts {
     internal_static_steprpc_v1_HealthResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_HealthResponse_descriptor,
-        new java.lang.String[] { "ApiVersion", "Service", "Status", });
+        new java.lang.String[] { "ApiVersion", "Service", "Status", "Capabilities", });
     internal_static_steprpc_v1_CatalogOperation_descriptor =
       getDescriptor().getMessageTypes().get(3);
     internal_static_steprpc_v1_CatalogOperation_fieldAccessorTable = new
@@ -191,37 +330,121 @@ public final class Contracts {
     internal_static_steprpc_v1_InvokeRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_InvokeRequest_descriptor,
-        new java.lang.String[] { "RequestId", "Operation", "Args", "IdempotencyKey", });
+        new java.lang.String[] { "RequestId", "Operation", "Args", "IdempotencyKey", "CallbackUrl", "CallbackSecret", });
     internal_static_steprpc_v1_InvokeResponse_descriptor =
       getDescriptor().getMessageTypes().get(6);
     internal_static_steprpc_v1_InvokeResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_InvokeResponse_descriptor,
-        new java.lang.String[] { "RequestId", "RunId", "State", "Error", });
-    internal_static_steprpc_v1_BridgePendingResponse_descriptor =
+        new java.lang.String[] { "RequestId", "RunId", "State", "Error", "RunState", });
+    internal_static_steprpc_v1_BatchInvokeRequest_descriptor =
       getDescriptor().getMessageTypes().get(7);
+    internal_static_steprpc_v1_BatchInvokeRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BatchInvokeRequest_descriptor,
+        new java.lang.String[] { "Requests", });
+    internal_static_steprpc_v1_BatchInvokeResult_descriptor =
+      getDescriptor().getMessageTypes().get(8);
+    internal_static_steprpc_v1_BatchInvokeResult_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BatchInvokeResult_descriptor,
+        new java.lang.String[] { "Response", "Error", "Status", });
+    internal_static_steprpc_v1_BatchInvokeResponse_descriptor =
+      getDescriptor().getMessageTypes().get(9);
+    internal_static_steprpc_v1_BatchInvokeResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BatchInvokeResponse_descriptor,
+        new java.lang.String[] { "Results", });
+    internal_static_steprpc_v1_BridgePendingResponse_descriptor =
+      getDescriptor().getMessageTypes().get(10);
     internal_static_steprpc_v1_BridgePendingResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_BridgePendingResponse_descriptor,
         new java.lang.String[] { "RequestId", "RunId", "Operation", "Args", "TargetRunExternalizableId", });
+    internal_static_steprpc_v1_BridgeClaimRequest_descriptor =
+      getDescriptor().getMessageTypes().get(11);
+    internal_static_steprpc_v1_BridgeClaimRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeClaimRequest_descriptor,
+        new java.lang.String[] { "RunExternalizableId", "WorkerId", "LeaseSeconds", });
+    internal_static_steprpc_v1_BridgeClaimResponse_descriptor =
+      getDescriptor().getMessageTypes().get(12);
+    internal_static_steprpc_v1_BridgeClaimResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeClaimResponse_descriptor,
+        new java.lang.String[] { "Request", "LeaseId", "LeaseExpiresAt", "Attempt", });
+    internal_static_steprpc_v1_BridgeHeartbeatRequest_descriptor =
+      getDescriptor().getMessageTypes().get(13);
+    internal_static_steprpc_v1_BridgeHeartbeatRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeHeartbeatRequest_descriptor,
+        new java.lang.String[] { "RunId", "LeaseId", "LeaseSeconds", });
+    internal_static_steprpc_v1_BridgeHeartbeatResponse_descriptor =
+      getDescriptor().getMessageTypes().get(14);
+    internal_static_steprpc_v1_BridgeHeartbeatResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeHeartbeatResponse_descriptor,
+        new java.lang.String[] { "RunId", "LeaseId", "LeaseExpiresAt", });
+    internal_static_steprpc_v1_BridgeRunsResponse_descriptor =
+      getDescriptor().getMessageTypes().get(15);
+    internal_static_steprpc_v1_BridgeRunsResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeRunsResponse_descriptor,
+        new java.lang.String[] { "Runs", });
+    internal_static_steprpc_v1_BridgeRunSummary_descriptor =
+      getDescriptor().getMessageTypes().get(16);
+    internal_static_steprpc_v1_BridgeRunSummary_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeRunSummary_descriptor,
+        new java.lang.String[] { "RunExternalizableId", "Pending", "Leased", });
+    internal_static_steprpc_v1_BridgeProgressRequest_descriptor =
+      getDescriptor().getMessageTypes().get(17);
+    internal_static_steprpc_v1_BridgeProgressRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeProgressRequest_descriptor,
+        new java.lang.String[] { "RunId", "LeaseId", "Percent", "Message", });
+    internal_static_steprpc_v1_BridgeProgressResponse_descriptor =
+      getDescriptor().getMessageTypes().get(18);
+    internal_static_steprpc_v1_BridgeProgressResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_BridgeProgressResponse_descriptor,
+        new java.lang.String[] { "RunId", "Progress", });
+    internal_static_steprpc_v1_RunProgress_descriptor =
+      getDescriptor().getMessageTypes().get(19);
+    internal_static_steprpc_v1_RunProgress_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_RunProgress_descriptor,
+        new java.lang.String[] { "Percent", "Message", "UpdatedAt", });
     internal_static_steprpc_v1_BridgeCompleteRequest_descriptor =
-      getDescriptor().getMessageTypes().get(8);
+      getDescriptor().getMessageTypes().get(20);
     internal_static_steprpc_v1_BridgeCompleteRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_BridgeCompleteRequest_descriptor,
-        new java.lang.String[] { "RunId", "State", "Error", });
+        new java.lang.String[] { "RunId", "State", "Error", "RunState", "LeaseId", "Result", });
     internal_static_steprpc_v1_BridgeCompleteResponse_descriptor =
-      getDescriptor().getMessageTypes().get(9);
+      getDescriptor().getMessageTypes().get(21);
     internal_static_steprpc_v1_BridgeCompleteResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_BridgeCompleteResponse_descriptor,
-        new java.lang.String[] { "RequestId", "RunId", "State", });
+        new java.lang.String[] { "RequestId", "RunId", "State", "RunState", });
     internal_static_steprpc_v1_RunStatusResponse_descriptor =
-      getDescriptor().getMessageTypes().get(10);
+      getDescriptor().getMessageTypes().get(22);
     internal_static_steprpc_v1_RunStatusResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessage.FieldAccessorTable(
         internal_static_steprpc_v1_RunStatusResponse_descriptor,
-        new java.lang.String[] { "RequestId", "RunId", "Operation", "State", "CreatedAt", "Error", });
+        new java.lang.String[] { "RequestId", "RunId", "Operation", "State", "CreatedAt", "Error", "StartedAt", "UpdatedAt", "CompletedAt", "Attempts", "ExecutionMode", "WorkerId", "RunState", "Progress", "Result", });
+    internal_static_steprpc_v1_RunResultResponse_descriptor =
+      getDescriptor().getMessageTypes().get(23);
+    internal_static_steprpc_v1_RunResultResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_RunResultResponse_descriptor,
+        new java.lang.String[] { "RunId", "State", "Result", "Error", "RunState", });
+    internal_static_steprpc_v1_RunLogResponse_descriptor =
+      getDescriptor().getMessageTypes().get(24);
+    internal_static_steprpc_v1_RunLogResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessage.FieldAccessorTable(
+        internal_static_steprpc_v1_RunLogResponse_descriptor,
+        new java.lang.String[] { "RunId", "Offset", "Text", "NextOffset", "Complete", });
     descriptor.resolveAllFeaturesImmutable();
     com.google.protobuf.StructProto.getDescriptor();
     com.google.protobuf.TimestampProto.getDescriptor();
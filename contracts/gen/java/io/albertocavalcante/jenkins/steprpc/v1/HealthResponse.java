@@ -30,6 +30,8 @@ private static final long serialVersionUID = 0L;
     apiVersion_ = "";
     service_ = "";
     status_ = "";
+    capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -162,6 +164,63 @@ private static final long serialVersionUID = 0L;
     }
   }
 
+  public static final int CAPABILITIES_FIELD_NUMBER = 4;
+  @SuppressWarnings("serial")
+  private com.google.protobuf.LazyStringArrayList capabilities_ =
+      com.google.protobuf.LazyStringArrayList.emptyList();
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @return A list containing the capabilities.
+   */
+  public com.google.protobuf.ProtocolStringList
+      getCapabilitiesList() {
+    return capabilities_;
+  }
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @return The count of capabilities.
+   */
+  public int getCapabilitiesCount() {
+    return capabilities_.size();
+  }
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @param index The index of the element to return.
+   * @return The capabilities at the given index.
+   */
+  public java.lang.String getCapabilities(int index) {
+    return capabilities_.get(index);
+  }
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @param index The index of the value to return.
+   * @return The bytes of the capabilities at the given index.
+   */
+  public com.google.protobuf.ByteString
+      getCapabilitiesBytes(int index) {
+    return capabilities_.getByteString(index);
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -185,6 +244,9 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(status_)) {
       com.google.protobuf.GeneratedMessage.writeString(output, 3, status_);
     }
+    for (int i = 0; i < capabilities_.size(); i++) {
+      com.google.protobuf.GeneratedMessage.writeString(output, 4, capabilities_.getRaw(i));
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -203,6 +265,14 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(status_)) {
       size += com.google.protobuf.GeneratedMessage.computeStringSize(3, status_);
     }
+    {
+      int dataSize = 0;
+      for (int i = 0; i < capabilities_.size(); i++) {
+        dataSize += computeStringSizeNoTag(capabilities_.getRaw(i));
+      }
+      size += dataSize;
+      size += 1 * getCapabilitiesList().size();
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -224,6 +294,8 @@ private static final long serialVersionUID = 0L;
         .equals(other.getService())) return false;
     if (!getStatus()
         .equals(other.getStatus())) return false;
+    if (!getCapabilitiesList()
+        .equals(other.getCapabilitiesList())) return false;
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -241,6 +313,10 @@ private static final long serialVersionUID = 0L;
     hash = (53 * hash) + getService().hashCode();
     hash = (37 * hash) + STATUS_FIELD_NUMBER;
     hash = (53 * hash) + getStatus().hashCode();
+    if (getCapabilitiesCount() > 0) {
+      hash = (37 * hash) + CAPABILITIES_FIELD_NUMBER;
+      hash = (53 * hash) + getCapabilitiesList().hashCode();
+    }
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -375,6 +451,8 @@ private static final long serialVersionUID = 0L;
       apiVersion_ = "";
       service_ = "";
       status_ = "";
+      capabilities_ =
+          com.google.protobuf.LazyStringArrayList.emptyList();
       return this;
     }
 
@@ -417,6 +495,10 @@ private static final long serialVersionUID = 0L;
       if (((from_bitField0_ & 0x00000004) != 0)) {
         result.status_ = status_;
       }
+      if (((from_bitField0_ & 0x00000008) != 0)) {
+        capabilities_.makeImmutable();
+        result.capabilities_ = capabilities_;
+      }
     }
 
     @java.lang.Override
@@ -446,6 +528,16 @@ private static final long serialVersionUID = 0L;
         bitField0_ |= 0x00000004;
         onChanged();
       }
+      if (!other.capabilities_.isEmpty()) {
+        if (capabilities_.isEmpty()) {
+          capabilities_ = other.capabilities_;
+          bitField0_ |= 0x00000008;
+        } else {
+          ensureCapabilitiesIsMutable();
+          capabilities_.addAll(other.capabilities_);
+        }
+        onChanged();
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -487,6 +579,12 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000004;
               break;
             } // case 26
+            case 34: {
+              java.lang.String s = input.readStringRequireUtf8();
+              ensureCapabilitiesIsMutable();
+              capabilities_.add(s);
+              break;
+            } // case 34
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -720,6 +818,162 @@ private static final long serialVersionUID = 0L;
       return this;
     }
 
+    private com.google.protobuf.LazyStringArrayList capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+    private void ensureCapabilitiesIsMutable() {
+      if (!capabilities_.isModifiable()) {
+        capabilities_ = new com.google.protobuf.LazyStringArrayList(capabilities_);
+      }
+      bitField0_ |= 0x00000008;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @return A list containing the capabilities.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getCapabilitiesList() {
+      capabilities_.makeImmutable();
+      return capabilities_;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @return The count of capabilities.
+     */
+    public int getCapabilitiesCount() {
+      return capabilities_.size();
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param index The index of the element to return.
+     * @return The capabilities at the given index.
+     */
+    public java.lang.String getCapabilities(int index) {
+      return capabilities_.get(index);
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the capabilities at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getCapabilitiesBytes(int index) {
+      return capabilities_.getByteString(index);
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param index The index to set the value at.
+     * @param value The capabilities to set.
+     * @return This builder for chaining.
+     */
+    public Builder setCapabilities(
+        int index, java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      ensureCapabilitiesIsMutable();
+      capabilities_.set(index, value);
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param value The capabilities to add.
+     * @return This builder for chaining.
+     */
+    public Builder addCapabilities(
+        java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      ensureCapabilitiesIsMutable();
+      capabilities_.add(value);
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param values The capabilities to add.
+     * @return This builder for chaining.
+     */
+    public Builder addAllCapabilities(
+        java.lang.Iterable<java.lang.String> values) {
+      ensureCapabilitiesIsMutable();
+      com.google.protobuf.AbstractMessageLite.Builder.addAll(
+          values, capabilities_);
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearCapabilities() {
+      capabilities_ =
+        com.google.protobuf.LazyStringArrayList.emptyList();
+      bitField0_ = (bitField0_ & ~0x00000008);;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional features this server supports. Servers that predate capability
+     * negotiation leave this empty; clients then assume the v1 baseline.
+     * </pre>
+     *
+     * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+     * @param value The bytes of the capabilities to add.
+     * @return This builder for chaining.
+     */
+    public Builder addCapabilitiesBytes(
+        com.google.protobuf.ByteString value) {
+      if (value == null) { throw new NullPointerException(); }
+      checkByteStringIsUtf8(value);
+      ensureCapabilitiesIsMutable();
+      capabilities_.add(value);
+      bitField0_ |= 0x00000008;
+      onChanged();
+      return this;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.HealthResponse)
   }
 
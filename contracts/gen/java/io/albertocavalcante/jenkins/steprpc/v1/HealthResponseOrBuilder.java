@@ -44,4 +44,49 @@ public interface HealthResponseOrBuilder extends
    */
   com.google.protobuf.ByteString
       getStatusBytes();
+
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @return A list containing the capabilities.
+   */
+  java.util.List<java.lang.String>
+      getCapabilitiesList();
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @return The count of capabilities.
+   */
+  int getCapabilitiesCount();
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @param index The index of the element to return.
+   * @return The capabilities at the given index.
+   */
+  java.lang.String getCapabilities(int index);
+  /**
+   * <pre>
+   * Optional features this server supports. Servers that predate capability
+   * negotiation leave this empty; clients then assume the v1 baseline.
+   * </pre>
+   *
+   * <code>repeated string capabilities = 4 [json_name = "capabilities"];</code>
+   * @param index The index of the value to return.
+   * @return The bytes of the capabilities at the given index.
+   */
+  com.google.protobuf.ByteString
+      getCapabilitiesBytes(int index);
 }
@@ -6,6 +6,27 @@
 package io.albertocavalcante.jenkins.steprpc.v1;
 
 /**
+ * <pre>
+ * Callback delivery. When an InvokeRequest sets callback_url, the server POSTs
+ * the run's RunStatusResponse (proto JSON) to it once the run reaches a terminal
+ * state. Servers that deliver callbacks advertise the "webhooks" capability.
+ *
+ * Each delivery carries three headers:
+ *
+ *   X-StepRpc-Delivery:  opaque ID, reused by every redelivery of the event
+ *   X-StepRpc-Timestamp: send time in Unix seconds
+ *   X-StepRpc-Signature: v1=&lt;hex(HMAC-SHA256(callback_secret, signed))&gt;
+ *
+ * where signed is delivery + "." + timestamp + "." + body, using the header
+ * values verbatim and the raw request body.
+ *
+ * The signature header may list several comma-separated v1= values while a
+ * secret is being rotated; any one matching is enough. Receivers must compare
+ * signatures in constant time, reject timestamps more than five minutes from
+ * their clock, and treat a repeated delivery ID as already handled. Any 2xx
+ * response acknowledges the delivery; otherwise the server may redeliver.
+ * </pre>
+ *
  * Protobuf type {@code steprpc.v1.InvokeRequest}
  */
 public final class InvokeRequest extends
@@ -30,6 +51,8 @@ private static final long serialVersionUID = 0L;
     requestId_ = "";
     operation_ = "";
     idempotencyKey_ = "";
+    callbackUrl_ = "";
+    callbackSecret_ = "";
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -189,6 +212,102 @@ private static final long serialVersionUID = 0L;
     }
   }
 
+  public static final int CALLBACK_URL_FIELD_NUMBER = 5;
+  @SuppressWarnings("serial")
+  private volatile java.lang.Object callbackUrl_ = "";
+  /**
+   * <pre>
+   * Optional http(s) URL notified when the run reaches a terminal state.
+   * </pre>
+   *
+   * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+   * @return The callbackUrl.
+   */
+  @java.lang.Override
+  public java.lang.String getCallbackUrl() {
+    java.lang.Object ref = callbackUrl_;
+    if (ref instanceof java.lang.String) {
+      return (java.lang.String) ref;
+    } else {
+      com.google.protobuf.ByteString bs = 
+          (com.google.protobuf.ByteString) ref;
+      java.lang.String s = bs.toStringUtf8();
+      callbackUrl_ = s;
+      return s;
+    }
+  }
+  /**
+   * <pre>
+   * Optional http(s) URL notified when the run reaches a terminal state.
+   * </pre>
+   *
+   * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+   * @return The bytes for callbackUrl.
+   */
+  @java.lang.Override
+  public com.google.protobuf.ByteString
+      getCallbackUrlBytes() {
+    java.lang.Object ref = callbackUrl_;
+    if (ref instanceof java.lang.String) {
+      com.google.protobuf.ByteString b = 
+          com.google.protobuf.ByteString.copyFromUtf8(
+              (java.lang.String) ref);
+      callbackUrl_ = b;
+      return b;
+    } else {
+      return (com.google.protobuf.ByteString) ref;
+    }
+  }
+
+  public static final int CALLBACK_SECRET_FIELD_NUMBER = 6;
+  @SuppressWarnings("serial")
+  private volatile java.lang.Object callbackSecret_ = "";
+  /**
+   * <pre>
+   * HMAC key for callback signatures. Required with callback_url; never echoed
+   * back by the server.
+   * </pre>
+   *
+   * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+   * @return The callbackSecret.
+   */
+  @java.lang.Override
+  public java.lang.String getCallbackSecret() {
+    java.lang.Object ref = callbackSecret_;
+    if (ref instanceof java.lang.String) {
+      return (java.lang.String) ref;
+    } else {
+      com.google.protobuf.ByteString bs = 
+          (com.google.protobuf.ByteString) ref;
+      java.lang.String s = bs.toStringUtf8();
+      callbackSecret_ = s;
+      return s;
+    }
+  }
+  /**
+   * <pre>
+   * HMAC key for callback signatures. Required with callback_url; never echoed
+   * back by the server.
+   * </pre>
+   *
+   * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+   * @return The bytes for callbackSecret.
+   */
+  @java.lang.Override
+  public com.google.protobuf.ByteString
+      getCallbackSecretBytes() {
+    java.lang.Object ref = callbackSecret_;
+    if (ref instanceof java.lang.String) {
+      com.google.protobuf.ByteString b = 
+          com.google.protobuf.ByteString.copyFromUtf8(
+              (java.lang.String) ref);
+      callbackSecret_ = b;
+      return b;
+    } else {
+      return (com.google.protobuf.ByteString) ref;
+    }
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -215,6 +334,12 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(idempotencyKey_)) {
       com.google.protobuf.GeneratedMessage.writeString(output, 4, idempotencyKey_);
     }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(callbackUrl_)) {
+      com.google.protobuf.GeneratedMessage.writeString(output, 5, callbackUrl_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(callbackSecret_)) {
+      com.google.protobuf.GeneratedMessage.writeString(output, 6, callbackSecret_);
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -237,6 +362,12 @@ private static final long serialVersionUID = 0L;
     if (!com.google.protobuf.GeneratedMessage.isStringEmpty(idempotencyKey_)) {
       size += com.google.protobuf.GeneratedMessage.computeStringSize(4, idempotencyKey_);
     }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(callbackUrl_)) {
+      size += com.google.protobuf.GeneratedMessage.computeStringSize(5, callbackUrl_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(callbackSecret_)) {
+      size += com.google.protobuf.GeneratedMessage.computeStringSize(6, callbackSecret_);
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -263,6 +394,10 @@ private static final long serialVersionUID = 0L;
     }
     if (!getIdempotencyKey()
         .equals(other.getIdempotencyKey())) return false;
+    if (!getCallbackUrl()
+        .equals(other.getCallbackUrl())) return false;
+    if (!getCallbackSecret()
+        .equals(other.getCallbackSecret())) return false;
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -284,6 +419,10 @@ private static final long serialVersionUID = 0L;
     }
     hash = (37 * hash) + IDEMPOTENCY_KEY_FIELD_NUMBER;
     hash = (53 * hash) + getIdempotencyKey().hashCode();
+    hash = (37 * hash) + CALLBACK_URL_FIELD_NUMBER;
+    hash = (53 * hash) + getCallbackUrl().hashCode();
+    hash = (37 * hash) + CALLBACK_SECRET_FIELD_NUMBER;
+    hash = (53 * hash) + getCallbackSecret().hashCode();
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -382,6 +521,27 @@ private static final long serialVersionUID = 0L;
     return builder;
   }
   /**
+   * <pre>
+   * Callback delivery. When an InvokeRequest sets callback_url, the server POSTs
+   * the run's RunStatusResponse (proto JSON) to it once the run reaches a terminal
+   * state. Servers that deliver callbacks advertise the "webhooks" capability.
+   *
+   * Each delivery carries three headers:
+   *
+   *   X-StepRpc-Delivery:  opaque ID, reused by every redelivery of the event
+   *   X-StepRpc-Timestamp: send time in Unix seconds
+   *   X-StepRpc-Signature: v1=&lt;hex(HMAC-SHA256(callback_secret, signed))&gt;
+   *
+   * where signed is delivery + "." + timestamp + "." + body, using the header
+   * values verbatim and the raw request body.
+   *
+   * The signature header may list several comma-separated v1= values while a
+   * secret is being rotated; any one matching is enough. Receivers must compare
+   * signatures in constant time, reject timestamps more than five minutes from
+   * their clock, and treat a repeated delivery ID as already handled. Any 2xx
+   * response acknowledges the delivery; otherwise the server may redeliver.
+   * </pre>
+   *
    * Protobuf type {@code steprpc.v1.InvokeRequest}
    */
   public static final class Builder extends
@@ -429,6 +589,8 @@ private static final long serialVersionUID = 0L;
         argsBuilder_ = null;
       }
       idempotencyKey_ = "";
+      callbackUrl_ = "";
+      callbackSecret_ = "";
       return this;
     }
 
@@ -478,6 +640,12 @@ private static final long serialVersionUID = 0L;
       if (((from_bitField0_ & 0x00000008) != 0)) {
         result.idempotencyKey_ = idempotencyKey_;
       }
+      if (((from_bitField0_ & 0x00000010) != 0)) {
+        result.callbackUrl_ = callbackUrl_;
+      }
+      if (((from_bitField0_ & 0x00000020) != 0)) {
+        result.callbackSecret_ = callbackSecret_;
+      }
       result.bitField0_ |= to_bitField0_;
     }
 
@@ -511,6 +679,16 @@ private static final long serialVersionUID = 0L;
         bitField0_ |= 0x00000008;
         onChanged();
       }
+      if (!other.getCallbackUrl().isEmpty()) {
+        callbackUrl_ = other.callbackUrl_;
+        bitField0_ |= 0x00000010;
+        onChanged();
+      }
+      if (!other.getCallbackSecret().isEmpty()) {
+        callbackSecret_ = other.callbackSecret_;
+        bitField0_ |= 0x00000020;
+        onChanged();
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -559,6 +737,16 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000008;
               break;
             } // case 34
+            case 42: {
+              callbackUrl_ = input.readStringRequireUtf8();
+              bitField0_ |= 0x00000010;
+              break;
+            } // case 42
+            case 50: {
+              callbackSecret_ = input.readStringRequireUtf8();
+              bitField0_ |= 0x00000020;
+              break;
+            } // case 50
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -913,6 +1101,195 @@ private static final long serialVersionUID = 0L;
       return this;
     }
 
+    private java.lang.Object callbackUrl_ = "";
+    /**
+     * <pre>
+     * Optional http(s) URL notified when the run reaches a terminal state.
+     * </pre>
+     *
+     * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+     * @return The callbackUrl.
+     */
+    public java.lang.String getCallbackUrl() {
+      java.lang.Object ref = callbackUrl_;
+      if (!(ref instanceof java.lang.String)) {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        callbackUrl_ = s;
+        return s;
+      } else {
+        return (java.lang.String) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Optional http(s) URL notified when the run reaches a terminal state.
+     * </pre>
+     *
+     * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+     * @return The bytes for callbackUrl.
+     */
+    public com.google.protobuf.ByteString
+        getCallbackUrlBytes() {
+      java.lang.Object ref = callbackUrl_;
+      if (ref instanceof String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        callbackUrl_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Optional http(s) URL notified when the run reaches a terminal state.
+     * </pre>
+     *
+     * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+     * @param value The callbackUrl to set.
+     * @return This builder for chaining.
+     */
+    public Builder setCallbackUrl(
+        java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      callbackUrl_ = value;
+      bitField0_ |= 0x00000010;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional http(s) URL notified when the run reaches a terminal state.
+     * </pre>
+     *
+     * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearCallbackUrl() {
+      callbackUrl_ = getDefaultInstance().getCallbackUrl();
+      bitField0_ = (bitField0_ & ~0x00000010);
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Optional http(s) URL notified when the run reaches a terminal state.
+     * </pre>
+     *
+     * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+     * @param value The bytes for callbackUrl to set.
+     * @return This builder for chaining.
+     */
+    public Builder setCallbackUrlBytes(
+        com.google.protobuf.ByteString value) {
+      if (value == null) { throw new NullPointerException(); }
+      checkByteStringIsUtf8(value);
+      callbackUrl_ = value;
+      bitField0_ |= 0x00000010;
+      onChanged();
+      return this;
+    }
+
+    private java.lang.Object callbackSecret_ = "";
+    /**
+     * <pre>
+     * HMAC key for callback signatures. Required with callback_url; never echoed
+     * back by the server.
+     * </pre>
+     *
+     * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+     * @return The callbackSecret.
+     */
+    public java.lang.String getCallbackSecret() {
+      java.lang.Object ref = callbackSecret_;
+      if (!(ref instanceof java.lang.String)) {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        callbackSecret_ = s;
+        return s;
+      } else {
+        return (java.lang.String) ref;
+      }
+    }
+    /**
+     * <pre>
+     * HMAC key for callback signatures. Required with callback_url; never echoed
+     * back by the server.
+     * </pre>
+     *
+     * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+     * @return The bytes for callbackSecret.
+     */
+    public com.google.protobuf.ByteString
+        getCallbackSecretBytes() {
+      java.lang.Object ref = callbackSecret_;
+      if (ref instanceof String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        callbackSecret_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+    /**
+     * <pre>
+     * HMAC key for callback signatures. Required with callback_url; never echoed
+     * back by the server.
+     * </pre>
+     *
+     * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+     * @param value The callbackSecret to set.
+     * @return This builder for chaining.
+     */
+    public Builder setCallbackSecret(
+        java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      callbackSecret_ = value;
+      bitField0_ |= 0x00000020;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * HMAC key for callback signatures. Required with callback_url; never echoed
+     * back by the server.
+     * </pre>
+     *
+     * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearCallbackSecret() {
+      callbackSecret_ = getDefaultInstance().getCallbackSecret();
+      bitField0_ = (bitField0_ & ~0x00000020);
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * HMAC key for callback signatures. Required with callback_url; never echoed
+     * back by the server.
+     * </pre>
+     *
+     * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+     * @param value The bytes for callbackSecret to set.
+     * @return This builder for chaining.
+     */
+    public Builder setCallbackSecretBytes(
+        com.google.protobuf.ByteString value) {
+      if (value == null) { throw new NullPointerException(); }
+      checkByteStringIsUtf8(value);
+      callbackSecret_ = value;
+      bitField0_ |= 0x00000020;
+      onChanged();
+      return this;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.InvokeRequest)
   }
 
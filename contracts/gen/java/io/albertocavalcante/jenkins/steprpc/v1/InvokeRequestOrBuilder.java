@@ -59,4 +59,46 @@ public interface InvokeRequestOrBuilder extends
    */
   com.google.protobuf.ByteString
       getIdempotencyKeyBytes();
+
+  /**
+   * <pre>
+   * Optional http(s) URL notified when the run reaches a terminal state.
+   * </pre>
+   *
+   * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+   * @return The callbackUrl.
+   */
+  java.lang.String getCallbackUrl();
+  /**
+   * <pre>
+   * Optional http(s) URL notified when the run reaches a terminal state.
+   * </pre>
+   *
+   * <code>string callback_url = 5 [json_name = "callbackUrl"];</code>
+   * @return The bytes for callbackUrl.
+   */
+  com.google.protobuf.ByteString
+      getCallbackUrlBytes();
+
+  /**
+   * <pre>
+   * HMAC key for callback signatures. Required with callback_url; never echoed
+   * back by the server.
+   * </pre>
+   *
+   * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+   * @return The callbackSecret.
+   */
+  java.lang.String getCallbackSecret();
+  /**
+   * <pre>
+   * HMAC key for callback signatures. Required with callback_url; never echoed
+   * back by the server.
+   * </pre>
+   *
+   * <code>string callback_secret = 6 [json_name = "callbackSecret"];</code>
+   * @return The bytes for callbackSecret.
+   */
+  com.google.protobuf.ByteString
+      getCallbackSecretBytes();
 }
@@ -30,6 +30,7 @@ private static final long serialVersionUID = 0L;
     requestId_ = "";
     runId_ = "";
     state_ = "";
+    runState_ = 0;
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -189,6 +190,24 @@ private static final long serialVersionUID = 0L;
     return error_ == null ? io.albertocavalcante.jenkins.steprpc.v1.Error.getDefaultInstance() : error_;
   }
 
+  public static final int RUN_STATE_FIELD_NUMBER = 5;
+  private int runState_ = 0;
+  /**
+   * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  @java.lang.Override public int getRunStateValue() {
+    return runState_;
+  }
+  /**
+   * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  @java.lang.Override public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+    io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+    return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -215,6 +234,9 @@ private static final long serialVersionUID = 0L;
     if (((bitField0_ & 0x00000001) != 0)) {
       output.writeMessage(4, getError());
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      output.writeEnum(5, runState_);
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -237,6 +259,10 @@ private static final long serialVersionUID = 0L;
       size += com.google.protobuf.CodedOutputStream
         .computeMessageSize(4, getError());
     }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeEnumSize(5, runState_);
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -263,6 +289,7 @@ private static final long serialVersionUID = 0L;
       if (!getError()
           .equals(other.getError())) return false;
     }
+    if (runState_ != other.runState_) return false;
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -284,6 +311,8 @@ private static final long serialVersionUID = 0L;
       hash = (37 * hash) + ERROR_FIELD_NUMBER;
       hash = (53 * hash) + getError().hashCode();
     }
+    hash = (37 * hash) + RUN_STATE_FIELD_NUMBER;
+    hash = (53 * hash) + runState_;
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -429,6 +458,7 @@ private static final long serialVersionUID = 0L;
         errorBuilder_.dispose();
         errorBuilder_ = null;
       }
+      runState_ = 0;
       return this;
     }
 
@@ -478,6 +508,9 @@ private static final long serialVersionUID = 0L;
             : errorBuilder_.build();
         to_bitField0_ |= 0x00000001;
       }
+      if (((from_bitField0_ & 0x00000010) != 0)) {
+        result.runState_ = runState_;
+      }
       result.bitField0_ |= to_bitField0_;
     }
 
@@ -511,6 +544,9 @@ private static final long serialVersionUID = 0L;
       if (other.hasError()) {
         mergeError(other.getError());
       }
+      if (other.runState_ != 0) {
+        setRunStateValue(other.getRunStateValue());
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -559,6 +595,11 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000008;
               break;
             } // case 34
+            case 40: {
+              runState_ = input.readEnum();
+              bitField0_ |= 0x00000010;
+              break;
+            } // case 40
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -913,6 +954,59 @@ private static final long serialVersionUID = 0L;
       return errorBuilder_;
     }
 
+    private int runState_ = 0;
+    /**
+     * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+     * @return The enum numeric value on the wire for runState.
+     */
+    @java.lang.Override public int getRunStateValue() {
+      return runState_;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+     * @param value The enum numeric value on the wire for runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunStateValue(int value) {
+      runState_ = value;
+      bitField0_ |= 0x00000010;
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+     * @return The runState.
+     */
+    @java.lang.Override
+    public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+      io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+      return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+     * @param value The runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunState(io.albertocavalcante.jenkins.steprpc.v1.RunState value) {
+      if (value == null) {
+        throw new NullPointerException();
+      }
+      bitField0_ |= 0x00000010;
+      runState_ = value.getNumber();
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearRunState() {
+      bitField0_ = (bitField0_ & ~0x00000010);
+      runState_ = 0;
+      onChanged();
+      return this;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.InvokeResponse)
   }
 
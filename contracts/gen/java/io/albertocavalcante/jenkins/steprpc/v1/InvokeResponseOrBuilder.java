@@ -59,4 +59,15 @@ public interface InvokeResponseOrBuilder extends
    * <code>.steprpc.v1.Error error = 4 [json_name = "error"];</code>
    */
   io.albertocavalcante.jenkins.steprpc.v1.ErrorOrBuilder getErrorOrBuilder();
+
+  /**
+   * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  int getRunStateValue();
+  /**
+   * <code>.steprpc.v1.RunState run_state = 5 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState();
 }
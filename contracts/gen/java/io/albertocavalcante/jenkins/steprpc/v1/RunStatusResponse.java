@@ -31,6 +31,9 @@ private static final long serialVersionUID = 0L;
     runId_ = "";
     operation_ = "";
     state_ = "";
+    executionMode_ = 0;
+    workerId_ = "";
+    runState_ = 0;
   }
 
   public static final com.google.protobuf.Descriptors.Descriptor
@@ -255,6 +258,305 @@ private static final long serialVersionUID = 0L;
     return error_ == null ? io.albertocavalcante.jenkins.steprpc.v1.Error.getDefaultInstance() : error_;
   }
 
+  public static final int STARTED_AT_FIELD_NUMBER = 7;
+  private com.google.protobuf.Timestamp startedAt_;
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   * @return Whether the startedAt field is set.
+   */
+  @java.lang.Override
+  public boolean hasStartedAt() {
+    return ((bitField0_ & 0x00000004) != 0);
+  }
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   * @return The startedAt.
+   */
+  @java.lang.Override
+  public com.google.protobuf.Timestamp getStartedAt() {
+    return startedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : startedAt_;
+  }
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   */
+  @java.lang.Override
+  public com.google.protobuf.TimestampOrBuilder getStartedAtOrBuilder() {
+    return startedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : startedAt_;
+  }
+
+  public static final int UPDATED_AT_FIELD_NUMBER = 8;
+  private com.google.protobuf.Timestamp updatedAt_;
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   * @return Whether the updatedAt field is set.
+   */
+  @java.lang.Override
+  public boolean hasUpdatedAt() {
+    return ((bitField0_ & 0x00000008) != 0);
+  }
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   * @return The updatedAt.
+   */
+  @java.lang.Override
+  public com.google.protobuf.Timestamp getUpdatedAt() {
+    return updatedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : updatedAt_;
+  }
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   */
+  @java.lang.Override
+  public com.google.protobuf.TimestampOrBuilder getUpdatedAtOrBuilder() {
+    return updatedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : updatedAt_;
+  }
+
+  public static final int COMPLETED_AT_FIELD_NUMBER = 9;
+  private com.google.protobuf.Timestamp completedAt_;
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   * @return Whether the completedAt field is set.
+   */
+  @java.lang.Override
+  public boolean hasCompletedAt() {
+    return ((bitField0_ & 0x00000010) != 0);
+  }
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   * @return The completedAt.
+   */
+  @java.lang.Override
+  public com.google.protobuf.Timestamp getCompletedAt() {
+    return completedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : completedAt_;
+  }
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   */
+  @java.lang.Override
+  public com.google.protobuf.TimestampOrBuilder getCompletedAtOrBuilder() {
+    return completedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : completedAt_;
+  }
+
+  public static final int ATTEMPTS_FIELD_NUMBER = 10;
+  private int attempts_ = 0;
+  /**
+   * <pre>
+   * Executions handed out: 1 for direct runs, one per bridge delivery.
+   * </pre>
+   *
+   * <code>int32 attempts = 10 [json_name = "attempts"];</code>
+   * @return The attempts.
+   */
+  @java.lang.Override
+  public int getAttempts() {
+    return attempts_;
+  }
+
+  public static final int EXECUTION_MODE_FIELD_NUMBER = 11;
+  private int executionMode_ = 0;
+  /**
+   * <pre>
+   * Lane the run executes in.
+   * </pre>
+   *
+   * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+   * @return The enum numeric value on the wire for executionMode.
+   */
+  @java.lang.Override public int getExecutionModeValue() {
+    return executionMode_;
+  }
+  /**
+   * <pre>
+   * Lane the run executes in.
+   * </pre>
+   *
+   * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+   * @return The executionMode.
+   */
+  @java.lang.Override public io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode getExecutionMode() {
+    io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode result = io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.forNumber(executionMode_);
+    return result == null ? io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.UNRECOGNIZED : result;
+  }
+
+  public static final int WORKER_ID_FIELD_NUMBER = 12;
+  @SuppressWarnings("serial")
+  private volatile java.lang.Object workerId_ = "";
+  /**
+   * <pre>
+   * Bridge worker that last fetched the request; empty for direct runs.
+   * </pre>
+   *
+   * <code>string worker_id = 12 [json_name = "workerId"];</code>
+   * @return The workerId.
+   */
+  @java.lang.Override
+  public java.lang.String getWorkerId() {
+    java.lang.Object ref = workerId_;
+    if (ref instanceof java.lang.String) {
+      return (java.lang.String) ref;
+    } else {
+      com.google.protobuf.ByteString bs = 
+          (com.google.protobuf.ByteString) ref;
+      java.lang.String s = bs.toStringUtf8();
+      workerId_ = s;
+      return s;
+    }
+  }
+  /**
+   * <pre>
+   * Bridge worker that last fetched the request; empty for direct runs.
+   * </pre>
+   *
+   * <code>string worker_id = 12 [json_name = "workerId"];</code>
+   * @return The bytes for workerId.
+   */
+  @java.lang.Override
+  public com.google.protobuf.ByteString
+      getWorkerIdBytes() {
+    java.lang.Object ref = workerId_;
+    if (ref instanceof java.lang.String) {
+      com.google.protobuf.ByteString b = 
+          com.google.protobuf.ByteString.copyFromUtf8(
+              (java.lang.String) ref);
+      workerId_ = b;
+      return b;
+    } else {
+      return (com.google.protobuf.ByteString) ref;
+    }
+  }
+
+  public static final int RUN_STATE_FIELD_NUMBER = 13;
+  private int runState_ = 0;
+  /**
+   * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  @java.lang.Override public int getRunStateValue() {
+    return runState_;
+  }
+  /**
+   * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  @java.lang.Override public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+    io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+    return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+  }
+
+  public static final int PROGRESS_FIELD_NUMBER = 14;
+  private io.albertocavalcante.jenkins.steprpc.v1.RunProgress progress_;
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   * @return Whether the progress field is set.
+   */
+  @java.lang.Override
+  public boolean hasProgress() {
+    return ((bitField0_ & 0x00000020) != 0);
+  }
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   * @return The progress.
+   */
+  @java.lang.Override
+  public io.albertocavalcante.jenkins.steprpc.v1.RunProgress getProgress() {
+    return progress_ == null ? io.albertocavalcante.jenkins.steprpc.v1.RunProgress.getDefaultInstance() : progress_;
+  }
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   */
+  @java.lang.Override
+  public io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder getProgressOrBuilder() {
+    return progress_ == null ? io.albertocavalcante.jenkins.steprpc.v1.RunProgress.getDefaultInstance() : progress_;
+  }
+
+  public static final int RESULT_FIELD_NUMBER = 15;
+  private com.google.protobuf.Struct result_;
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   * @return Whether the result field is set.
+   */
+  @java.lang.Override
+  public boolean hasResult() {
+    return ((bitField0_ & 0x00000040) != 0);
+  }
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   * @return The result.
+   */
+  @java.lang.Override
+  public com.google.protobuf.Struct getResult() {
+    return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+  }
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   */
+  @java.lang.Override
+  public com.google.protobuf.StructOrBuilder getResultOrBuilder() {
+    return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+  }
+
   private byte memoizedIsInitialized = -1;
   @java.lang.Override
   public final boolean isInitialized() {
@@ -287,6 +589,33 @@ private static final long serialVersionUID = 0L;
     if (((bitField0_ & 0x00000002) != 0)) {
       output.writeMessage(6, getError());
     }
+    if (((bitField0_ & 0x00000004) != 0)) {
+      output.writeMessage(7, getStartedAt());
+    }
+    if (((bitField0_ & 0x00000008) != 0)) {
+      output.writeMessage(8, getUpdatedAt());
+    }
+    if (((bitField0_ & 0x00000010) != 0)) {
+      output.writeMessage(9, getCompletedAt());
+    }
+    if (attempts_ != 0) {
+      output.writeInt32(10, attempts_);
+    }
+    if (executionMode_ != io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.OPERATION_EXECUTION_MODE_UNSPECIFIED.getNumber()) {
+      output.writeEnum(11, executionMode_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(workerId_)) {
+      com.google.protobuf.GeneratedMessage.writeString(output, 12, workerId_);
+    }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      output.writeEnum(13, runState_);
+    }
+    if (((bitField0_ & 0x00000020) != 0)) {
+      output.writeMessage(14, getProgress());
+    }
+    if (((bitField0_ & 0x00000040) != 0)) {
+      output.writeMessage(15, getResult());
+    }
     getUnknownFields().writeTo(output);
   }
 
@@ -316,6 +645,41 @@ private static final long serialVersionUID = 0L;
       size += com.google.protobuf.CodedOutputStream
         .computeMessageSize(6, getError());
     }
+    if (((bitField0_ & 0x00000004) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(7, getStartedAt());
+    }
+    if (((bitField0_ & 0x00000008) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(8, getUpdatedAt());
+    }
+    if (((bitField0_ & 0x00000010) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(9, getCompletedAt());
+    }
+    if (attempts_ != 0) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeInt32Size(10, attempts_);
+    }
+    if (executionMode_ != io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.OPERATION_EXECUTION_MODE_UNSPECIFIED.getNumber()) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeEnumSize(11, executionMode_);
+    }
+    if (!com.google.protobuf.GeneratedMessage.isStringEmpty(workerId_)) {
+      size += com.google.protobuf.GeneratedMessage.computeStringSize(12, workerId_);
+    }
+    if (runState_ != io.albertocavalcante.jenkins.steprpc.v1.RunState.RUN_STATE_UNSPECIFIED.getNumber()) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeEnumSize(13, runState_);
+    }
+    if (((bitField0_ & 0x00000020) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(14, getProgress());
+    }
+    if (((bitField0_ & 0x00000040) != 0)) {
+      size += com.google.protobuf.CodedOutputStream
+        .computeMessageSize(15, getResult());
+    }
     size += getUnknownFields().getSerializedSize();
     memoizedSize = size;
     return size;
@@ -349,6 +713,37 @@ private static final long serialVersionUID = 0L;
       if (!getError()
           .equals(other.getError())) return false;
     }
+    if (hasStartedAt() != other.hasStartedAt()) return false;
+    if (hasStartedAt()) {
+      if (!getStartedAt()
+          .equals(other.getStartedAt())) return false;
+    }
+    if (hasUpdatedAt() != other.hasUpdatedAt()) return false;
+    if (hasUpdatedAt()) {
+      if (!getUpdatedAt()
+          .equals(other.getUpdatedAt())) return false;
+    }
+    if (hasCompletedAt() != other.hasCompletedAt()) return false;
+    if (hasCompletedAt()) {
+      if (!getCompletedAt()
+          .equals(other.getCompletedAt())) return false;
+    }
+    if (getAttempts()
+        != other.getAttempts()) return false;
+    if (executionMode_ != other.executionMode_) return false;
+    if (!getWorkerId()
+        .equals(other.getWorkerId())) return false;
+    if (runState_ != other.runState_) return false;
+    if (hasProgress() != other.hasProgress()) return false;
+    if (hasProgress()) {
+      if (!getProgress()
+          .equals(other.getProgress())) return false;
+    }
+    if (hasResult() != other.hasResult()) return false;
+    if (hasResult()) {
+      if (!getResult()
+          .equals(other.getResult())) return false;
+    }
     if (!getUnknownFields().equals(other.getUnknownFields())) return false;
     return true;
   }
@@ -376,6 +771,34 @@ private static final long serialVersionUID = 0L;
       hash = (37 * hash) + ERROR_FIELD_NUMBER;
       hash = (53 * hash) + getError().hashCode();
     }
+    if (hasStartedAt()) {
+      hash = (37 * hash) + STARTED_AT_FIELD_NUMBER;
+      hash = (53 * hash) + getStartedAt().hashCode();
+    }
+    if (hasUpdatedAt()) {
+      hash = (37 * hash) + UPDATED_AT_FIELD_NUMBER;
+      hash = (53 * hash) + getUpdatedAt().hashCode();
+    }
+    if (hasCompletedAt()) {
+      hash = (37 * hash) + COMPLETED_AT_FIELD_NUMBER;
+      hash = (53 * hash) + getCompletedAt().hashCode();
+    }
+    hash = (37 * hash) + ATTEMPTS_FIELD_NUMBER;
+    hash = (53 * hash) + getAttempts();
+    hash = (37 * hash) + EXECUTION_MODE_FIELD_NUMBER;
+    hash = (53 * hash) + executionMode_;
+    hash = (37 * hash) + WORKER_ID_FIELD_NUMBER;
+    hash = (53 * hash) + getWorkerId().hashCode();
+    hash = (37 * hash) + RUN_STATE_FIELD_NUMBER;
+    hash = (53 * hash) + runState_;
+    if (hasProgress()) {
+      hash = (37 * hash) + PROGRESS_FIELD_NUMBER;
+      hash = (53 * hash) + getProgress().hashCode();
+    }
+    if (hasResult()) {
+      hash = (37 * hash) + RESULT_FIELD_NUMBER;
+      hash = (53 * hash) + getResult().hashCode();
+    }
     hash = (29 * hash) + getUnknownFields().hashCode();
     memoizedHashCode = hash;
     return hash;
@@ -508,6 +931,11 @@ private static final long serialVersionUID = 0L;
               .alwaysUseFieldBuilders) {
         getCreatedAtFieldBuilder();
         getErrorFieldBuilder();
+        getStartedAtFieldBuilder();
+        getUpdatedAtFieldBuilder();
+        getCompletedAtFieldBuilder();
+        getProgressFieldBuilder();
+        getResultFieldBuilder();
       }
     }
     @java.lang.Override
@@ -528,6 +956,35 @@ private static final long serialVersionUID = 0L;
         errorBuilder_.dispose();
         errorBuilder_ = null;
       }
+      startedAt_ = null;
+      if (startedAtBuilder_ != null) {
+        startedAtBuilder_.dispose();
+        startedAtBuilder_ = null;
+      }
+      updatedAt_ = null;
+      if (updatedAtBuilder_ != null) {
+        updatedAtBuilder_.dispose();
+        updatedAtBuilder_ = null;
+      }
+      completedAt_ = null;
+      if (completedAtBuilder_ != null) {
+        completedAtBuilder_.dispose();
+        completedAtBuilder_ = null;
+      }
+      attempts_ = 0;
+      executionMode_ = 0;
+      workerId_ = "";
+      runState_ = 0;
+      progress_ = null;
+      if (progressBuilder_ != null) {
+        progressBuilder_.dispose();
+        progressBuilder_ = null;
+      }
+      result_ = null;
+      if (resultBuilder_ != null) {
+        resultBuilder_.dispose();
+        resultBuilder_ = null;
+      }
       return this;
     }
 
@@ -586,6 +1043,48 @@ private static final long serialVersionUID = 0L;
             : errorBuilder_.build();
         to_bitField0_ |= 0x00000002;
       }
+      if (((from_bitField0_ & 0x00000040) != 0)) {
+        result.startedAt_ = startedAtBuilder_ == null
+            ? startedAt_
+            : startedAtBuilder_.build();
+        to_bitField0_ |= 0x00000004;
+      }
+      if (((from_bitField0_ & 0x00000080) != 0)) {
+        result.updatedAt_ = updatedAtBuilder_ == null
+            ? updatedAt_
+            : updatedAtBuilder_.build();
+        to_bitField0_ |= 0x00000008;
+      }
+      if (((from_bitField0_ & 0x00000100) != 0)) {
+        result.completedAt_ = completedAtBuilder_ == null
+            ? completedAt_
+            : completedAtBuilder_.build();
+        to_bitField0_ |= 0x00000010;
+      }
+      if (((from_bitField0_ & 0x00000200) != 0)) {
+        result.attempts_ = attempts_;
+      }
+      if (((from_bitField0_ & 0x00000400) != 0)) {
+        result.executionMode_ = executionMode_;
+      }
+      if (((from_bitField0_ & 0x00000800) != 0)) {
+        result.workerId_ = workerId_;
+      }
+      if (((from_bitField0_ & 0x00001000) != 0)) {
+        result.runState_ = runState_;
+      }
+      if (((from_bitField0_ & 0x00002000) != 0)) {
+        result.progress_ = progressBuilder_ == null
+            ? progress_
+            : progressBuilder_.build();
+        to_bitField0_ |= 0x00000020;
+      }
+      if (((from_bitField0_ & 0x00004000) != 0)) {
+        result.result_ = resultBuilder_ == null
+            ? result_
+            : resultBuilder_.build();
+        to_bitField0_ |= 0x00000040;
+      }
       result.bitField0_ |= to_bitField0_;
     }
 
@@ -627,6 +1126,35 @@ private static final long serialVersionUID = 0L;
       if (other.hasError()) {
         mergeError(other.getError());
       }
+      if (other.hasStartedAt()) {
+        mergeStartedAt(other.getStartedAt());
+      }
+      if (other.hasUpdatedAt()) {
+        mergeUpdatedAt(other.getUpdatedAt());
+      }
+      if (other.hasCompletedAt()) {
+        mergeCompletedAt(other.getCompletedAt());
+      }
+      if (other.getAttempts() != 0) {
+        setAttempts(other.getAttempts());
+      }
+      if (other.executionMode_ != 0) {
+        setExecutionModeValue(other.getExecutionModeValue());
+      }
+      if (!other.getWorkerId().isEmpty()) {
+        workerId_ = other.workerId_;
+        bitField0_ |= 0x00000800;
+        onChanged();
+      }
+      if (other.runState_ != 0) {
+        setRunStateValue(other.getRunStateValue());
+      }
+      if (other.hasProgress()) {
+        mergeProgress(other.getProgress());
+      }
+      if (other.hasResult()) {
+        mergeResult(other.getResult());
+      }
       this.mergeUnknownFields(other.getUnknownFields());
       onChanged();
       return this;
@@ -687,6 +1215,61 @@ private static final long serialVersionUID = 0L;
               bitField0_ |= 0x00000020;
               break;
             } // case 50
+            case 58: {
+              input.readMessage(
+                  getStartedAtFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00000040;
+              break;
+            } // case 58
+            case 66: {
+              input.readMessage(
+                  getUpdatedAtFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00000080;
+              break;
+            } // case 66
+            case 74: {
+              input.readMessage(
+                  getCompletedAtFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00000100;
+              break;
+            } // case 74
+            case 80: {
+              attempts_ = input.readInt32();
+              bitField0_ |= 0x00000200;
+              break;
+            } // case 80
+            case 88: {
+              executionMode_ = input.readEnum();
+              bitField0_ |= 0x00000400;
+              break;
+            } // case 88
+            case 98: {
+              workerId_ = input.readStringRequireUtf8();
+              bitField0_ |= 0x00000800;
+              break;
+            } // case 98
+            case 104: {
+              runState_ = input.readEnum();
+              bitField0_ |= 0x00001000;
+              break;
+            } // case 104
+            case 114: {
+              input.readMessage(
+                  getProgressFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00002000;
+              break;
+            } // case 114
+            case 122: {
+              input.readMessage(
+                  getResultFieldBuilder().getBuilder(),
+                  extensionRegistry);
+              bitField0_ |= 0x00004000;
+              break;
+            } // case 122
             default: {
               if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                 done = true; // was an endgroup tag
@@ -1234,6 +1817,1062 @@ private static final long serialVersionUID = 0L;
       return errorBuilder_;
     }
 
+    private com.google.protobuf.Timestamp startedAt_;
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> startedAtBuilder_;
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     * @return Whether the startedAt field is set.
+     */
+    public boolean hasStartedAt() {
+      return ((bitField0_ & 0x00000040) != 0);
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     * @return The startedAt.
+     */
+    public com.google.protobuf.Timestamp getStartedAt() {
+      if (startedAtBuilder_ == null) {
+        return startedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : startedAt_;
+      } else {
+        return startedAtBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public Builder setStartedAt(com.google.protobuf.Timestamp value) {
+      if (startedAtBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        startedAt_ = value;
+      } else {
+        startedAtBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00000040;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public Builder setStartedAt(
+        com.google.protobuf.Timestamp.Builder builderForValue) {
+      if (startedAtBuilder_ == null) {
+        startedAt_ = builderForValue.build();
+      } else {
+        startedAtBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00000040;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public Builder mergeStartedAt(com.google.protobuf.Timestamp value) {
+      if (startedAtBuilder_ == null) {
+        if (((bitField0_ & 0x00000040) != 0) &&
+          startedAt_ != null &&
+          startedAt_ != com.google.protobuf.Timestamp.getDefaultInstance()) {
+          getStartedAtBuilder().mergeFrom(value);
+        } else {
+          startedAt_ = value;
+        }
+      } else {
+        startedAtBuilder_.mergeFrom(value);
+      }
+      if (startedAt_ != null) {
+        bitField0_ |= 0x00000040;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public Builder clearStartedAt() {
+      bitField0_ = (bitField0_ & ~0x00000040);
+      startedAt_ = null;
+      if (startedAtBuilder_ != null) {
+        startedAtBuilder_.dispose();
+        startedAtBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public com.google.protobuf.Timestamp.Builder getStartedAtBuilder() {
+      bitField0_ |= 0x00000040;
+      onChanged();
+      return getStartedAtFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    public com.google.protobuf.TimestampOrBuilder getStartedAtOrBuilder() {
+      if (startedAtBuilder_ != null) {
+        return startedAtBuilder_.getMessageOrBuilder();
+      } else {
+        return startedAt_ == null ?
+            com.google.protobuf.Timestamp.getDefaultInstance() : startedAt_;
+      }
+    }
+    /**
+     * <pre>
+     * When execution began: the direct step started, or a bridge worker first
+     * fetched the request. Unset while queued.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> 
+        getStartedAtFieldBuilder() {
+      if (startedAtBuilder_ == null) {
+        startedAtBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                getStartedAt(),
+                getParentForChildren(),
+                isClean());
+        startedAt_ = null;
+      }
+      return startedAtBuilder_;
+    }
+
+    private com.google.protobuf.Timestamp updatedAt_;
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> updatedAtBuilder_;
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     * @return Whether the updatedAt field is set.
+     */
+    public boolean hasUpdatedAt() {
+      return ((bitField0_ & 0x00000080) != 0);
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     * @return The updatedAt.
+     */
+    public com.google.protobuf.Timestamp getUpdatedAt() {
+      if (updatedAtBuilder_ == null) {
+        return updatedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : updatedAt_;
+      } else {
+        return updatedAtBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public Builder setUpdatedAt(com.google.protobuf.Timestamp value) {
+      if (updatedAtBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        updatedAt_ = value;
+      } else {
+        updatedAtBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00000080;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public Builder setUpdatedAt(
+        com.google.protobuf.Timestamp.Builder builderForValue) {
+      if (updatedAtBuilder_ == null) {
+        updatedAt_ = builderForValue.build();
+      } else {
+        updatedAtBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00000080;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public Builder mergeUpdatedAt(com.google.protobuf.Timestamp value) {
+      if (updatedAtBuilder_ == null) {
+        if (((bitField0_ & 0x00000080) != 0) &&
+          updatedAt_ != null &&
+          updatedAt_ != com.google.protobuf.Timestamp.getDefaultInstance()) {
+          getUpdatedAtBuilder().mergeFrom(value);
+        } else {
+          updatedAt_ = value;
+        }
+      } else {
+        updatedAtBuilder_.mergeFrom(value);
+      }
+      if (updatedAt_ != null) {
+        bitField0_ |= 0x00000080;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public Builder clearUpdatedAt() {
+      bitField0_ = (bitField0_ & ~0x00000080);
+      updatedAt_ = null;
+      if (updatedAtBuilder_ != null) {
+        updatedAtBuilder_.dispose();
+        updatedAtBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public com.google.protobuf.Timestamp.Builder getUpdatedAtBuilder() {
+      bitField0_ |= 0x00000080;
+      onChanged();
+      return getUpdatedAtFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    public com.google.protobuf.TimestampOrBuilder getUpdatedAtOrBuilder() {
+      if (updatedAtBuilder_ != null) {
+        return updatedAtBuilder_.getMessageOrBuilder();
+      } else {
+        return updatedAt_ == null ?
+            com.google.protobuf.Timestamp.getDefaultInstance() : updatedAt_;
+      }
+    }
+    /**
+     * <pre>
+     * Last change to the run's state, attempts, or worker.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> 
+        getUpdatedAtFieldBuilder() {
+      if (updatedAtBuilder_ == null) {
+        updatedAtBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                getUpdatedAt(),
+                getParentForChildren(),
+                isClean());
+        updatedAt_ = null;
+      }
+      return updatedAtBuilder_;
+    }
+
+    private com.google.protobuf.Timestamp completedAt_;
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> completedAtBuilder_;
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     * @return Whether the completedAt field is set.
+     */
+    public boolean hasCompletedAt() {
+      return ((bitField0_ & 0x00000100) != 0);
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     * @return The completedAt.
+     */
+    public com.google.protobuf.Timestamp getCompletedAt() {
+      if (completedAtBuilder_ == null) {
+        return completedAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : completedAt_;
+      } else {
+        return completedAtBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public Builder setCompletedAt(com.google.protobuf.Timestamp value) {
+      if (completedAtBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        completedAt_ = value;
+      } else {
+        completedAtBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00000100;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public Builder setCompletedAt(
+        com.google.protobuf.Timestamp.Builder builderForValue) {
+      if (completedAtBuilder_ == null) {
+        completedAt_ = builderForValue.build();
+      } else {
+        completedAtBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00000100;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public Builder mergeCompletedAt(com.google.protobuf.Timestamp value) {
+      if (completedAtBuilder_ == null) {
+        if (((bitField0_ & 0x00000100) != 0) &&
+          completedAt_ != null &&
+          completedAt_ != com.google.protobuf.Timestamp.getDefaultInstance()) {
+          getCompletedAtBuilder().mergeFrom(value);
+        } else {
+          completedAt_ = value;
+        }
+      } else {
+        completedAtBuilder_.mergeFrom(value);
+      }
+      if (completedAt_ != null) {
+        bitField0_ |= 0x00000100;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public Builder clearCompletedAt() {
+      bitField0_ = (bitField0_ & ~0x00000100);
+      completedAt_ = null;
+      if (completedAtBuilder_ != null) {
+        completedAtBuilder_.dispose();
+        completedAtBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public com.google.protobuf.Timestamp.Builder getCompletedAtBuilder() {
+      bitField0_ |= 0x00000100;
+      onChanged();
+      return getCompletedAtFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    public com.google.protobuf.TimestampOrBuilder getCompletedAtOrBuilder() {
+      if (completedAtBuilder_ != null) {
+        return completedAtBuilder_.getMessageOrBuilder();
+      } else {
+        return completedAt_ == null ?
+            com.google.protobuf.Timestamp.getDefaultInstance() : completedAt_;
+      }
+    }
+    /**
+     * <pre>
+     * When the run reached a terminal state.
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> 
+        getCompletedAtFieldBuilder() {
+      if (completedAtBuilder_ == null) {
+        completedAtBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                getCompletedAt(),
+                getParentForChildren(),
+                isClean());
+        completedAt_ = null;
+      }
+      return completedAtBuilder_;
+    }
+
+    private int attempts_ ;
+    /**
+     * <pre>
+     * Executions handed out: 1 for direct runs, one per bridge delivery.
+     * </pre>
+     *
+     * <code>int32 attempts = 10 [json_name = "attempts"];</code>
+     * @return The attempts.
+     */
+    @java.lang.Override
+    public int getAttempts() {
+      return attempts_;
+    }
+    /**
+     * <pre>
+     * Executions handed out: 1 for direct runs, one per bridge delivery.
+     * </pre>
+     *
+     * <code>int32 attempts = 10 [json_name = "attempts"];</code>
+     * @param value The attempts to set.
+     * @return This builder for chaining.
+     */
+    public Builder setAttempts(int value) {
+
+      attempts_ = value;
+      bitField0_ |= 0x00000200;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Executions handed out: 1 for direct runs, one per bridge delivery.
+     * </pre>
+     *
+     * <code>int32 attempts = 10 [json_name = "attempts"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearAttempts() {
+      bitField0_ = (bitField0_ & ~0x00000200);
+      attempts_ = 0;
+      onChanged();
+      return this;
+    }
+
+    private int executionMode_ = 0;
+    /**
+     * <pre>
+     * Lane the run executes in.
+     * </pre>
+     *
+     * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+     * @return The enum numeric value on the wire for executionMode.
+     */
+    @java.lang.Override public int getExecutionModeValue() {
+      return executionMode_;
+    }
+    /**
+     * <pre>
+     * Lane the run executes in.
+     * </pre>
+     *
+     * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+     * @param value The enum numeric value on the wire for executionMode to set.
+     * @return This builder for chaining.
+     */
+    public Builder setExecutionModeValue(int value) {
+      executionMode_ = value;
+      bitField0_ |= 0x00000400;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Lane the run executes in.
+     * </pre>
+     *
+     * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+     * @return The executionMode.
+     */
+    @java.lang.Override
+    public io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode getExecutionMode() {
+      io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode result = io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.forNumber(executionMode_);
+      return result == null ? io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode.UNRECOGNIZED : result;
+    }
+    /**
+     * <pre>
+     * Lane the run executes in.
+     * </pre>
+     *
+     * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+     * @param value The executionMode to set.
+     * @return This builder for chaining.
+     */
+    public Builder setExecutionMode(io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode value) {
+      if (value == null) {
+        throw new NullPointerException();
+      }
+      bitField0_ |= 0x00000400;
+      executionMode_ = value.getNumber();
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Lane the run executes in.
+     * </pre>
+     *
+     * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearExecutionMode() {
+      bitField0_ = (bitField0_ & ~0x00000400);
+      executionMode_ = 0;
+      onChanged();
+      return this;
+    }
+
+    private java.lang.Object workerId_ = "";
+    /**
+     * <pre>
+     * Bridge worker that last fetched the request; empty for direct runs.
+     * </pre>
+     *
+     * <code>string worker_id = 12 [json_name = "workerId"];</code>
+     * @return The workerId.
+     */
+    public java.lang.String getWorkerId() {
+      java.lang.Object ref = workerId_;
+      if (!(ref instanceof java.lang.String)) {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        workerId_ = s;
+        return s;
+      } else {
+        return (java.lang.String) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Bridge worker that last fetched the request; empty for direct runs.
+     * </pre>
+     *
+     * <code>string worker_id = 12 [json_name = "workerId"];</code>
+     * @return The bytes for workerId.
+     */
+    public com.google.protobuf.ByteString
+        getWorkerIdBytes() {
+      java.lang.Object ref = workerId_;
+      if (ref instanceof String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        workerId_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+    /**
+     * <pre>
+     * Bridge worker that last fetched the request; empty for direct runs.
+     * </pre>
+     *
+     * <code>string worker_id = 12 [json_name = "workerId"];</code>
+     * @param value The workerId to set.
+     * @return This builder for chaining.
+     */
+    public Builder setWorkerId(
+        java.lang.String value) {
+      if (value == null) { throw new NullPointerException(); }
+      workerId_ = value;
+      bitField0_ |= 0x00000800;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Bridge worker that last fetched the request; empty for direct runs.
+     * </pre>
+     *
+     * <code>string worker_id = 12 [json_name = "workerId"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearWorkerId() {
+      workerId_ = getDefaultInstance().getWorkerId();
+      bitField0_ = (bitField0_ & ~0x00000800);
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Bridge worker that last fetched the request; empty for direct runs.
+     * </pre>
+     *
+     * <code>string worker_id = 12 [json_name = "workerId"];</code>
+     * @param value The bytes for workerId to set.
+     * @return This builder for chaining.
+     */
+    public Builder setWorkerIdBytes(
+        com.google.protobuf.ByteString value) {
+      if (value == null) { throw new NullPointerException(); }
+      checkByteStringIsUtf8(value);
+      workerId_ = value;
+      bitField0_ |= 0x00000800;
+      onChanged();
+      return this;
+    }
+
+    private int runState_ = 0;
+    /**
+     * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+     * @return The enum numeric value on the wire for runState.
+     */
+    @java.lang.Override public int getRunStateValue() {
+      return runState_;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+     * @param value The enum numeric value on the wire for runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunStateValue(int value) {
+      runState_ = value;
+      bitField0_ |= 0x00001000;
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+     * @return The runState.
+     */
+    @java.lang.Override
+    public io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState() {
+      io.albertocavalcante.jenkins.steprpc.v1.RunState result = io.albertocavalcante.jenkins.steprpc.v1.RunState.forNumber(runState_);
+      return result == null ? io.albertocavalcante.jenkins.steprpc.v1.RunState.UNRECOGNIZED : result;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+     * @param value The runState to set.
+     * @return This builder for chaining.
+     */
+    public Builder setRunState(io.albertocavalcante.jenkins.steprpc.v1.RunState value) {
+      if (value == null) {
+        throw new NullPointerException();
+      }
+      bitField0_ |= 0x00001000;
+      runState_ = value.getNumber();
+      onChanged();
+      return this;
+    }
+    /**
+     * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+     * @return This builder for chaining.
+     */
+    public Builder clearRunState() {
+      bitField0_ = (bitField0_ & ~0x00001000);
+      runState_ = 0;
+      onChanged();
+      return this;
+    }
+
+    private io.albertocavalcante.jenkins.steprpc.v1.RunProgress progress_;
+    private com.google.protobuf.SingleFieldBuilder<
+        io.albertocavalcante.jenkins.steprpc.v1.RunProgress, io.albertocavalcante.jenkins.steprpc.v1.RunProgress.Builder, io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder> progressBuilder_;
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     * @return Whether the progress field is set.
+     */
+    public boolean hasProgress() {
+      return ((bitField0_ & 0x00002000) != 0);
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     * @return The progress.
+     */
+    public io.albertocavalcante.jenkins.steprpc.v1.RunProgress getProgress() {
+      if (progressBuilder_ == null) {
+        return progress_ == null ? io.albertocavalcante.jenkins.steprpc.v1.RunProgress.getDefaultInstance() : progress_;
+      } else {
+        return progressBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public Builder setProgress(io.albertocavalcante.jenkins.steprpc.v1.RunProgress value) {
+      if (progressBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        progress_ = value;
+      } else {
+        progressBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00002000;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public Builder setProgress(
+        io.albertocavalcante.jenkins.steprpc.v1.RunProgress.Builder builderForValue) {
+      if (progressBuilder_ == null) {
+        progress_ = builderForValue.build();
+      } else {
+        progressBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00002000;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public Builder mergeProgress(io.albertocavalcante.jenkins.steprpc.v1.RunProgress value) {
+      if (progressBuilder_ == null) {
+        if (((bitField0_ & 0x00002000) != 0) &&
+          progress_ != null &&
+          progress_ != io.albertocavalcante.jenkins.steprpc.v1.RunProgress.getDefaultInstance()) {
+          getProgressBuilder().mergeFrom(value);
+        } else {
+          progress_ = value;
+        }
+      } else {
+        progressBuilder_.mergeFrom(value);
+      }
+      if (progress_ != null) {
+        bitField0_ |= 0x00002000;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public Builder clearProgress() {
+      bitField0_ = (bitField0_ & ~0x00002000);
+      progress_ = null;
+      if (progressBuilder_ != null) {
+        progressBuilder_.dispose();
+        progressBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public io.albertocavalcante.jenkins.steprpc.v1.RunProgress.Builder getProgressBuilder() {
+      bitField0_ |= 0x00002000;
+      onChanged();
+      return getProgressFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    public io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder getProgressOrBuilder() {
+      if (progressBuilder_ != null) {
+        return progressBuilder_.getMessageOrBuilder();
+      } else {
+        return progress_ == null ?
+            io.albertocavalcante.jenkins.steprpc.v1.RunProgress.getDefaultInstance() : progress_;
+      }
+    }
+    /**
+     * <pre>
+     * Last progress a bridge worker reported; unset when none has.
+     * </pre>
+     *
+     * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        io.albertocavalcante.jenkins.steprpc.v1.RunProgress, io.albertocavalcante.jenkins.steprpc.v1.RunProgress.Builder, io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder> 
+        getProgressFieldBuilder() {
+      if (progressBuilder_ == null) {
+        progressBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            io.albertocavalcante.jenkins.steprpc.v1.RunProgress, io.albertocavalcante.jenkins.steprpc.v1.RunProgress.Builder, io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder>(
+                getProgress(),
+                getParentForChildren(),
+                isClean());
+        progress_ = null;
+      }
+      return progressBuilder_;
+    }
+
+    private com.google.protobuf.Struct result_;
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder> resultBuilder_;
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     * @return Whether the result field is set.
+     */
+    public boolean hasResult() {
+      return ((bitField0_ & 0x00004000) != 0);
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     * @return The result.
+     */
+    public com.google.protobuf.Struct getResult() {
+      if (resultBuilder_ == null) {
+        return result_ == null ? com.google.protobuf.Struct.getDefaultInstance() : result_;
+      } else {
+        return resultBuilder_.getMessage();
+      }
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public Builder setResult(com.google.protobuf.Struct value) {
+      if (resultBuilder_ == null) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        result_ = value;
+      } else {
+        resultBuilder_.setMessage(value);
+      }
+      bitField0_ |= 0x00004000;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public Builder setResult(
+        com.google.protobuf.Struct.Builder builderForValue) {
+      if (resultBuilder_ == null) {
+        result_ = builderForValue.build();
+      } else {
+        resultBuilder_.setMessage(builderForValue.build());
+      }
+      bitField0_ |= 0x00004000;
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public Builder mergeResult(com.google.protobuf.Struct value) {
+      if (resultBuilder_ == null) {
+        if (((bitField0_ & 0x00004000) != 0) &&
+          result_ != null &&
+          result_ != com.google.protobuf.Struct.getDefaultInstance()) {
+          getResultBuilder().mergeFrom(value);
+        } else {
+          result_ = value;
+        }
+      } else {
+        resultBuilder_.mergeFrom(value);
+      }
+      if (result_ != null) {
+        bitField0_ |= 0x00004000;
+        onChanged();
+      }
+      return this;
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public Builder clearResult() {
+      bitField0_ = (bitField0_ & ~0x00004000);
+      result_ = null;
+      if (resultBuilder_ != null) {
+        resultBuilder_.dispose();
+        resultBuilder_ = null;
+      }
+      onChanged();
+      return this;
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public com.google.protobuf.Struct.Builder getResultBuilder() {
+      bitField0_ |= 0x00004000;
+      onChanged();
+      return getResultFieldBuilder().getBuilder();
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    public com.google.protobuf.StructOrBuilder getResultOrBuilder() {
+      if (resultBuilder_ != null) {
+        return resultBuilder_.getMessageOrBuilder();
+      } else {
+        return result_ == null ?
+            com.google.protobuf.Struct.getDefaultInstance() : result_;
+      }
+    }
+    /**
+     * <pre>
+     * Result data once the run is terminal, as in RunResultResponse.result.
+     * </pre>
+     *
+     * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+     */
+    private com.google.protobuf.SingleFieldBuilder<
+        com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder> 
+        getResultFieldBuilder() {
+      if (resultBuilder_ == null) {
+        resultBuilder_ = new com.google.protobuf.SingleFieldBuilder<
+            com.google.protobuf.Struct, com.google.protobuf.Struct.Builder, com.google.protobuf.StructOrBuilder>(
+                getResult(),
+                getParentForChildren(),
+                isClean());
+        result_ = null;
+      }
+      return resultBuilder_;
+    }
+
     // @@protoc_insertion_point(builder_scope:steprpc.v1.RunStatusResponse)
   }
 
@@ -86,4 +86,202 @@ public interface RunStatusResponseOrBuilder extends
    * <code>.steprpc.v1.Error error = 6 [json_name = "error"];</code>
    */
   io.albertocavalcante.jenkins.steprpc.v1.ErrorOrBuilder getErrorOrBuilder();
+
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   * @return Whether the startedAt field is set.
+   */
+  boolean hasStartedAt();
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   * @return The startedAt.
+   */
+  com.google.protobuf.Timestamp getStartedAt();
+  /**
+   * <pre>
+   * When execution began: the direct step started, or a bridge worker first
+   * fetched the request. Unset while queued.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp started_at = 7 [json_name = "startedAt"];</code>
+   */
+  com.google.protobuf.TimestampOrBuilder getStartedAtOrBuilder();
+
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   * @return Whether the updatedAt field is set.
+   */
+  boolean hasUpdatedAt();
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   * @return The updatedAt.
+   */
+  com.google.protobuf.Timestamp getUpdatedAt();
+  /**
+   * <pre>
+   * Last change to the run's state, attempts, or worker.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp updated_at = 8 [json_name = "updatedAt"];</code>
+   */
+  com.google.protobuf.TimestampOrBuilder getUpdatedAtOrBuilder();
+
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   * @return Whether the completedAt field is set.
+   */
+  boolean hasCompletedAt();
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   * @return The completedAt.
+   */
+  com.google.protobuf.Timestamp getCompletedAt();
+  /**
+   * <pre>
+   * When the run reached a terminal state.
+   * </pre>
+   *
+   * <code>.google.protobuf.Timestamp completed_at = 9 [json_name = "completedAt"];</code>
+   */
+  com.google.protobuf.TimestampOrBuilder getCompletedAtOrBuilder();
+
+  /**
+   * <pre>
+   * Executions handed out: 1 for direct runs, one per bridge delivery.
+   * </pre>
+   *
+   * <code>int32 attempts = 10 [json_name = "attempts"];</code>
+   * @return The attempts.
+   */
+  int getAttempts();
+
+  /**
+   * <pre>
+   * Lane the run executes in.
+   * </pre>
+   *
+   * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+   * @return The enum numeric value on the wire for executionMode.
+   */
+  int getExecutionModeValue();
+  /**
+   * <pre>
+   * Lane the run executes in.
+   * </pre>
+   *
+   * <code>.steprpc.v1.OperationExecutionMode execution_mode = 11 [json_name = "executionMode"];</code>
+   * @return The executionMode.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode getExecutionMode();
+
+  /**
+   * <pre>
+   * Bridge worker that last fetched the request; empty for direct runs.
+   * </pre>
+   *
+   * <code>string worker_id = 12 [json_name = "workerId"];</code>
+   * @return The workerId.
+   */
+  java.lang.String getWorkerId();
+  /**
+   * <pre>
+   * Bridge worker that last fetched the request; empty for direct runs.
+   * </pre>
+   *
+   * <code>string worker_id = 12 [json_name = "workerId"];</code>
+   * @return The bytes for workerId.
+   */
+  com.google.protobuf.ByteString
+      getWorkerIdBytes();
+
+  /**
+   * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+   * @return The enum numeric value on the wire for runState.
+   */
+  int getRunStateValue();
+  /**
+   * <code>.steprpc.v1.RunState run_state = 13 [json_name = "runState"];</code>
+   * @return The runState.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunState getRunState();
+
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   * @return Whether the progress field is set.
+   */
+  boolean hasProgress();
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   * @return The progress.
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunProgress getProgress();
+  /**
+   * <pre>
+   * Last progress a bridge worker reported; unset when none has.
+   * </pre>
+   *
+   * <code>.steprpc.v1.RunProgress progress = 14 [json_name = "progress"];</code>
+   */
+  io.albertocavalcante.jenkins.steprpc.v1.RunProgressOrBuilder getProgressOrBuilder();
+
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   * @return Whether the result field is set.
+   */
+  boolean hasResult();
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   * @return The result.
+   */
+  com.google.protobuf.Struct getResult();
+  /**
+   * <pre>
+   * Result data once the run is terminal, as in RunResultResponse.result.
+   * </pre>
+   *
+   * <code>.google.protobuf.Struct result = 15 [json_name = "result"];</code>
+   */
+  com.google.protobuf.StructOrBuilder getResultOrBuilder();
 }
@@ -0,0 +1,228 @@
+// Package bridge services CPS bridge requests for every build on a controller.
+//
+// A Supervisor lists the runs with queued requests through ListBridgeRuns,
+// starts a worker loop for each, and retires the loop once the run has nothing
+// left to hand out. Workers claim requests under a lease with
+// ServeBridgeRequest, so several supervisors can share a controller. The
+// handler returns each request's result and may report progress under the
+// lease its context carries:
+//
+//	handle := func(ctx context.Context, req *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+//		_, _ = client.ReportBridgeProgress(ctx, &steprpcv1.BridgeProgressRequest{RunId: req.GetRunId(), Percent: 50})
+//		return map[string]any{"files": 3}, nil
+//	}
+//	sup := bridge.NewSupervisor(client, handle).
+//		WithFolder("team").
+//		WithConcurrency(8, 2)
+//	err := sup.Run(ctx)
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+)
+
+// DefaultDiscoveryInterval is how often a Supervisor lists runs.
+const DefaultDiscoveryInterval = 5 * time.Second
+
+// DefaultMaxConcurrency bounds the handlers a Supervisor runs at once.
+const DefaultMaxConcurrency = 8
+
+// Client is the part of *jenkinsrpc.Client a Supervisor uses.
+type Client interface {
+	ListBridgeRuns(ctx context.Context, folder string) (*steprpcv1.BridgeRunsResponse, error)
+	ServeBridgeRequest(ctx context.Context, runExternalizableID string, ttl time.Duration, handler jenkinsrpc.BridgeHandler) (*steprpcv1.BridgeCompleteResponse, error)
+}
+
+// ErrorHandler receives errors a Supervisor recovers from: failed discovery
+// polls, with an empty runExternalizableID, and worker loops that ended on an
+// error, such as a lost lease.
+type ErrorHandler func(runExternalizableID string, err error)
+
+// Supervisor discovers runs with queued CPS bridge requests and serves them
+// with one handler.
+//
+// Concurrency is bounded twice: at most maxPerRun workers serve one run, and
+// at most maxConcurrency handlers run in total. Workers give their slot back
+// after each request, so a run with a long queue takes turns with the others
+// instead of holding slots until it is drained.
+type Supervisor struct {
+	client         Client
+	handler        jenkinsrpc.BridgeHandler
+	folder         string
+	interval       time.Duration
+	maxConcurrency int
+	maxPerRun      int
+	leaseTTL       time.Duration
+	onError        ErrorHandler
+}
+
+// NewSupervisor returns a Supervisor that serves every run on the controller
+// with handler, one worker per run and DefaultMaxConcurrency handlers in total.
+func NewSupervisor(client Client, handler jenkinsrpc.BridgeHandler) *Supervisor {
+	return &Supervisor{
+		client:         client,
+		handler:        handler,
+		interval:       DefaultDiscoveryInterval,
+		maxConcurrency: DefaultMaxConcurrency,
+		maxPerRun:      1,
+	}
+}
+
+// WithFolder returns a copy that only serves jobs inside folder.
+func (s *Supervisor) WithFolder(folder string) *Supervisor {
+	cp := *s
+	cp.folder = folder
+	return &cp
+}
+
+// WithDiscoveryInterval returns a copy that lists runs every d.
+func (s *Supervisor) WithDiscoveryInterval(d time.Duration) *Supervisor {
+	cp := *s
+	if d > 0 {
+		cp.interval = d
+	}
+	return &cp
+}
+
+// WithConcurrency returns a copy that runs at most total handlers at once and
+// at most perRun for any one run. Values below 1 keep the current setting.
+func (s *Supervisor) WithConcurrency(total, perRun int) *Supervisor {
+	cp := *s
+	if total > 0 {
+		cp.maxConcurrency = total
+	}
+	if perRun > 0 {
+		cp.maxPerRun = perRun
+	}
+	return &cp
+}
+
+// WithLeaseTTL returns a copy that claims requests for ttl; see
+// Client.ServeBridgeRequest.
+func (s *Supervisor) WithLeaseTTL(ttl time.Duration) *Supervisor {
+	cp := *s
+	cp.leaseTTL = ttl
+	return &cp
+}
+
+// WithErrorHandler returns a copy that reports recovered errors to h.
+func (s *Supervisor) WithErrorHandler(h ErrorHandler) *Supervisor {
+	cp := *s
+	cp.onError = h
+	return &cp
+}
+
+// Run serves bridge requests until ctx ends, then waits for running handlers
+// and returns nil.
+//
+// Each discovery poll starts workers for listed runs with pending requests, up
+// to the per-run limit. A worker serves its run's requests one at a time and
+// exits once a claim finds nothing, or after reporting an error; the next poll
+// starts a new one if requests remain. Discovery failing with a network, rate
+// limit, or server error is reported and retried at the next poll. Any other
+// discovery error, such as a server without CapabilityBridgeDiscovery or a
+// denied request, stops the Supervisor and is returned.
+func (s *Supervisor) Run(ctx context.Context) error {
+	if s.handler == nil {
+		return fmt.Errorf("bridge handler is required")
+	}
+
+	slots := make(chan struct{}, s.maxConcurrency)
+	var mu sync.Mutex
+	workers := map[string]int{}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		runs, err := s.client.ListBridgeRuns(ctx, s.folder)
+		switch {
+		case err == nil:
+			for _, run := range runs.GetRuns() {
+				id := run.GetRunExternalizableId()
+				mu.Lock()
+				start := min(int(run.GetPending()), s.maxPerRun) - workers[id]
+				if start > 0 {
+					workers[id] += start
+				}
+				mu.Unlock()
+
+				for range start {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						s.serve(ctx, id, slots)
+						mu.Lock()
+						if workers[id]--; workers[id] <= 0 {
+							delete(workers, id)
+						}
+						mu.Unlock()
+					}()
+				}
+			}
+		case ctx.Err() != nil:
+		case transient(err):
+			s.report("", fmt.Errorf("discover bridge runs: %w", err))
+		default:
+			return fmt.Errorf("discover bridge runs: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// serve claims and handles the run's requests until none is pending, one per
+// slot.
+func (s *Supervisor) serve(ctx context.Context, runExternalizableID string, slots chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case slots <- struct{}{}:
+		}
+		_, err := s.client.ServeBridgeRequest(ctx, runExternalizableID, s.leaseTTL, s.handler)
+		<-slots
+
+		switch {
+		case err == nil:
+		case errors.Is(err, jenkinsrpc.ErrNoPendingRequest), ctx.Err() != nil:
+			return
+		default:
+			s.report(runExternalizableID, err)
+			return
+		}
+	}
+}
+
+func (s *Supervisor) report(runExternalizableID string, err error) {
+	if s.onError != nil {
+		s.onError(runExternalizableID, err)
+	}
+}
+
+// transient reports whether a discovery error may clear by itself. Errors
+// without an HTTP status count as network failures, except the client's own
+// capability check.
+func transient(err error) bool {
+	if errors.Is(err, jenkinsrpc.ErrCapabilityUnsupported) {
+		return false
+	}
+	switch jenkinsrpc.CategoryOf(err) {
+	case jenkinsrpc.CategoryNetwork, jenkinsrpc.CategoryRateLimited, jenkinsrpc.CategoryServerError:
+		return true
+	default:
+		return false
+	}
+}
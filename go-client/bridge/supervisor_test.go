@@ -0,0 +1,281 @@
+package bridge_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/bridge"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/emulator"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeClient keeps a count of queued requests per run and records peak
+// concurrency of handlers, overall and per run.
+type fakeClient struct {
+	mu       sync.Mutex
+	queued   map[string]int
+	listErr  []error
+	folders  []string
+	running  map[string]int
+	total    int
+	peak     int
+	peakRun  int
+	served   map[string]int
+	handling time.Duration
+}
+
+func newFakeClient(queued map[string]int) *fakeClient {
+	return &fakeClient{
+		queued:   queued,
+		running:  map[string]int{},
+		served:   map[string]int{},
+		handling: 5 * time.Millisecond,
+	}
+}
+
+func (f *fakeClient) ListBridgeRuns(_ context.Context, folder string) (*steprpcv1.BridgeRunsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.folders = append(f.folders, folder)
+	if len(f.listErr) > 0 {
+		err := f.listErr[0]
+		f.listErr = f.listErr[1:]
+		return nil, err
+	}
+	out := &steprpcv1.BridgeRunsResponse{}
+	for id, n := range f.queued {
+		if n > 0 {
+			out.Runs = append(out.Runs, &steprpcv1.BridgeRunSummary{RunExternalizableId: id, Pending: int32(n)})
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeClient) ServeBridgeRequest(ctx context.Context, id string, _ time.Duration, handler jenkinsrpc.BridgeHandler) (*steprpcv1.BridgeCompleteResponse, error) {
+	f.mu.Lock()
+	if f.queued[id] == 0 {
+		f.mu.Unlock()
+		return nil, jenkinsrpc.ErrNoPendingRequest
+	}
+	f.queued[id]--
+	f.running[id]++
+	f.total++
+	f.peak = max(f.peak, f.total)
+	f.peakRun = max(f.peakRun, f.running[id])
+	f.mu.Unlock()
+
+	time.Sleep(f.handling)
+	_, err := handler(ctx, &steprpcv1.BridgePendingResponse{RunId: id})
+
+	f.mu.Lock()
+	f.running[id]--
+	f.total--
+	f.served[id]++
+	f.mu.Unlock()
+	return &steprpcv1.BridgeCompleteResponse{RunId: id}, err
+}
+
+func (f *fakeClient) enqueue(id string, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queued[id] += n
+}
+
+func (f *fakeClient) servedCount(id string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.served[id]
+}
+
+// waitFor polls cond until it holds or a second passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func noop(context.Context, *steprpcv1.BridgePendingResponse) (map[string]any, error) { return nil, nil }
+
+func TestSupervisor_ServesDiscoveredRuns(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeClient(map[string]int{"team/a#1": 6, "team/b#4": 3, "team/c#2": 1})
+	sup := bridge.NewSupervisor(f, noop).
+		WithFolder("team").
+		WithDiscoveryInterval(10*time.Millisecond).
+		WithConcurrency(3, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- sup.Run(ctx) }()
+
+	waitFor(t, "initial queues to drain", func() bool {
+		return f.servedCount("team/a#1") == 6 && f.servedCount("team/b#4") == 3 && f.servedCount("team/c#2") == 1
+	})
+	// A run whose worker retired is picked up again by a later poll.
+	f.enqueue("team/c#2", 2)
+	waitFor(t, "new requests to be served", func() bool { return f.servedCount("team/c#2") == 3 })
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.peak > 3 || f.peakRun > 2 {
+		t.Fatalf("peak concurrency = %d total, %d per run; want at most 3 and 2", f.peak, f.peakRun)
+	}
+	if f.folders[0] != "team" {
+		t.Fatalf("folder = %q, want team", f.folders[0])
+	}
+}
+
+func TestSupervisor_DiscoveryErrors(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeClient(map[string]int{"job#1": 1})
+	f.listErr = []error{&jenkinsrpc.HTTPError{StatusCode: http.StatusServiceUnavailable}}
+
+	var mu sync.Mutex
+	var reported []error
+	sup := bridge.NewSupervisor(f, noop).
+		WithDiscoveryInterval(time.Millisecond).
+		WithErrorHandler(func(id string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if id == "" {
+				reported = append(reported, err)
+			}
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- sup.Run(ctx) }()
+	waitFor(t, "request after a failed poll", func() bool { return f.servedCount("job#1") == 1 })
+
+	f.mu.Lock()
+	f.listErr = []error{jenkinsrpc.ErrCapabilityUnsupported}
+	f.mu.Unlock()
+	select {
+	case err := <-done:
+		if !errors.Is(err, jenkinsrpc.ErrCapabilityUnsupported) {
+			t.Fatalf("Run() error = %v, want ErrCapabilityUnsupported", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Run() did not stop on a permanent discovery error")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reported) != 1 {
+		t.Fatalf("reported discovery errors = %v, want the 503", reported)
+	}
+}
+
+func TestSupervisor_ReportsWorkerErrors(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeClient(map[string]int{"job#1": 2})
+	lost := errors.New("lease lost")
+	var mu sync.Mutex
+	var failedRuns []string
+	sup := bridge.NewSupervisor(f, func(context.Context, *steprpcv1.BridgePendingResponse) (map[string]any, error) { return nil, lost }).
+		WithDiscoveryInterval(time.Millisecond).
+		WithErrorHandler(func(id string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if errors.Is(err, lost) {
+				failedRuns = append(failedRuns, id)
+			}
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- sup.Run(ctx) }()
+	waitFor(t, "both requests", func() bool { return f.servedCount("job#1") == 2 })
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(failedRuns) != 2 || failedRuns[0] != "job#1" {
+		t.Fatalf("reported worker errors for %v, want job#1 twice", failedRuns)
+	}
+}
+
+func TestSupervisor_ReportsProgressAndResult(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(emulator.New(emulator.DefaultScript()))
+	t.Cleanup(ts.Close)
+	c, err := jenkinsrpc.New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if c, err = c.Handshake(ctx); err != nil {
+		t.Fatalf("Handshake() error = %v", err)
+	}
+	args, err := jenkinsrpc.InjectRunContext(&structpb.Struct{}, jenkinsrpc.NewRunContextForBuild("team/app", 7, "agent-1", "/ws"))
+	if err != nil {
+		t.Fatalf("InjectRunContext() error = %v", err)
+	}
+	run, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "stash", Args: args})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+
+	sup := bridge.NewSupervisor(c, func(ctx context.Context, req *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+		if _, ok := jenkinsrpc.BridgeLeaseFromContext(ctx); !ok {
+			t.Errorf("handler context carries no lease")
+		}
+		if _, err := c.ReportBridgeProgress(ctx, &steprpcv1.BridgeProgressRequest{RunId: req.GetRunId(), Percent: 60, Message: "stashing"}); err != nil {
+			t.Errorf("ReportBridgeProgress() error = %v", err)
+		}
+		return map[string]any{"files": 3}, nil
+	}).WithDiscoveryInterval(time.Millisecond)
+	done := make(chan error, 1)
+	go func() { done <- sup.Run(ctx) }()
+
+	var status *steprpcv1.RunStatusResponse
+	waitFor(t, "the run to complete", func() bool {
+		status, err = c.GetRunStatus(ctx, run.GetRunId())
+		return err == nil && jenkinsrpc.IsTerminalState(status.GetState())
+	})
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if status.GetState() != "succeeded" || status.GetResult().GetFields()["files"].GetNumberValue() != 3 {
+		t.Fatalf("status = %v, want the handler's result", status)
+	}
+	if status.GetProgress().GetPercent() != 60 || status.GetProgress().GetMessage() != "stashing" {
+		t.Fatalf("progress = %v, want the handler's report", status.GetProgress())
+	}
+}
+
+func TestSupervisor_RequiresHandler(t *testing.T) {
+	t.Parallel()
+
+	if err := bridge.NewSupervisor(newFakeClient(nil), nil).Run(context.Background()); err == nil {
+		t.Fatalf("Run() error = nil, want handler required")
+	}
+}
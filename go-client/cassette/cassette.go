@@ -0,0 +1,235 @@
+// Package cassette records and replays Step RPC HTTP traffic.
+//
+// A Recorder wraps a real transport and captures request/response pairs with
+// credentials, crumbs, and sensitive invoke arguments redacted. A Replayer
+// serves a recorded cassette deterministically, so unit tests can exercise the
+// client against real controller responses without a controller.
+//
+// Both types are http.RoundTrippers and plug into the *http.Client passed to
+// jenkinsrpc.New.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/redact"
+)
+
+// FormatVersion is the cassette file format version written by Save.
+const FormatVersion = 1
+
+// redactedHeaders are replaced with redact.Mask in recorded requests and responses.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Jenkins-Crumb",
+}
+
+// Cassette is an ordered list of recorded interactions.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded shape of an outgoing request. Host and scheme are
+// dropped so a cassette replays against any base URL.
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is the recorded shape of a response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Load reads a cassette file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // cassette paths are chosen by the test author
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+	c := &Cassette{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("decode cassette %s: %w", path, err)
+	}
+	if c.Version != FormatVersion {
+		return nil, fmt.Errorf("cassette %s: unsupported version %d", path, c.Version)
+	}
+	return c, nil
+}
+
+// Save writes the cassette as indented JSON, creating parent directories.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create cassette dir: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+	return nil
+}
+
+// Recorder is an http.RoundTripper that forwards to a real transport and
+// records every completed exchange.
+type Recorder struct {
+	base http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+// NewRecorder returns a Recorder forwarding to base, or http.DefaultTransport when nil.
+func NewRecorder(base http.RoundTripper) *Recorder {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Recorder{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read request body: %w", err)
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := drainBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  req.URL.RawQuery,
+			Header: redactHeader(req.Header),
+			Body:   redactBody(reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       redactBody(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// Cassette returns a snapshot of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{
+		Version:      FormatVersion,
+		Interactions: append([]Interaction(nil), r.interactions...),
+	}
+}
+
+// Save writes the recorded interactions to path.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// drainBody reads *body fully and replaces it with an in-memory copy.
+func drainBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func redactHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	out := h.Clone()
+	for _, name := range redactedHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out.Set(name, redact.Mask)
+		}
+	}
+	return out
+}
+
+// redactBody masks sensitive values inside every "args" object of a JSON body,
+// and invoke callback secrets. Non-JSON bodies are recorded verbatim.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return string(body)
+	}
+	out, err := json.Marshal(redactArgs(doc))
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+func redactArgs(v any) any {
+	switch tv := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(tv))
+		for k, item := range tv {
+			if args, ok := item.(map[string]any); ok && k == "args" {
+				out[k] = redact.Args(args)
+				continue
+			}
+			if k == "callbackSecret" {
+				out[k] = redact.Mask
+				continue
+			}
+			out[k] = redactArgs(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(tv))
+		for i, item := range tv {
+			out[i] = redactArgs(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,139 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrNoInteraction is returned by Replayer when no unused recorded interaction
+// matches a request.
+var ErrNoInteraction = errors.New("cassette: no matching interaction")
+
+// MatchRule selects which parts of a request must equal the recording.
+type MatchRule struct {
+	Method bool
+	Path   bool
+	Query  bool
+	// Body compares JSON bodies after normalization: key order and whitespace
+	// are ignored, sensitive args are masked as on record, and IgnoreFields are
+	// removed from the top-level object. Non-JSON bodies are compared byte for byte.
+	Body         bool
+	IgnoreFields []string
+}
+
+// DefaultMatchRule matches method, path, query, and normalized protojson body,
+// ignoring the per-call request_id.
+var DefaultMatchRule = MatchRule{
+	Method:       true,
+	Path:         true,
+	Query:        true,
+	Body:         true,
+	IgnoreFields: []string{"requestId", "request_id"},
+}
+
+// Match reports whether the recorded request matches req with body.
+func (m MatchRule) Match(recorded Request, req *http.Request, body []byte) bool {
+	if m.Method && !strings.EqualFold(recorded.Method, req.Method) {
+		return false
+	}
+	if m.Path && recorded.Path != req.URL.Path {
+		return false
+	}
+	if m.Query && recorded.Query != req.URL.RawQuery {
+		return false
+	}
+	if m.Body && m.normalize([]byte(recorded.Body)) != m.normalize(body) {
+		return false
+	}
+	return true
+}
+
+func (m MatchRule) normalize(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return string(body)
+	}
+	doc = redactArgs(doc)
+	if obj, ok := doc.(map[string]any); ok {
+		for _, f := range m.IgnoreFields {
+			delete(obj, f)
+		}
+	}
+	// encoding/json writes map keys in sorted order.
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+// Replayer is an http.RoundTripper that serves recorded interactions. Each
+// interaction is served at most once, in recording order among matches, so
+// polling sequences (queued, then succeeded) replay faithfully.
+type Replayer struct {
+	rule MatchRule
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer serving c with rule.
+func NewReplayer(c *Cassette, rule MatchRule) *Replayer {
+	return &Replayer{
+		rule:         rule,
+		interactions: c.Interactions,
+		used:         make([]bool, len(c.Interactions)),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read request body: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.interactions {
+		if r.used[i] || !r.rule.Match(in.Request, req, body) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+// Remaining returns the number of interactions not yet served.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, u := range r.used {
+		if !u {
+			n++
+		}
+	}
+	return n
+}
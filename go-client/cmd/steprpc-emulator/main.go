@@ -0,0 +1,80 @@
+// Command steprpc-emulator serves the plugin's /step-rpc/v1/* API without a
+// Jenkins controller, for developing and testing clients and bridge workers.
+// Operations run as a script describes them; see emulator.Script for the
+// format. Without -script it serves a direct echo and a bridge-only stash.
+//
+// Usage:
+//
+//	steprpc-emulator [-listen :8080] [-script FILE] [-token TOKEN]
+//
+// GET /emulator/state reports runs and queued bridge requests, and
+// POST /emulator/reset clears them.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/emulator"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("steprpc-emulator", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	listen := fs.String("listen", ":8080", "HTTP listen address")
+	scriptPath := fs.String("script", "", "operation script file (default: built-in echo and stash)")
+	token := fs.String("token", "", "require this bearer token or basic auth password")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	fail := func(code int, err error) int {
+		_, _ = fmt.Fprintf(stderr, "steprpc-emulator: %v\n", err)
+		return code
+	}
+	script := emulator.DefaultScript()
+	if *scriptPath != "" {
+		var err error
+		if script, err = emulator.Load(*scriptPath); err != nil {
+			return fail(2, err)
+		}
+	}
+
+	handler := emulator.New(script)
+	if *token != "" {
+		handler = handler.WithToken(*token)
+	}
+	srv := &http.Server{
+		Addr:              *listen,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	_, _ = fmt.Fprintf(stderr, "steprpc-emulator: listening on %s with %d operations\n", *listen, len(script.Operations))
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fail(1, err)
+	}
+	return 0
+}
@@ -0,0 +1,69 @@
+// Command steprpc-gateway serves steprpc.v1.StepRpcService over gRPC and
+// forwards every call to a Jenkins Step RPC plugin over HTTP.
+//
+// Usage:
+//
+//	steprpc-gateway [-listen :9090] [-profile NAME] [-watch-interval 1s]
+//
+// The plugin connection comes from the jenkins-rpc config file and
+// JENKINS_RPC_* environment variables; see package config.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/config"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/gateway"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("steprpc-gateway", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	listen := fs.String("listen", ":9090", "gRPC listen address")
+	profile := fs.String("profile", "", "config profile (default: $JENKINS_RPC_PROFILE or currentProfile)")
+	watchInterval := fs.Duration("watch-interval", gateway.DefaultWatchInterval, "run status poll interval for WatchRun")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	client, _, err := config.NewClient(*profile)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "steprpc-gateway: %v\n", err)
+		return 2
+	}
+	lis, err := net.Listen("tcp", *listen)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "steprpc-gateway: %v\n", err)
+		return 1
+	}
+
+	srv := grpc.NewServer()
+	steprpcv1.RegisterStepRpcServiceServer(srv, gateway.New(client).WithWatchInterval(*watchInterval))
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	_, _ = fmt.Fprintf(stderr, "steprpc-gateway: listening on %s\n", lis.Addr())
+	if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		_, _ = fmt.Fprintf(stderr, "steprpc-gateway: %v\n", err)
+		return 1
+	}
+	return 0
+}
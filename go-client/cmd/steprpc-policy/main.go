@@ -0,0 +1,70 @@
+// Command steprpc-policy checks policy files.
+//
+// Usage:
+//
+//	steprpc-policy test -policy rules.yaml CASES.yaml...
+//
+// Each case file is a list of policy.TestCase. The exit status is 1 when any
+// case fails and 2 on usage or input errors.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/policy"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	usage := func() int {
+		_, _ = fmt.Fprintln(stderr, "usage: steprpc-policy test -policy FILE CASES...")
+		return 2
+	}
+	if len(args) == 0 || args[0] != "test" {
+		return usage()
+	}
+
+	fs := flag.NewFlagSet("steprpc-policy test", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	policyPath := fs.String("policy", "", "policy file")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if *policyPath == "" || fs.NArg() == 0 {
+		return usage()
+	}
+
+	p, err := policy.Load(*policyPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "steprpc-policy: %v\n", err)
+		return 2
+	}
+	failed, total := 0, 0
+	for _, path := range fs.Args() {
+		cases, err := policy.LoadTests(path)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "steprpc-policy: %s: %v\n", path, err)
+			return 2
+		}
+		for _, res := range p.RunTests(cases) {
+			total++
+			if res.Err != nil {
+				failed++
+				_, _ = fmt.Fprintf(stdout, "FAIL %s: %s: %v\n", path, res.Case.Name, res.Err)
+				continue
+			}
+			_, _ = fmt.Fprintf(stdout, "ok   %s: %s\n", path, res.Case.Name)
+		}
+	}
+	_, _ = fmt.Fprintf(stdout, "%d/%d passed\n", total-failed, total)
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
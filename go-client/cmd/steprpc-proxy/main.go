@@ -0,0 +1,117 @@
+// Command steprpc-proxy serves the plugin's /step-rpc/v1/* routes with its own
+// caller authentication, per-tenant policy, and audit trail, forwarding
+// allowed calls to a controller with a single service credential.
+//
+// Usage:
+//
+//	steprpc-proxy -config proxy.yaml [-listen :8080] [-profile NAME] [-audit FILE]
+//	              [-policy FILE] [-tls-cert FILE -tls-key FILE]
+//
+// The controller connection comes from the jenkins-rpc config file and
+// JENKINS_RPC_* environment variables; see package config. The proxy config
+// format is documented on proxy.Config.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/config"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/proxy"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/policy"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("steprpc-proxy", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	listen := fs.String("listen", ":8080", "HTTP listen address")
+	configPath := fs.String("config", "", "proxy config file (required)")
+	profile := fs.String("profile", "", "controller config profile (default: $JENKINS_RPC_PROFILE or currentProfile)")
+	auditPath := fs.String("audit", "", "append audit events to this file (default: stderr)")
+	policyPath := fs.String("policy", "", "policy file checked before every invoke")
+	tlsCert := fs.String("tls-cert", "", "serve HTTPS with this certificate")
+	tlsKey := fs.String("tls-key", "", "private key for -tls-cert")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *configPath == "" || (*tlsCert == "") != (*tlsKey == "") {
+		fs.Usage()
+		return 2
+	}
+
+	fail := func(code int, err error) int {
+		_, _ = fmt.Fprintf(stderr, "steprpc-proxy: %v\n", err)
+		return code
+	}
+	cfg, err := proxy.Load(*configPath)
+	if err != nil {
+		return fail(2, err)
+	}
+	auth, err := cfg.Authenticator()
+	if err != nil {
+		return fail(2, err)
+	}
+	var rules *policy.Policy
+	if *policyPath != "" {
+		if rules, err = policy.Load(*policyPath); err != nil {
+			return fail(2, err)
+		}
+	}
+	client, _, err := config.NewClient(*profile)
+	if err != nil {
+		return fail(2, err)
+	}
+
+	auditOut := stderr
+	if *auditPath != "" {
+		f, err := os.OpenFile(*auditPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec // path comes from operator flags
+		if err != nil {
+			return fail(1, err)
+		}
+		defer func() { _ = f.Close() }()
+		auditOut = f
+	}
+
+	handler := proxy.New(client, auth, cfg.Tenants).WithAudit(proxy.NewJSONAuditLog(auditOut))
+	if rules != nil {
+		handler = handler.WithPolicy(rules)
+	}
+	srv := &http.Server{
+		Addr:              *listen,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	_, _ = fmt.Fprintf(stderr, "steprpc-proxy: listening on %s\n", *listen)
+	if *tlsCert != "" {
+		err = srv.ListenAndServeTLS(*tlsCert, *tlsKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fail(1, err)
+	}
+	return 0
+}
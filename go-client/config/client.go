@@ -0,0 +1,196 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+)
+
+// NewClient loads the default config file, resolves profile (see
+// File.Resolve), and returns a configured client.
+func NewClient(profile string) (*jenkinsrpc.Client, *Profile, error) {
+	f, err := Load("")
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := f.Resolve(profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := p.NewClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, p, nil
+}
+
+// NewClient reads the profile's secrets and certificates and returns a client
+// with its auth, transport, retry, bridge poll, and rate limit settings applied.
+// Use PollPolicy for WaitRunTerminal calls.
+func (p *Profile) NewClient() (*jenkinsrpc.Client, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	token, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := p.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	bearer := ""
+	if p.authMethod() == AuthBearer {
+		bearer = token
+	}
+	c, err := jenkinsrpc.New(p.URL, bearer, httpClient)
+	if err != nil {
+		return nil, invalid(p.key("url"), "%w", err)
+	}
+	if p.authMethod() == AuthBasic {
+		c = c.WithBasicAuth(p.Auth.Username, token)
+	}
+
+	if r := p.Retry; r != nil {
+		c = c.WithRetryPolicy(&jenkinsrpc.RetryPolicy{
+			MaxAttempts:    r.MaxAttempts,
+			InitialBackoff: r.InitialBackoff,
+			MaxBackoff:     r.MaxBackoff,
+		})
+	}
+	if p.BridgePoll != nil {
+		c = c.WithBridgePollPolicy(p.BridgePoll.policy())
+	}
+	if rl := p.RateLimit; rl != nil {
+		c = c.WithRateLimit(rl.RequestsPerSecond, rl.Burst)
+	}
+	return c, nil
+}
+
+// PollPolicy returns the profile's poll settings, or the zero policy.
+func (p *Profile) PollPolicy() jenkinsrpc.PollPolicy {
+	if p.Poll == nil {
+		return jenkinsrpc.PollPolicy{}
+	}
+	return p.Poll.policy()
+}
+
+func (q *Poll) policy() jenkinsrpc.PollPolicy {
+	return jenkinsrpc.PollPolicy{
+		InitialInterval:  q.InitialInterval,
+		MaxInterval:      q.MaxInterval,
+		MaxAttempts:      q.MaxAttempts,
+		MaxDuration:      q.MaxDuration,
+		FailOnRunFailure: q.FailOnRunFailure,
+	}
+}
+
+// HTTPClient builds the *http.Client for the profile's TLS, proxy, and timeout settings.
+func (p *Profile) HTTPClient() (*http.Client, error) {
+	tlsConfig, err := p.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if p.Proxy != "" {
+		proxyURL, err := url.Parse(p.Proxy)
+		if err != nil {
+			return nil, invalid(p.key("proxy"), "%w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if p.Timeouts.Dial > 0 {
+		transport.DialContext = (&net.Dialer{Timeout: p.Timeouts.Dial}).DialContext
+	}
+	if p.Timeouts.TLSHandshake > 0 {
+		transport.TLSHandshakeTimeout = p.Timeouts.TLSHandshake
+	}
+	if p.Timeouts.ResponseHeader > 0 {
+		transport.ResponseHeaderTimeout = p.Timeouts.ResponseHeader
+	}
+
+	return &http.Client{Transport: transport, Timeout: p.Timeouts.Request}, nil
+}
+
+func (p *Profile) tlsConfig() (*tls.Config, error) {
+	var caPEM []byte
+	if p.TLS.CAFile != "" {
+		pem, err := os.ReadFile(p.TLS.CAFile) //nolint:gosec // path comes from operator config
+		if err != nil {
+			return nil, invalid(p.key("tls.caFile"), "%w", err)
+		}
+		caPEM = pem
+	}
+	minVersion, err := p.TLS.minVersion()
+	if err != nil {
+		return nil, invalid(p.key("tls.minVersion"), "%w", err)
+	}
+	cfg, err := jenkinsrpc.NewTLSConfig(jenkinsrpc.TLSOptions{
+		CAPEM:              caPEM,
+		CertFile:           p.TLS.CertFile,
+		KeyFile:            p.TLS.KeyFile,
+		MinVersion:         minVersion,
+		ServerName:         p.TLS.ServerName,
+		SPKIPins:           p.TLS.SPKIPins,
+		InsecureSkipVerify: p.TLS.InsecureSkipVerify,
+	})
+	if err != nil {
+		return nil, invalid(p.key("tls"), "%w", err)
+	}
+	return cfg, nil
+}
+
+func (t TLS) minVersion() (uint16, error) {
+	switch t.MinVersion {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("must be 1.2 or 1.3, got %q", t.MinVersion)
+	}
+}
+
+func (p *Profile) token() (string, error) {
+	a := p.Auth
+	switch {
+	case a.Token != "":
+		return a.Token, nil
+	case a.TokenEnv != "":
+		v, ok := os.LookupEnv(a.TokenEnv)
+		if !ok || v == "" {
+			return "", invalid(p.key("auth.tokenEnv"), "environment variable %s is not set", a.TokenEnv)
+		}
+		return v, nil
+	case a.TokenFile != "":
+		data, err := os.ReadFile(a.TokenFile) //nolint:gosec // path comes from operator config
+		if err != nil {
+			return "", invalid(p.key("auth.tokenFile"), "%w", err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", invalid(p.key("auth.tokenFile"), "%s is empty", a.TokenFile)
+		}
+		return token, nil
+	default:
+		return "", nil
+	}
+}
+
+// key names field in errors: the environment variable Resolve took it from, or
+// its path in the file.
+func (p *Profile) key(field string) string {
+	if env, ok := p.fromEnv[field]; ok {
+		return env
+	}
+	return fmt.Sprintf("profiles.%s.%s", p.Name, field)
+}
@@ -0,0 +1,511 @@
+// Package config builds configured clients from a profile file.
+//
+// The file is YAML (or JSON, which YAML accepts) with named controller
+// profiles and a current-profile selector, in the style of a kubeconfig:
+//
+//	currentProfile: prod
+//	profiles:
+//	  prod:
+//	    url: https://jenkins.example.com
+//	    auth:
+//	      method: basic
+//	      username: ci-bot
+//	      tokenEnv: JENKINS_API_TOKEN
+//	    tls:
+//	      caFile: /etc/ssl/corp-ca.pem
+//	    timeouts: {request: 30s, dial: 5s}
+//	    retry: {maxAttempts: 4, initialBackoff: 500ms, maxBackoff: 5s}
+//	    poll: {initialInterval: 2s, maxInterval: 30s, maxDuration: 30m}
+//	    rateLimit: {requestsPerSecond: 10, burst: 20}
+//
+// JENKINS_RPC_* environment variables override the selected profile; see
+// Resolve. Validation errors name the offending key, e.g.
+// "profiles.prod.tls.caFile: open /etc/ssl/corp-ca.pem: no such file or directory",
+// or the environment variable a bad value came from. Durations take a unit
+// ("30s"); a bare number is rejected.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by Load and Resolve.
+const (
+	EnvConfig    = "JENKINS_RPC_CONFIG"
+	EnvProfile   = "JENKINS_RPC_PROFILE"
+	EnvURL       = "JENKINS_RPC_URL"
+	EnvAuth      = "JENKINS_RPC_AUTH_METHOD"
+	EnvUsername  = "JENKINS_RPC_USERNAME"
+	EnvToken     = "JENKINS_RPC_TOKEN"
+	EnvTokenFile = "JENKINS_RPC_TOKEN_FILE"
+	EnvCAFile    = "JENKINS_RPC_CA_FILE"
+	EnvCertFile  = "JENKINS_RPC_CERT_FILE"
+	EnvKeyFile   = "JENKINS_RPC_KEY_FILE"
+	EnvInsecure  = "JENKINS_RPC_INSECURE_SKIP_VERIFY"
+	EnvProxy     = "JENKINS_RPC_PROXY"
+	EnvTimeout   = "JENKINS_RPC_TIMEOUT"
+)
+
+// envProfileName is the profile name reported when no file exists and the
+// profile comes from the environment alone.
+const envProfileName = "env"
+
+// Auth methods.
+const (
+	AuthNone   = "none"
+	AuthBearer = "bearer"
+	AuthBasic  = "basic"
+)
+
+// ErrNoProfile is returned by Resolve when no profile is selected and none can
+// be built from the environment.
+var ErrNoProfile = errors.New("no profile selected")
+
+// ValidationError reports an invalid value at a dotted key path.
+type ValidationError struct {
+	Key string
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Key + ": " + e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func invalid(key, format string, args ...any) error {
+	return &ValidationError{Key: key, Err: fmt.Errorf(format, args...)}
+}
+
+// File is a parsed configuration file.
+type File struct {
+	CurrentProfile string             `yaml:"currentProfile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+// Profile configures a client for one controller.
+type Profile struct {
+	// Name is the profile's key in File.Profiles, set by Resolve.
+	Name string `yaml:"-"`
+
+	URL        string     `yaml:"url"`
+	Auth       Auth       `yaml:"auth"`
+	TLS        TLS        `yaml:"tls"`
+	Proxy      string     `yaml:"proxy"`
+	Timeouts   Timeouts   `yaml:"timeouts"`
+	Retry      *Retry     `yaml:"retry"`
+	Poll       *Poll      `yaml:"poll"`
+	BridgePoll *Poll      `yaml:"bridgePoll"`
+	RateLimit  *RateLimit `yaml:"rateLimit"`
+
+	// fromEnv maps the keys Resolve took from the environment to their
+	// variables, so errors name where a bad value came from.
+	fromEnv map[string]string
+}
+
+// Auth selects the authentication method and where its secret comes from.
+// At most one of Token, TokenEnv, and TokenFile may be set.
+type Auth struct {
+	// Method is none, bearer, or basic. It defaults to bearer when a token
+	// source is set and none otherwise.
+	Method   string `yaml:"method"`
+	Username string `yaml:"username"`
+	Token    string `yaml:"token"`
+	TokenEnv string `yaml:"tokenEnv"`
+	// TokenFile is read at client construction; surrounding whitespace is trimmed.
+	TokenFile string `yaml:"tokenFile"`
+}
+
+// TLS configures server verification and client certificates.
+type TLS struct {
+	// CAFile is a PEM bundle trusted in addition to the system roots.
+	CAFile   string `yaml:"caFile"`
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+	// ServerName overrides the name verified against the server certificate.
+	ServerName string `yaml:"serverName"`
+	// MinVersion is "1.2" (the default) or "1.3".
+	MinVersion string `yaml:"minVersion"`
+	// SPKIPins are "sha256/<base64>" digests; the server chain must match one.
+	SPKIPins           []string `yaml:"spkiPins"`
+	InsecureSkipVerify bool     `yaml:"insecureSkipVerify"`
+}
+
+// Timeouts bound the phases of an HTTP request. Zero leaves the Go default.
+type Timeouts struct {
+	// Request bounds a whole request, including reading the response body.
+	Request        time.Duration `yaml:"request"`
+	Dial           time.Duration `yaml:"dial"`
+	TLSHandshake   time.Duration `yaml:"tlsHandshake"`
+	ResponseHeader time.Duration `yaml:"responseHeader"`
+}
+
+// Retry mirrors jenkinsrpc.RetryPolicy.
+type Retry struct {
+	MaxAttempts    int           `yaml:"maxAttempts"`
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+}
+
+// Poll mirrors jenkinsrpc.PollPolicy.
+type Poll struct {
+	InitialInterval  time.Duration `yaml:"initialInterval"`
+	MaxInterval      time.Duration `yaml:"maxInterval"`
+	MaxAttempts      int           `yaml:"maxAttempts"`
+	MaxDuration      time.Duration `yaml:"maxDuration"`
+	FailOnRunFailure bool          `yaml:"failOnRunFailure"`
+}
+
+// RateLimit caps the client's request rate.
+type RateLimit struct {
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+	Burst             int     `yaml:"burst"`
+}
+
+// DefaultPath returns $JENKINS_RPC_CONFIG, or jenkins-rpc/config.yaml under
+// the user config directory.
+func DefaultPath() (string, error) {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate config dir: %w", err)
+	}
+	return filepath.Join(dir, "jenkins-rpc", "config.yaml"), nil
+}
+
+// Load reads the file at path, or at DefaultPath when path is empty. A missing
+// default file yields an empty File so environment-only setups work.
+func Load(path string) (*File, error) {
+	explicit := path != ""
+	if !explicit {
+		var err error
+		if path, err = DefaultPath(); err != nil {
+			return nil, err
+		}
+		explicit = os.Getenv(EnvConfig) != ""
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // config path is chosen by the operator
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return &File{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Parse decodes a YAML or JSON configuration. Unknown keys are rejected, as
+// are bare numbers for durations.
+func Parse(data []byte) (*File, error) {
+	f := &File{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return f, nil
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode config: %w", err)
+	}
+	if err := checkDurations(&doc); err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(f); err != nil {
+		return nil, fmt.Errorf("decode config: %w", err)
+	}
+	return f, nil
+}
+
+// durationKeys are a profile's duration fields, relative to the profile.
+var durationKeys = map[string]bool{
+	"timeouts.request":           true,
+	"timeouts.dial":              true,
+	"timeouts.tlsHandshake":      true,
+	"timeouts.responseHeader":    true,
+	"retry.initialBackoff":       true,
+	"retry.maxBackoff":           true,
+	"poll.initialInterval":       true,
+	"poll.maxInterval":           true,
+	"poll.maxDuration":           true,
+	"bridgePoll.initialInterval": true,
+	"bridgePoll.maxInterval":     true,
+	"bridgePoll.maxDuration":     true,
+}
+
+// checkDurations rejects bare numbers for duration fields, naming the key, so
+// "request: 30" is never read as 30ns and the error says where it is.
+func checkDurations(doc *yaml.Node) error {
+	root := doc
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	profiles := mappingValue(root, "profiles")
+	if profiles == nil || profiles.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(profiles.Content); i += 2 {
+		for _, section := range []string{"timeouts", "retry", "poll", "bridgePoll"} {
+			fields := mappingValue(profiles.Content[i+1], section)
+			if fields == nil || fields.Kind != yaml.MappingNode {
+				continue
+			}
+			for j := 0; j+1 < len(fields.Content); j += 2 {
+				rel := section + "." + fields.Content[j].Value
+				v := fields.Content[j+1]
+				if !durationKeys[rel] || v.Kind != yaml.ScalarNode {
+					continue
+				}
+				if tag := v.ShortTag(); tag == "!!int" || tag == "!!float" {
+					return invalid("profiles."+profiles.Content[i].Value+"."+rel, "duration %s needs a unit, e.g. %ss", v.Value, v.Value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// mappingValue returns the value of key in mapping node n, or nil.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// Resolve selects a profile and applies environment overrides. The name is the
+// first non-empty of name, $JENKINS_RPC_PROFILE, and CurrentProfile; with none
+// set, a file holding a single profile selects it. When the file has no
+// profiles and $JENKINS_RPC_URL is set, a profile named "env" is built from the
+// environment alone.
+//
+// The returned profile is validated; secrets are not read until NewClient.
+func (f *File) Resolve(name string) (*Profile, error) {
+	key := "currentProfile"
+	switch {
+	case name != "":
+		key = "profile"
+	case os.Getenv(EnvProfile) != "":
+		name, key = os.Getenv(EnvProfile), EnvProfile
+	default:
+		name = f.CurrentProfile
+	}
+
+	var p Profile
+	switch {
+	case name != "":
+		found, ok := f.Profiles[name]
+		if !ok {
+			return nil, invalid(key, "profile %q not found (have %s)", name, strings.Join(f.profileNames(), ", "))
+		}
+		p = found
+	case len(f.Profiles) == 0 && os.Getenv(EnvURL) != "":
+		name = envProfileName
+	case len(f.Profiles) == 1:
+		for only, found := range f.Profiles {
+			name, p = only, found
+		}
+	default:
+		return nil, ErrNoProfile
+	}
+	p.Name = name
+
+	if err := p.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (f *File) profileNames() []string {
+	names := make([]string, 0, len(f.Profiles))
+	for n := range f.Profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p *Profile) applyEnv() error {
+	p.fromEnv = map[string]string{}
+	setString := func(env, key string, dst *string) {
+		if v, ok := os.LookupEnv(env); ok {
+			*dst = v
+			p.fromEnv[key] = env
+		}
+	}
+	setString(EnvURL, "url", &p.URL)
+	setString(EnvAuth, "auth.method", &p.Auth.Method)
+	setString(EnvUsername, "auth.username", &p.Auth.Username)
+	setString(EnvCAFile, "tls.caFile", &p.TLS.CAFile)
+	setString(EnvCertFile, "tls.certFile", &p.TLS.CertFile)
+	setString(EnvKeyFile, "tls.keyFile", &p.TLS.KeyFile)
+	setString(EnvProxy, "proxy", &p.Proxy)
+
+	// A token from the environment replaces whatever source the file names.
+	if v, ok := os.LookupEnv(EnvToken); ok {
+		p.Auth.Token, p.Auth.TokenEnv, p.Auth.TokenFile = v, "", ""
+		p.fromEnv["auth.token"] = EnvToken
+	} else if v, ok := os.LookupEnv(EnvTokenFile); ok {
+		p.Auth.Token, p.Auth.TokenEnv, p.Auth.TokenFile = "", "", v
+		p.fromEnv["auth.tokenFile"] = EnvTokenFile
+	}
+
+	if v, ok := os.LookupEnv(EnvInsecure); ok {
+		switch strings.ToLower(v) {
+		case "1", "true", "yes":
+			p.TLS.InsecureSkipVerify = true
+		case "0", "false", "no", "":
+			p.TLS.InsecureSkipVerify = false
+		default:
+			return invalid(EnvInsecure, "must be true or false, got %q", v)
+		}
+	}
+	if v, ok := os.LookupEnv(EnvTimeout); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return invalid(EnvTimeout, "%w", err)
+		}
+		p.Timeouts.Request = d
+		p.fromEnv["timeouts.request"] = EnvTimeout
+	}
+	return nil
+}
+
+// Validate checks the profile without touching the filesystem or network.
+func (p *Profile) Validate() error {
+	if strings.TrimSpace(p.URL) == "" {
+		return invalid(p.key("url"), "is required")
+	}
+	if err := validateURL(p.URL); err != nil {
+		return invalid(p.key("url"), "%w", err)
+	}
+	if p.Proxy != "" {
+		if err := validateURL(p.Proxy); err != nil {
+			return invalid(p.key("proxy"), "%w", err)
+		}
+	}
+
+	sources := 0
+	for _, s := range []string{p.Auth.Token, p.Auth.TokenEnv, p.Auth.TokenFile} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return invalid(p.key("auth"), "set only one of token, tokenEnv, tokenFile")
+	}
+	switch p.authMethod() {
+	case AuthNone:
+		if sources > 0 {
+			return invalid(p.key("auth.method"), "none does not take a token")
+		}
+	case AuthBearer:
+		if sources == 0 {
+			return invalid(p.key("auth"), "bearer needs one of token, tokenEnv, tokenFile")
+		}
+	case AuthBasic:
+		if p.Auth.Username == "" {
+			return invalid(p.key("auth.username"), "is required for basic auth")
+		}
+		if sources == 0 {
+			return invalid(p.key("auth"), "basic needs one of token, tokenEnv, tokenFile")
+		}
+	default:
+		return invalid(p.key("auth.method"), "must be none, bearer, or basic, got %q", p.Auth.Method)
+	}
+
+	if (p.TLS.CertFile == "") != (p.TLS.KeyFile == "") {
+		return invalid(p.key("tls"), "certFile and keyFile must be set together")
+	}
+	if _, err := p.TLS.minVersion(); err != nil {
+		return invalid(p.key("tls.minVersion"), "%w", err)
+	}
+
+	for _, t := range []struct {
+		key string
+		d   time.Duration
+	}{
+		{"timeouts.request", p.Timeouts.Request},
+		{"timeouts.dial", p.Timeouts.Dial},
+		{"timeouts.tlsHandshake", p.Timeouts.TLSHandshake},
+		{"timeouts.responseHeader", p.Timeouts.ResponseHeader},
+	} {
+		if t.d < 0 {
+			return invalid(p.key(t.key), "must not be negative")
+		}
+	}
+	if r := p.Retry; r != nil {
+		if r.MaxAttempts < 0 || r.InitialBackoff < 0 || r.MaxBackoff < 0 {
+			return invalid(p.key("retry"), "values must not be negative")
+		}
+		if r.MaxBackoff > 0 && r.MaxBackoff < r.InitialBackoff {
+			return invalid(p.key("retry.maxBackoff"), "must be >= initialBackoff")
+		}
+	}
+	for _, poll := range []struct {
+		key string
+		p   *Poll
+	}{{"poll", p.Poll}, {"bridgePoll", p.BridgePoll}} {
+		if q := poll.p; q != nil && (q.InitialInterval < 0 || q.MaxInterval < 0 || q.MaxAttempts < 0 || q.MaxDuration < 0) {
+			return invalid(p.key(poll.key), "values must not be negative")
+		}
+	}
+	if rl := p.RateLimit; rl != nil {
+		if rl.RequestsPerSecond <= 0 {
+			return invalid(p.key("rateLimit.requestsPerSecond"), "must be positive")
+		}
+		if rl.Burst < 0 {
+			return invalid(p.key("rateLimit.burst"), "must not be negative")
+		}
+	}
+	return nil
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	return nil
+}
+
+func (p *Profile) authMethod() string {
+	if p.Auth.Method != "" {
+		return strings.ToLower(p.Auth.Method)
+	}
+	if p.Auth.Token != "" || p.Auth.TokenEnv != "" || p.Auth.TokenFile != "" {
+		return AuthBearer
+	}
+	return AuthNone
+}
@@ -0,0 +1,315 @@
+package config_test
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/config"
+)
+
+const sampleConfig = `
+currentProfile: prod
+profiles:
+  prod:
+    url: https://jenkins.example.com
+    auth:
+      method: basic
+      username: ci-bot
+      tokenEnv: TEST_JENKINS_TOKEN
+    timeouts: {request: 30s, dial: 5s}
+    retry: {maxAttempts: 4, initialBackoff: 500ms, maxBackoff: 5s}
+    poll: {initialInterval: 2s, maxInterval: 30s, maxDuration: 30m, failOnRunFailure: true}
+    rateLimit: {requestsPerSecond: 10, burst: 20}
+  dev:
+    url: http://localhost:8080
+`
+
+// clearEnv unsets every JENKINS_RPC_* variable for the test.
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, kv := range os.Environ() {
+		if name, _, _ := strings.Cut(kv, "="); strings.HasPrefix(name, "JENKINS_RPC_") {
+			t.Setenv(name, "")
+			_ = os.Unsetenv(name)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	clearEnv(t)
+
+	f, err := config.Parse([]byte(sampleConfig))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	p, err := f.Resolve("")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if p.Name != "prod" || p.Auth.Username != "ci-bot" || p.Timeouts.Request != 30*time.Second {
+		t.Fatalf("profile = %+v", p)
+	}
+	poll := p.PollPolicy()
+	if poll.MaxDuration != 30*time.Minute || !poll.FailOnRunFailure {
+		t.Fatalf("PollPolicy() = %+v", poll)
+	}
+
+	t.Setenv(config.EnvProfile, "dev")
+	t.Setenv(config.EnvURL, "http://jenkins.internal:8080")
+	t.Setenv(config.EnvToken, "env-token")
+	t.Setenv(config.EnvTimeout, "3s")
+	p, err = f.Resolve("")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if p.Name != "dev" || p.URL != "http://jenkins.internal:8080" || p.Auth.Token != "env-token" || p.Timeouts.Request != 3*time.Second {
+		t.Fatalf("overridden profile = %+v", p)
+	}
+
+	if p, err = f.Resolve("prod"); err != nil || p.Name != "prod" {
+		t.Fatalf("Resolve(prod) = %+v, %v", p, err)
+	}
+	// An environment token replaces the file's tokenEnv source.
+	if p.Auth.Token != "env-token" || p.Auth.TokenEnv != "" {
+		t.Fatalf("auth = %+v, want env token to win", p.Auth)
+	}
+
+	var verr *config.ValidationError
+	if _, err := f.Resolve("staging"); !errors.As(err, &verr) || verr.Key != "profile" {
+		t.Fatalf("Resolve(staging) error = %v, want ValidationError at profile", err)
+	}
+}
+
+func TestResolve_EnvOnly(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(config.EnvURL, "https://ci.example.com")
+
+	p, err := (&config.File{}).Resolve("")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if p.Name != "env" || p.URL != "https://ci.example.com" {
+		t.Fatalf("profile = %+v", p)
+	}
+
+	clearEnv(t)
+	if _, err := (&config.File{}).Resolve(""); !errors.Is(err, config.ErrNoProfile) {
+		t.Fatalf("Resolve() error = %v, want ErrNoProfile", err)
+	}
+}
+
+func TestParse_RejectsUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := config.Parse([]byte("profiles:\n  prod:\n    url: https://x\n    tiemouts: {request: 1s}\n"))
+	if err == nil || !strings.Contains(err.Error(), "tiemouts") {
+		t.Fatalf("Parse() error = %v, want unknown field tiemouts", err)
+	}
+}
+
+func TestParse_JSON(t *testing.T) {
+	t.Parallel()
+
+	f, err := config.Parse([]byte(`{"currentProfile":"a","profiles":{"a":{"url":"https://a.example.com","timeouts":{"request":"10s"}}}}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := f.Profiles["a"].Timeouts.Request; got != 10*time.Second {
+		t.Fatalf("timeout = %v, want 10s", got)
+	}
+}
+
+func TestParse_RejectsUnitlessDurations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+		key  string
+	}{
+		{"yaml int", "profiles:\n  prod:\n    url: https://x\n    timeouts: {request: 30}\n", "profiles.prod.timeouts.request"},
+		{"yaml float", "profiles:\n  prod:\n    url: https://x\n    poll: {maxInterval: 1.5}\n", "profiles.prod.poll.maxInterval"},
+		{"json number", `{"profiles":{"a":{"url":"https://x","retry":{"initialBackoff":250}}}}`, "profiles.a.retry.initialBackoff"},
+		{"zero", "profiles:\n  prod:\n    url: https://x\n    bridgePoll: {maxDuration: 0}\n", "profiles.prod.bridgePoll.maxDuration"},
+	}
+	for _, tt := range tests {
+		var verr *config.ValidationError
+		if _, err := config.Parse([]byte(tt.data)); !errors.As(err, &verr) || verr.Key != tt.key {
+			t.Fatalf("%s: Parse() error = %v, want ValidationError at %s", tt.name, err, tt.key)
+		}
+	}
+
+	f, err := config.Parse([]byte("profiles:\n  prod:\n    url: https://x\n    timeouts: {request: 0s, dial: 5s}\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := f.Profiles["prod"].Timeouts.Dial; got != 5*time.Second {
+		t.Fatalf("dial = %v, want 5s", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		profile config.Profile
+		key     string
+	}{
+		{"missing url", config.Profile{}, "profiles.p.url"},
+		{"bad scheme", config.Profile{URL: "ftp://x"}, "profiles.p.url"},
+		{"bad proxy", config.Profile{URL: "https://x", Proxy: "://"}, "profiles.p.proxy"},
+		{"two token sources", config.Profile{URL: "https://x", Auth: config.Auth{Token: "a", TokenEnv: "B"}}, "profiles.p.auth"},
+		{"basic without username", config.Profile{URL: "https://x", Auth: config.Auth{Method: "basic", Token: "a"}}, "profiles.p.auth.username"},
+		{"unknown method", config.Profile{URL: "https://x", Auth: config.Auth{Method: "oauth"}}, "profiles.p.auth.method"},
+		{"bearer without token", config.Profile{URL: "https://x", Auth: config.Auth{Method: "bearer"}}, "profiles.p.auth"},
+		{"cert without key", config.Profile{URL: "https://x", TLS: config.TLS{CertFile: "c.pem"}}, "profiles.p.tls"},
+		{"bad tls version", config.Profile{URL: "https://x", TLS: config.TLS{MinVersion: "1.1"}}, "profiles.p.tls.minVersion"},
+		{"negative timeout", config.Profile{URL: "https://x", Timeouts: config.Timeouts{Dial: -time.Second}}, "profiles.p.timeouts.dial"},
+		{"backoff order", config.Profile{URL: "https://x", Retry: &config.Retry{InitialBackoff: time.Second, MaxBackoff: time.Millisecond}}, "profiles.p.retry.maxBackoff"},
+		{"zero rate", config.Profile{URL: "https://x", RateLimit: &config.RateLimit{}}, "profiles.p.rateLimit.requestsPerSecond"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.profile.Name = "p"
+			var verr *config.ValidationError
+			if err := tt.profile.Validate(); !errors.As(err, &verr) || verr.Key != tt.key {
+				t.Fatalf("Validate() error = %v, want ValidationError at %s", err, tt.key)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	clearEnv(t)
+
+	var auth atomic.Value
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth.Store(r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operations":[]}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("api-token\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	p := &config.Profile{
+		Name: "test",
+		URL:  ts.URL,
+		Auth: config.Auth{Method: "basic", Username: "ci-bot", TokenFile: tokenFile},
+		TLS:  config.TLS{CAFile: caFile},
+		Retry: &config.Retry{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+		},
+		RateLimit: &config.RateLimit{RequestsPerSecond: 100, Burst: 1},
+	}
+	c, err := p.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	if got := auth.Load(); got != "Basic Y2ktYm90OmFwaS10b2tlbg==" {
+		t.Fatalf("Authorization = %q, want basic ci-bot:api-token", got)
+	}
+
+	// Without the CA bundle the self-signed server is rejected.
+	p.TLS.CAFile = ""
+	c, err = p.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.GetCatalog(context.Background()); err == nil {
+		t.Fatalf("GetCatalog() error = nil, want certificate error")
+	}
+}
+
+func TestNewClient_SecretErrorsNameKey(t *testing.T) {
+	clearEnv(t)
+
+	tests := []struct {
+		name string
+		auth config.Auth
+		tls  config.TLS
+		key  string
+	}{
+		{"unset token env", config.Auth{TokenEnv: "TEST_JENKINS_RPC_MISSING"}, config.TLS{}, "profiles.p.auth.tokenEnv"},
+		{"missing token file", config.Auth{TokenFile: "/nonexistent/token"}, config.TLS{}, "profiles.p.auth.tokenFile"},
+		{"missing ca file", config.Auth{}, config.TLS{CAFile: "/nonexistent/ca.pem"}, "profiles.p.tls.caFile"},
+		{"bad pin", config.Auth{}, config.TLS{SPKIPins: []string{"sha256/short"}}, "profiles.p.tls"},
+	}
+	for _, tt := range tests {
+		p := &config.Profile{Name: "p", URL: "https://x.example.com", Auth: tt.auth, TLS: tt.tls}
+		var verr *config.ValidationError
+		if _, err := p.NewClient(); !errors.As(err, &verr) || verr.Key != tt.key {
+			t.Fatalf("%s: NewClient() error = %v, want ValidationError at %s", tt.name, err, tt.key)
+		}
+	}
+}
+
+func TestErrorsNameEnvOverrides(t *testing.T) {
+	clearEnv(t)
+	f := &config.File{Profiles: map[string]config.Profile{"prod": {URL: "https://ci.example.com"}}}
+
+	t.Setenv(config.EnvURL, "ftp://ci.example.com")
+	var verr *config.ValidationError
+	if _, err := f.Resolve("prod"); !errors.As(err, &verr) || verr.Key != config.EnvURL {
+		t.Fatalf("Resolve() error = %v, want ValidationError at %s", err, config.EnvURL)
+	}
+
+	clearEnv(t)
+	t.Setenv(config.EnvTokenFile, "/nonexistent/token")
+	p, err := f.Resolve("prod")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if _, err := p.NewClient(); !errors.As(err, &verr) || verr.Key != config.EnvTokenFile {
+		t.Fatalf("NewClient() error = %v, want ValidationError at %s", err, config.EnvTokenFile)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	clearEnv(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(sampleConfig), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	t.Setenv(config.EnvConfig, path)
+	t.Setenv("TEST_JENKINS_TOKEN", "from-env")
+
+	c, p, err := config.NewClient("")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if c == nil || p.Name != "prod" {
+		t.Fatalf("NewClient() = %v, %+v", c, p)
+	}
+
+	t.Setenv(config.EnvConfig, filepath.Join(t.TempDir(), "missing.yaml"))
+	if _, err := config.Load(""); err == nil {
+		t.Fatalf("Load() error = nil, want missing explicit file error")
+	}
+}
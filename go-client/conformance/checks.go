@@ -0,0 +1,371 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// decodeOptions accept fields added after this contract version.
+var decodeOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+type suite struct {
+	cfg    Config
+	client *http.Client
+	// prefix makes request ids unique to one Run, so the suite can repeat
+	// against a long-lived server.
+	prefix string
+}
+
+// response is one HTTP exchange.
+type response struct {
+	status      int
+	contentType string
+	body        []byte
+}
+
+func (s *suite) do(t *testing.T, method, path, body string) response {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(t.Context(), method, s.cfg.BaseURL+"/step-rpc/v1/"+path, reader)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case s.cfg.Username != "":
+		req.SetBasicAuth(s.cfg.Username, s.cfg.Token)
+	case s.cfg.Token != "":
+		req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("%s %s: read body: %v", method, path, err)
+	}
+	return response{status: res.StatusCode, contentType: res.Header.Get("Content-Type"), body: data}
+}
+
+// ok checks for a 200 JSON response and decodes it into out.
+func ok(t *testing.T, what string, res response, out proto.Message) {
+	t.Helper()
+	if res.status != http.StatusOK {
+		t.Fatalf("%s: status = %d, want 200; body %s", what, res.status, res.body)
+	}
+	if !strings.HasPrefix(res.contentType, "application/json") {
+		t.Fatalf("%s: Content-Type = %q, want application/json", what, res.contentType)
+	}
+	if err := decodeOptions.Unmarshal(res.body, out); err != nil {
+		t.Fatalf("%s: decode %s: %v", what, res.body, err)
+	}
+}
+
+// wantError checks that res is status with the {"error": {...}} body the
+// plugin sends: a JSON object whose only key is error, holding a string code
+// equal to code, a non-empty message, and string details if any.
+func wantError(t *testing.T, what string, res response, status int, code jenkinsrpc.ErrorCode) {
+	t.Helper()
+	if res.status != status {
+		t.Fatalf("%s: status = %d, want %d; body %s", what, res.status, status, res.body)
+	}
+	if !strings.HasPrefix(res.contentType, "application/json") {
+		t.Fatalf("%s: Content-Type = %q, want application/json", what, res.contentType)
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(res.body, &envelope); err != nil || len(envelope) != 1 || envelope["error"] == nil {
+		t.Fatalf("%s: body %s, want an object with only an error field", what, res.body)
+	}
+	var e struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Details map[string]string `json:"details"`
+	}
+	if err := json.Unmarshal(envelope["error"], &e); err != nil {
+		t.Fatalf("%s: error %s: %v", what, envelope["error"], err)
+	}
+	if e.Code != string(code) || e.Message == "" {
+		t.Fatalf("%s: error = %s, want code %s with a message", what, envelope["error"], code)
+	}
+}
+
+func (s *suite) requestID(name string) string {
+	return s.prefix + "-" + name
+}
+
+func (s *suite) invokeBody(t *testing.T, requestID, operation string, args map[string]any) string {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"requestId": requestID, "operation": operation, "args": args})
+	if err != nil {
+		t.Fatalf("marshal invoke request: %v", err)
+	}
+	return string(body)
+}
+
+func (s *suite) invoke(t *testing.T, requestID, operation string, args map[string]any) response {
+	t.Helper()
+	return s.do(t, http.MethodPost, "invoke", s.invokeBody(t, requestID, operation, args))
+}
+
+func (s *suite) status(t *testing.T, runID string) *steprpcv1.RunStatusResponse {
+	t.Helper()
+	out := &steprpcv1.RunStatusResponse{}
+	ok(t, "run status", s.do(t, http.MethodGet, "runs/"+url.PathEscape(runID), ""), out)
+	if out.GetRunId() != runID {
+		t.Fatalf("run status runId = %q, want %q", out.GetRunId(), runID)
+	}
+	return out
+}
+
+// stateOf checks that a message's state string and runState enum agree and
+// returns the state.
+func stateOf(t *testing.T, what string, msg jenkinsrpc.RunStateMessage) jenkinsrpc.RunState {
+	t.Helper()
+	legacy := jenkinsrpc.ParseRunState(msg.GetState())
+	if legacy == jenkinsrpc.RunStateUnknown {
+		t.Fatalf("%s: state = %q, want a known run state", what, msg.GetState())
+	}
+	if enum := msg.GetRunState(); enum != steprpcv1.RunState_RUN_STATE_UNSPECIFIED && jenkinsrpc.RunStateFromProto(enum) != legacy {
+		t.Fatalf("%s: runState = %v, state = %q; want them to agree", what, enum, msg.GetState())
+	}
+	return legacy
+}
+
+func (s *suite) health(t *testing.T) {
+	out := &steprpcv1.HealthResponse{}
+	ok(t, "health", s.do(t, http.MethodGet, "", ""), out)
+	if out.GetApiVersion() != "v1" || out.GetStatus() != "ok" || out.GetService() == "" {
+		t.Fatalf("health = %v, want apiVersion v1, status ok, and a service name", out)
+	}
+	caps := out.GetCapabilities()
+	for _, c := range []jenkinsrpc.Capability{
+		jenkinsrpc.CapabilityInvoke,
+		jenkinsrpc.CapabilityRunStatus,
+		jenkinsrpc.CapabilityCatalog,
+		jenkinsrpc.CapabilityCPSBridge,
+	} {
+		if !slices.Contains(caps, string(c)) {
+			t.Errorf("capabilities %v lack baseline %q", caps, c)
+		}
+	}
+	sorted := slices.Clone(caps)
+	slices.Sort(sorted)
+	if len(slices.Compact(sorted)) != len(caps) {
+		t.Errorf("capabilities %v repeat a name", caps)
+	}
+}
+
+func (s *suite) catalog(t *testing.T) {
+	out := &steprpcv1.CatalogResponse{}
+	ok(t, "catalog", s.do(t, http.MethodGet, "catalog", ""), out)
+	modes := map[string]steprpcv1.OperationExecutionMode{}
+	var prev string
+	for i, op := range out.GetOperations() {
+		name := op.GetName()
+		if name == "" {
+			t.Fatalf("catalog operation %d has no name", i)
+		}
+		if i > 0 && name <= prev {
+			t.Fatalf("catalog lists %q after %q, want unique names in ascending order", name, prev)
+		}
+		prev = name
+		switch op.GetExecutionMode() {
+		case steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT,
+			steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED:
+		default:
+			t.Fatalf("catalog operation %q has executionMode %v", name, op.GetExecutionMode())
+		}
+		modes[name] = op.GetExecutionMode()
+	}
+
+	for name, want := range map[string]steprpcv1.OperationExecutionMode{
+		s.cfg.DirectOperation: steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT,
+		s.cfg.BridgeOperation: steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED,
+	} {
+		if name != "" && modes[name] != want {
+			t.Errorf("catalog mode of %q = %v, want %v", name, modes[name], want)
+		}
+	}
+	if name := s.cfg.DisallowedOperation; name != "" {
+		if _, listed := modes[name]; listed {
+			t.Errorf("catalog lists disallowed operation %q", name)
+		}
+	}
+}
+
+func (s *suite) invokeValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name, body string
+		code       jenkinsrpc.ErrorCode
+	}{
+		{"empty body", "", jenkinsrpc.CodeBadRequest},
+		{"malformed JSON", `{"requestId":`, jenkinsrpc.CodeBadJSON},
+		{"unknown field", `{"requestId":"x","operation":"y","retries":3}`, jenkinsrpc.CodeBadJSON},
+		{"missing requestId", `{"operation":"echo"}`, jenkinsrpc.CodeBadRequest},
+		{"missing operation", fmt.Sprintf(`{"requestId":%q}`, s.requestID("no-operation")), jenkinsrpc.CodeBadRequest},
+	} {
+		wantError(t, "invoke with "+tc.name, s.do(t, http.MethodPost, "invoke", tc.body), http.StatusBadRequest, tc.code)
+	}
+
+	if s.cfg.DisallowedOperation == "" {
+		t.Log("DisallowedOperation unset; skipping operation_not_allowed")
+		return
+	}
+	res := s.invoke(t, s.requestID("disallowed"), s.cfg.DisallowedOperation, nil)
+	wantError(t, "invoke disallowed operation", res, http.StatusBadRequest, jenkinsrpc.CodeOperationNotAllowed)
+}
+
+func (s *suite) runLookup(t *testing.T) {
+	wantError(t, "run status without an id", s.do(t, http.MethodGet, "runs/", ""), http.StatusBadRequest, jenkinsrpc.CodeBadRequest)
+	missing := s.requestID("missing-run")
+	wantError(t, "unknown run status", s.do(t, http.MethodGet, "runs/"+missing, ""), http.StatusNotFound, jenkinsrpc.CodeRunNotFound)
+}
+
+// directRun checks that a direct operation finishes inside the invoke call,
+// that its status records one attempt from start to completion, and that
+// request ids replay.
+func (s *suite) directRun(t *testing.T) {
+	if s.cfg.DirectOperation == "" {
+		t.Skip("DirectOperation unset")
+	}
+	requestID := s.requestID("direct")
+	invoked := &steprpcv1.InvokeResponse{}
+	ok(t, "invoke", s.invoke(t, requestID, s.cfg.DirectOperation, s.cfg.DirectArgs), invoked)
+	if invoked.GetRequestId() != requestID || invoked.GetRunId() == "" {
+		t.Fatalf("invoke = %v, want requestId %q and a runId", invoked, requestID)
+	}
+	if state := stateOf(t, "invoke", invoked); state != jenkinsrpc.RunStateSucceeded {
+		t.Fatalf("invoke state = %s (error %v), want succeeded", state, invoked.GetError())
+	}
+
+	status := s.status(t, invoked.GetRunId())
+	if stateOf(t, "run status", status) != jenkinsrpc.RunStateSucceeded ||
+		status.GetRequestId() != requestID || status.GetOperation() != s.cfg.DirectOperation {
+		t.Fatalf("run status = %v", status)
+	}
+	if status.GetAttempts() != 1 || status.GetCreatedAt() == nil || status.GetStartedAt() == nil || status.GetCompletedAt() == nil {
+		t.Fatalf("run status = %v, want one attempt with created, started, and completed times", status)
+	}
+	if status.GetExecutionMode() != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT {
+		t.Fatalf("run status executionMode = %v, want direct", status.GetExecutionMode())
+	}
+
+	replayed := &steprpcv1.InvokeResponse{}
+	ok(t, "replayed invoke", s.invoke(t, requestID, s.cfg.DirectOperation, s.cfg.DirectArgs), replayed)
+	if replayed.GetRunId() != invoked.GetRunId() {
+		t.Fatalf("replayed invoke runId = %q, want %q", replayed.GetRunId(), invoked.GetRunId())
+	}
+	if other := s.otherOperation(t); other != "" {
+		res := s.invoke(t, requestID, other, s.cfg.DirectArgs)
+		wantError(t, "request id reused for another operation", res, http.StatusBadRequest, jenkinsrpc.CodeBadRequest)
+	}
+}
+
+// otherOperation returns a catalog operation other than DirectOperation, which
+// the allowlist, if any, admits.
+func (s *suite) otherOperation(t *testing.T) string {
+	t.Helper()
+	out := &steprpcv1.CatalogResponse{}
+	ok(t, "catalog", s.do(t, http.MethodGet, "catalog", ""), out)
+	for _, op := range out.GetOperations() {
+		if op.GetName() != s.cfg.DirectOperation {
+			return op.GetName()
+		}
+	}
+	return ""
+}
+
+// bridge walks one bridge request from queued through a pending delivery to
+// completion, checking the errors workers see along the way.
+func (s *suite) bridge(t *testing.T) {
+	if s.cfg.BridgeOperation == "" {
+		t.Skip("BridgeOperation unset")
+	}
+	target, err := bridgeTarget(s.cfg.BridgeArgs)
+	if err != nil {
+		t.Fatalf("BridgeArgs: %v", err)
+	}
+	pendingPath := "bridge/pending?runExternalizableId=" + url.QueryEscape(target)
+
+	requestID := s.requestID("bridge")
+	invoked := &steprpcv1.InvokeResponse{}
+	ok(t, "invoke", s.invoke(t, requestID, s.cfg.BridgeOperation, s.cfg.BridgeArgs), invoked)
+	if state := stateOf(t, "invoke", invoked); state != jenkinsrpc.RunStateQueued {
+		t.Fatalf("invoke state = %s (error %v), want queued", state, invoked.GetError())
+	}
+	runID := invoked.GetRunId()
+	status := s.status(t, runID)
+	if stateOf(t, "queued run status", status) != jenkinsrpc.RunStateQueued || status.GetAttempts() != 0 || status.GetStartedAt() != nil ||
+		status.GetExecutionMode() != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED {
+		t.Fatalf("queued run status = %v, want a bridge run with no attempts", status)
+	}
+
+	wantError(t, "pending without a run", s.do(t, http.MethodGet, "bridge/pending", ""), http.StatusBadRequest, jenkinsrpc.CodeBadRequest)
+	pending := &steprpcv1.BridgePendingResponse{}
+	ok(t, "pending", s.do(t, http.MethodGet, pendingPath, ""), pending)
+	if pending.GetRunId() != runID || pending.GetRequestId() != requestID || pending.GetOperation() != s.cfg.BridgeOperation ||
+		pending.GetTargetRunExternalizableId() != target {
+		t.Fatalf("pending = %v, want run %s for %s", pending, runID, target)
+	}
+	if _, leaked := pending.GetArgs().GetFields()["runContext"]; leaked {
+		t.Fatalf("pending args = %v, want runContext removed", pending.GetArgs())
+	}
+	status = s.status(t, runID)
+	if state := stateOf(t, "delivered run status", status); state != jenkinsrpc.RunStateRunning || status.GetAttempts() != 1 || status.GetStartedAt() == nil {
+		t.Fatalf("delivered run status = %v, want running after one attempt", status)
+	}
+
+	complete := func(body string) response { return s.do(t, http.MethodPost, "bridge/complete", body) }
+	wantError(t, "complete without a body", complete(""), http.StatusBadRequest, jenkinsrpc.CodeBadRequest)
+	wantError(t, "complete as running", complete(fmt.Sprintf(`{"runId":%q,"state":"running"}`, runID)), http.StatusBadRequest, jenkinsrpc.CodeBadRequest)
+	wantError(t, "complete unknown run", complete(fmt.Sprintf(`{"runId":%q,"state":"succeeded"}`, s.requestID("missing-run"))), http.StatusNotFound, jenkinsrpc.CodeRunNotFound)
+
+	completed := &steprpcv1.BridgeCompleteResponse{}
+	ok(t, "complete", complete(fmt.Sprintf(`{"runId":%q,"state":"succeeded"}`, runID)), completed)
+	if completed.GetRunId() != runID || completed.GetRequestId() != requestID || stateOf(t, "complete", completed) != jenkinsrpc.RunStateSucceeded {
+		t.Fatalf("complete = %v", completed)
+	}
+	status = s.status(t, runID)
+	if stateOf(t, "completed run status", status) != jenkinsrpc.RunStateSucceeded || status.GetCompletedAt() == nil {
+		t.Fatalf("completed run status = %v, want succeeded with a completion time", status)
+	}
+
+	wantError(t, "complete twice", complete(fmt.Sprintf(`{"runId":%q,"state":"failed"}`, runID)), http.StatusNotFound, jenkinsrpc.CodeRunNotFound)
+	wantError(t, "pending after completion", s.do(t, http.MethodGet, pendingPath, ""), http.StatusNotFound, jenkinsrpc.CodeNoPendingRequest)
+}
+
+// bridgeTarget names the run a bridge request with args is queued for, as
+// the plugin resolves args.runContext.
+func bridgeTarget(args map[string]any) (string, error) {
+	rc, _ := args["runContext"].(map[string]any)
+	if rc == nil {
+		return "", fmt.Errorf("runContext object is required")
+	}
+	if id, _ := rc["runExternalizableId"].(string); id != "" {
+		return id, nil
+	}
+	job, _ := rc["jobFullName"].(string)
+	number := fmt.Sprint(rc["buildNumber"])
+	if job == "" || rc["buildNumber"] == nil || number == "" {
+		return "", fmt.Errorf("runContext needs runExternalizableId or jobFullName and buildNumber")
+	}
+	return job + "#" + number, nil
+}
@@ -0,0 +1,112 @@
+// Package conformance checks that a server implements the Step RPC v1 wire
+// contract the plugin defines: the health and catalog shapes, invoke
+// validation, run lookup, run-state transitions, CPS bridge pending and
+// complete semantics, and the {"error": {...}} response format.
+//
+// The checks talk plain HTTP and decode with protojson, so they judge the wire
+// format rather than this module's client. Run them from a test:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, conformance.Config{
+//			BaseURL:         "https://jenkins.example.com",
+//			Username:        "ci",
+//			Token:           os.Getenv("JENKINS_TOKEN"),
+//			DirectOperation: "archiveArtifacts",
+//			DirectArgs:      map[string]any{"artifacts": "out.txt", "runContext": rc},
+//		})
+//	}
+//
+// The package's own test runs the suite against the emulator, or against the
+// server ConfigFromEnv describes when STEPRPC_CONFORMANCE_URL is set.
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvURL                 = "STEPRPC_CONFORMANCE_URL"
+	EnvUsername            = "STEPRPC_CONFORMANCE_USERNAME"
+	EnvToken               = "STEPRPC_CONFORMANCE_TOKEN"
+	EnvDirectOperation     = "STEPRPC_CONFORMANCE_DIRECT_OPERATION"
+	EnvDirectArgs          = "STEPRPC_CONFORMANCE_DIRECT_ARGS"
+	EnvBridgeOperation     = "STEPRPC_CONFORMANCE_BRIDGE_OPERATION"
+	EnvBridgeArgs          = "STEPRPC_CONFORMANCE_BRIDGE_ARGS"
+	EnvDisallowedOperation = "STEPRPC_CONFORMANCE_DISALLOWED_OPERATION"
+)
+
+// Config describes the server under test and the operations the suite may
+// invoke. Checks that need an operation the config leaves empty are skipped.
+type Config struct {
+	// BaseURL is the controller or server root, without /step-rpc/v1.
+	BaseURL string
+	// Username and Token are sent as basic auth; Token alone is sent as a
+	// bearer token.
+	Username   string
+	Token      string
+	HTTPClient *http.Client
+
+	// DirectOperation is a direct operation that succeeds with DirectArgs,
+	// which include args.runContext when the server needs one.
+	DirectOperation string
+	DirectArgs      map[string]any
+	// BridgeOperation is a CPS bridge operation. BridgeArgs must carry the
+	// args.runContext of a run no bridge worker serves, since the suite
+	// fetches and completes its requests itself.
+	BridgeOperation string
+	BridgeArgs      map[string]any
+	// DisallowedOperation is an operation outside the server's allowlist.
+	DisallowedOperation string
+}
+
+// ConfigFromEnv builds a Config from the STEPRPC_CONFORMANCE_* variables. Args
+// variables hold JSON objects. ok is false when EnvURL is unset.
+func ConfigFromEnv() (cfg Config, ok bool, err error) {
+	cfg = Config{
+		BaseURL:             os.Getenv(EnvURL),
+		Username:            os.Getenv(EnvUsername),
+		Token:               os.Getenv(EnvToken),
+		DirectOperation:     os.Getenv(EnvDirectOperation),
+		BridgeOperation:     os.Getenv(EnvBridgeOperation),
+		DisallowedOperation: os.Getenv(EnvDisallowedOperation),
+	}
+	if cfg.BaseURL == "" {
+		return Config{}, false, nil
+	}
+	for name, dst := range map[string]*map[string]any{EnvDirectArgs: &cfg.DirectArgs, EnvBridgeArgs: &cfg.BridgeArgs} {
+		if raw := os.Getenv(name); raw != "" {
+			if err := json.Unmarshal([]byte(raw), dst); err != nil {
+				return Config{}, false, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return cfg, true, nil
+}
+
+// Run runs every check against cfg's server as subtests of t.
+func Run(t *testing.T, cfg Config) {
+	t.Helper()
+	if strings.TrimSpace(cfg.BaseURL) == "" {
+		t.Fatalf("conformance: BaseURL is required")
+	}
+	s := &suite{cfg: cfg, client: cfg.HTTPClient, prefix: "conformance-" + strconv.FormatInt(time.Now().UnixNano(), 36)}
+	if s.client == nil {
+		s.client = http.DefaultClient
+	}
+	s.cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	t.Run("Health", s.health)
+	t.Run("Catalog", s.catalog)
+	t.Run("InvokeValidation", s.invokeValidation)
+	t.Run("RunLookup", s.runLookup)
+	t.Run("DirectRun", s.directRun)
+	t.Run("Bridge", s.bridge)
+}
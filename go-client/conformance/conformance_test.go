@@ -0,0 +1,46 @@
+package conformance_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/conformance"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/emulator"
+)
+
+const emulatorScript = `
+allowlist: [echo, stash]
+operations:
+  - name: echo
+    log: "hello\n"
+  - name: stash
+    mode: cpsBridgeRequired
+`
+
+// TestConformance runs the suite against the server STEPRPC_CONFORMANCE_URL
+// names, or against the emulator when it is unset.
+func TestConformance(t *testing.T) {
+	cfg, ok, err := conformance.ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("ConfigFromEnv() error = %v", err)
+	}
+	if !ok {
+		script, err := emulator.Parse([]byte(emulatorScript))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		ts := httptest.NewServer(emulator.New(script).WithToken("conformance"))
+		t.Cleanup(ts.Close)
+		cfg = conformance.Config{
+			BaseURL:             ts.URL,
+			Username:            "worker",
+			Token:               "conformance",
+			HTTPClient:          ts.Client(),
+			DirectOperation:     "echo",
+			BridgeOperation:     "stash",
+			BridgeArgs:          map[string]any{"name": "src", "runContext": map[string]any{"runExternalizableId": "team/app#7"}},
+			DisallowedOperation: "sh",
+		}
+	}
+	conformance.Run(t, cfg)
+}
@@ -0,0 +1,370 @@
+// Package faultinject provides an http.RoundTripper that injects network and
+// server faults into Step RPC traffic, for testing how clients retry, poll,
+// and give up.
+//
+// A Transport forwards to a base transport and, for requests matching a Rule,
+// injects the rule's Fault either with a probability or on a schedule of call
+// numbers. It plugs into the *http.Client passed to jenkinsrpc.New:
+//
+//	ft := faultinject.New(http.DefaultTransport,
+//		faultinject.Rule{Path: "/step-rpc/v1/runs/*", Probability: 0.2, Fault: faultinject.Fault{Kind: faultinject.ServerError, Burst: 3}},
+//		faultinject.Rule{Method: http.MethodPost, Path: "/step-rpc/v1/invoke", Calls: []int{1}, Fault: faultinject.Fault{Kind: faultinject.ResetAfterWrite}},
+//	)
+//	client, err := jenkinsrpc.New(baseURL, token, &http.Client{Transport: ft})
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Kind names a fault.
+type Kind string
+
+// Fault kinds.
+const (
+	// Latency delays the request by Fault.Delay, then forwards it.
+	Latency Kind = "latency"
+	// ResetBeforeWrite fails the request with a connection reset without
+	// forwarding it, so the server never sees it.
+	ResetBeforeWrite Kind = "resetBeforeWrite"
+	// ResetAfterWrite forwards the request, discards the response, and fails
+	// with a connection reset, so the server acted but the caller cannot tell.
+	ResetAfterWrite Kind = "resetAfterWrite"
+	// TruncateBody forwards the request and cuts the response body in half;
+	// reading past the cut fails with io.ErrUnexpectedEOF.
+	TruncateBody Kind = "truncateBody"
+	// MalformedBody forwards the request and replaces the response body with
+	// Fault.Body, or with JSON that is not valid protojson for any Step RPC
+	// message.
+	MalformedBody Kind = "malformedBody"
+	// TooManyRequests answers 429 rate_limited with a Retry-After header of
+	// Fault.RetryAfter without forwarding the request.
+	TooManyRequests Kind = "tooManyRequests"
+	// ServerError answers Fault.Status (default 503) without forwarding the
+	// request, for Fault.Burst consecutive matching calls.
+	ServerError Kind = "serverError"
+	// Stall blocks until the request's context is done and fails with its
+	// error, as a server that accepted the connection and never answered.
+	Stall Kind = "stall"
+)
+
+// DefaultMalformedBody is the MalformedBody replacement when Fault.Body is
+// empty: valid JSON whose state is an object rather than a string, so no Step
+// RPC message with a state field decodes from it. TruncateBody covers JSON
+// that does not parse at all.
+const DefaultMalformedBody = `{"faultinject":true,"state":{"not":"a string"}}`
+
+// ErrConnectionReset is wrapped by the errors ResetBeforeWrite and
+// ResetAfterWrite return. It wraps syscall.ECONNRESET.
+var ErrConnectionReset = fmt.Errorf("faultinject: connection reset by peer: %w", syscall.ECONNRESET)
+
+// Fault describes what to inject.
+type Fault struct {
+	Kind Kind
+	// Delay is the Latency delay.
+	Delay time.Duration
+	// RetryAfter is the TooManyRequests Retry-After value, rounded up to whole
+	// seconds. Zero sends "0".
+	RetryAfter time.Duration
+	// Status is the ServerError status. Zero means 503.
+	Status int
+	// Burst is the number of consecutive matching calls a ServerError fails
+	// once triggered, including the triggering one. Zero means 1.
+	Burst int
+	// Body replaces the response body for MalformedBody.
+	Body string
+}
+
+// Rule selects requests and when to fault them. A rule with neither
+// Probability nor Calls faults every matching request.
+type Rule struct {
+	// Method matches the request method case-insensitively. Empty matches any.
+	Method string
+	// Path is a path.Match pattern for the request URL path, so
+	// "/step-rpc/v1/runs/*" matches run status but not run results. Empty
+	// matches any.
+	Path string
+	// Probability faults each matching request with this probability.
+	Probability float64
+	// Calls faults the matching requests with these 1-based numbers, counted
+	// per rule. It takes precedence over Probability.
+	Calls []int
+	Fault Fault
+}
+
+// Matches reports whether the rule applies to req.
+func (r Rule) Matches(req *http.Request) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, req.Method) {
+		return false
+	}
+	if r.Path != "" {
+		ok, err := path.Match(r.Path, req.URL.Path)
+		if err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Injection records one injected fault.
+type Injection struct {
+	Method string
+	Path   string
+	Kind   Kind
+	// Call is the 1-based number of the request among the rule's matches.
+	Call int
+}
+
+// Transport is an http.RoundTripper that injects faults. The first matching
+// rule decides a request's fault; requests no rule faults are forwarded
+// unchanged. It is safe for concurrent use.
+type Transport struct {
+	base  http.RoundTripper
+	rules []Rule
+
+	mu         sync.Mutex
+	rng        *rand.Rand
+	matches    []int
+	burst      []int
+	requests   int
+	injections []Injection
+}
+
+// New returns a Transport forwarding to base, or http.DefaultTransport when
+// base is nil, with rules applied in order. Probabilities draw from a fixed
+// seed, so runs are reproducible; see WithSeed.
+func New(base http.RoundTripper, rules ...Rule) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		base:    base,
+		rules:   slices.Clone(rules),
+		rng:     rand.New(rand.NewPCG(1, 1)), //nolint:gosec // fault selection does not need cryptographic randomness
+		matches: make([]int, len(rules)),
+		burst:   make([]int, len(rules)),
+	}
+}
+
+// WithSeed returns a copy of the transport, with fresh counters, drawing
+// probabilities from seed.
+func (t *Transport) WithSeed(seed uint64) *Transport {
+	cp := New(t.base, t.rules...)
+	cp.rng = rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // fault selection does not need cryptographic randomness
+	return cp
+}
+
+// Requests returns the number of requests the transport has handled.
+func (t *Transport) Requests() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.requests
+}
+
+// Injections returns the faults injected so far, in order.
+func (t *Transport) Injections() []Injection {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.injections)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault, ok := t.pick(req)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	switch fault.Kind {
+	case Latency:
+		if err := sleep(req.Context(), fault.Delay); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+		return t.base.RoundTrip(req)
+	case ResetBeforeWrite:
+		closeBody(req)
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrConnectionReset)
+	case ResetAfterWrite:
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrConnectionReset)
+	case TruncateBody:
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := readAll(resp)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = &truncatedBody{r: strings.NewReader(string(body[:len(body)/2]))}
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+		return resp, nil
+	case MalformedBody:
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := readAll(resp); err != nil {
+			return nil, err
+		}
+		body := fault.Body
+		if body == "" {
+			body = DefaultMalformedBody
+		}
+		resp.Body = io.NopCloser(strings.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		return resp, nil
+	case TooManyRequests:
+		closeBody(req)
+		seconds := strconv.FormatInt(int64(math.Ceil(fault.RetryAfter.Seconds())), 10)
+		resp := errorResponse(req, http.StatusTooManyRequests, "rate_limited", "faultinject: too many requests", `{"retryAfter":"`+seconds+`"}`)
+		resp.Header.Set("Retry-After", seconds)
+		return resp, nil
+	case ServerError:
+		closeBody(req)
+		status := fault.Status
+		if status == 0 {
+			status = http.StatusServiceUnavailable
+		}
+		return errorResponse(req, status, "unavailable", "faultinject: server error", ""), nil
+	case Stall:
+		closeBody(req)
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	default:
+		closeBody(req)
+		return nil, fmt.Errorf("faultinject: unknown fault kind %q", fault.Kind)
+	}
+}
+
+// pick counts req against the first matching rule and reports the fault to
+// inject, if any. A running ServerError burst fires before the rule's own
+// schedule or probability is consulted.
+func (t *Transport) pick(req *http.Request) (Fault, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requests++
+	for i, rule := range t.rules {
+		if !rule.Matches(req) {
+			continue
+		}
+		t.matches[i]++
+		call := t.matches[i]
+		var fire bool
+		switch {
+		case t.burst[i] > 0:
+			t.burst[i]--
+			fire = true
+		case len(rule.Calls) > 0:
+			fire = slices.Contains(rule.Calls, call)
+			t.startBurst(i)
+		case rule.Probability > 0:
+			fire = t.rng.Float64() < rule.Probability
+			t.startBurst(i)
+		default:
+			fire = true
+		}
+		if !fire {
+			t.burst[i] = 0
+			return Fault{}, false
+		}
+		t.injections = append(t.injections, Injection{Method: req.Method, Path: req.URL.Path, Kind: rule.Fault.Kind, Call: call})
+		return rule.Fault, true
+	}
+	return Fault{}, false
+}
+
+// startBurst arms the calls that follow a ServerError trigger. pick disarms
+// them again when the trigger does not fire.
+func (t *Transport) startBurst(i int) {
+	if f := t.rules[i].Fault; f.Kind == ServerError && f.Burst > 1 {
+		t.burst[i] = f.Burst - 1
+	}
+}
+
+func errorResponse(req *http.Request, status int, code, message, details string) *http.Response {
+	body := `{"error":{"code":"` + code + `","message":"` + message + `"`
+	if details != "" {
+		body += `,"details":` + details
+	}
+	body += `}}`
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func readAll(resp *http.Response) ([]byte, error) {
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("faultinject: read response body: %w", err)
+	}
+	return body, nil
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// truncatedBody serves a prefix, then fails like a connection dropped
+// mid-body.
+type truncatedBody struct {
+	r io.Reader
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (b *truncatedBody) Close() error { return nil }
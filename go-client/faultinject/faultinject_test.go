@@ -0,0 +1,261 @@
+package faultinject_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/faultinject"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const statusBody = `{"requestId":"r-1","runId":"rpc-1","state":"succeeded"}`
+
+func server(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(statusBody))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, &hits
+}
+
+func get(ctx context.Context, t *testing.T, c *http.Client, url string) (*http.Response, string, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	return resp, string(body), err
+}
+
+func TestTransport_Faults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fault    faultinject.Fault
+		wantHits int32
+		check    func(t *testing.T, resp *http.Response, body string, err error)
+	}{
+		{
+			name:     "reset before write",
+			fault:    faultinject.Fault{Kind: faultinject.ResetBeforeWrite},
+			wantHits: 0,
+			check: func(t *testing.T, _ *http.Response, _ string, err error) {
+				if !errors.Is(err, faultinject.ErrConnectionReset) || !errors.Is(err, syscall.ECONNRESET) {
+					t.Fatalf("error = %v, want connection reset", err)
+				}
+			},
+		},
+		{
+			name:     "reset after write",
+			fault:    faultinject.Fault{Kind: faultinject.ResetAfterWrite},
+			wantHits: 1,
+			check: func(t *testing.T, _ *http.Response, _ string, err error) {
+				if !errors.Is(err, faultinject.ErrConnectionReset) {
+					t.Fatalf("error = %v, want connection reset", err)
+				}
+			},
+		},
+		{
+			name:     "truncated body",
+			fault:    faultinject.Fault{Kind: faultinject.TruncateBody},
+			wantHits: 1,
+			check: func(t *testing.T, _ *http.Response, body string, err error) {
+				if !errors.Is(err, io.ErrUnexpectedEOF) {
+					t.Fatalf("read error = %v, want io.ErrUnexpectedEOF", err)
+				}
+				if body != statusBody[:len(statusBody)/2] {
+					t.Fatalf("body = %q, want first half", body)
+				}
+			},
+		},
+		{
+			name:     "malformed body",
+			fault:    faultinject.Fault{Kind: faultinject.MalformedBody},
+			wantHits: 1,
+			check: func(t *testing.T, resp *http.Response, body string, err error) {
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if resp.StatusCode != http.StatusOK || body != faultinject.DefaultMalformedBody {
+					t.Fatalf("response = %d %q", resp.StatusCode, body)
+				}
+				if !json.Valid([]byte(body)) {
+					t.Fatalf("body %q is not valid JSON", body)
+				}
+				status := &steprpcv1.RunStatusResponse{}
+				if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(body), status); err == nil {
+					t.Fatalf("body %q decoded as a run status", body)
+				}
+			},
+		},
+		{
+			name:     "too many requests",
+			fault:    faultinject.Fault{Kind: faultinject.TooManyRequests, RetryAfter: 1500 * time.Millisecond},
+			wantHits: 0,
+			check: func(t *testing.T, resp *http.Response, body string, err error) {
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+					t.Fatalf("response = %d Retry-After %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+				}
+				if !strings.Contains(body, `"code":"rate_limited"`) || !strings.Contains(body, `"retryAfter":"2"`) {
+					t.Fatalf("body = %s", body)
+				}
+			},
+		},
+		{
+			name:     "server error",
+			fault:    faultinject.Fault{Kind: faultinject.ServerError, Status: http.StatusBadGateway},
+			wantHits: 0,
+			check: func(t *testing.T, resp *http.Response, body string, err error) {
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if resp.StatusCode != http.StatusBadGateway || !strings.Contains(body, `"code":"unavailable"`) {
+					t.Fatalf("response = %d %s", resp.StatusCode, body)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ts, hits := server(t)
+			ft := faultinject.New(ts.Client().Transport, faultinject.Rule{Fault: tt.fault})
+			resp, body, err := get(t.Context(), t, &http.Client{Transport: ft}, ts.URL+"/step-rpc/v1/runs/rpc-1")
+			tt.check(t, resp, body, err)
+			if got := hits.Load(); got != tt.wantHits {
+				t.Fatalf("server hits = %d, want %d", got, tt.wantHits)
+			}
+			if got := ft.Injections(); len(got) != 1 || got[0].Kind != tt.fault.Kind || got[0].Call != 1 {
+				t.Fatalf("Injections() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransport_LatencyAndStallRespectContext(t *testing.T) {
+	t.Parallel()
+
+	ts, hits := server(t)
+	for _, fault := range []faultinject.Fault{
+		{Kind: faultinject.Latency, Delay: time.Hour},
+		{Kind: faultinject.Stall},
+	} {
+		ft := faultinject.New(ts.Client().Transport, faultinject.Rule{Fault: fault})
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		start := time.Now()
+		_, _, err := get(ctx, t, &http.Client{Transport: ft}, ts.URL+"/step-rpc/v1/health")
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("%s: error = %v, want context.DeadlineExceeded", fault.Kind, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("%s: returned after %v", fault.Kind, elapsed)
+		}
+	}
+	if got := hits.Load(); got != 0 {
+		t.Fatalf("server hits = %d, want 0", got)
+	}
+
+	ft := faultinject.New(ts.Client().Transport, faultinject.Rule{Fault: faultinject.Fault{Kind: faultinject.Latency, Delay: 10 * time.Millisecond}})
+	start := time.Now()
+	if _, body, err := get(t.Context(), t, &http.Client{Transport: ft}, ts.URL+"/step-rpc/v1/health"); err != nil || body != statusBody {
+		t.Fatalf("latency: body = %q, error = %v", body, err)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Fatalf("latency: elapsed = %v, want >= 10ms", elapsed)
+	}
+}
+
+func TestTransport_ScheduleAndBurst(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := server(t)
+	ft := faultinject.New(ts.Client().Transport,
+		faultinject.Rule{Path: "/step-rpc/v1/runs/*", Calls: []int{2}, Fault: faultinject.Fault{Kind: faultinject.ServerError, Burst: 3}},
+	)
+	c := &http.Client{Transport: ft}
+
+	var statuses []int
+	for range 6 {
+		resp, _, err := get(t.Context(), t, c, ts.URL+"/step-rpc/v1/runs/rpc-1")
+		if err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+		statuses = append(statuses, resp.StatusCode)
+	}
+	want := []int{200, 503, 503, 503, 200, 200}
+	for i := range want {
+		if statuses[i] != want[i] {
+			t.Fatalf("statuses = %v, want %v", statuses, want)
+		}
+	}
+
+	// Paths the pattern does not match are forwarded and not counted.
+	if resp, _, err := get(t.Context(), t, c, ts.URL+"/step-rpc/v1/runs/rpc-1/result"); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("result: error = %v", err)
+	}
+	if got := ft.Requests(); got != 7 {
+		t.Fatalf("Requests() = %d, want 7", got)
+	}
+	if got := len(ft.Injections()); got != 3 {
+		t.Fatalf("len(Injections()) = %d, want 3", got)
+	}
+}
+
+func TestTransport_ProbabilityIsSeeded(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := server(t)
+	base := faultinject.New(ts.Client().Transport,
+		faultinject.Rule{Method: http.MethodGet, Probability: 0.5, Fault: faultinject.Fault{Kind: faultinject.ResetBeforeWrite}},
+	)
+	pattern := func(ft *faultinject.Transport) string {
+		var b strings.Builder
+		c := &http.Client{Transport: ft}
+		for range 64 {
+			if _, _, err := get(t.Context(), t, c, ts.URL+"/step-rpc/v1/health"); err != nil {
+				b.WriteByte('x')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		return b.String()
+	}
+
+	first, second := pattern(base.WithSeed(7)), pattern(base.WithSeed(7))
+	if first != second {
+		t.Fatalf("seed 7 patterns differ:\n%s\n%s", first, second)
+	}
+	if n := strings.Count(first, "x"); n == 0 || n == 64 {
+		t.Fatalf("pattern = %s, want a mix of faults and passes", first)
+	}
+	if other := pattern(base.WithSeed(8)); other == first {
+		t.Fatalf("seeds 7 and 8 produced the same pattern %s", first)
+	}
+}
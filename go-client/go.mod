@@ -4,6 +4,20 @@ go 1.26.0
 
 require github.com/albertocavalcante/jenkins-rpc/contracts v0.0.0
 
-require google.golang.org/protobuf v1.36.10
+require google.golang.org/protobuf v1.36.11
+
+require gopkg.in/yaml.v3 v3.0.1
+
+require (
+	golang.org/x/time v0.16.0
+	google.golang.org/grpc v1.84.0
+)
+
+require (
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+)
 
 replace github.com/albertocavalcante/jenkins-rpc/contracts => ../contracts
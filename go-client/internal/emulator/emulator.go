@@ -0,0 +1,771 @@
+// Package emulator serves the plugin's /step-rpc/v1/* API from a Script, so
+// clients, bridge workers, and the proxy can be exercised without a Jenkins
+// controller.
+//
+// Responses use the plugin's protojson wire format, error codes, and run-state
+// transitions: direct operations finish within the invoke call, bridge
+// operations queue for a worker under the same pending, claim, heartbeat,
+// progress, and complete rules, and replays by request id return the original
+// run. Two admin routes outside the API inspect and clear state:
+// GET /emulator/state and POST /emulator/reset.
+//
+// The emulator departs from the plugin where it has no controller to consult:
+// only bridge operations need args.runContext, and a missing one is a 400
+// bad_request rather than a server error.
+package emulator
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Service is the service name the emulator reports from the health route.
+const Service = "steprpc-emulator"
+
+// CodeUnauthenticated rejects requests without the configured token.
+const CodeUnauthenticated = "unauthenticated"
+
+// Limits matching the plugin.
+const (
+	maxBodyBytes     = 1 << 20
+	maxBatchSize     = 100
+	maxLogChunkBytes = 64 * 1024
+)
+
+// capabilities are the plugin's, minus webhooks, which it does not send.
+var capabilities = []string{
+	string(rpcclient.CapabilityInvoke),
+	string(rpcclient.CapabilityRunStatus),
+	string(rpcclient.CapabilityCatalog),
+	string(rpcclient.CapabilityCPSBridge),
+	string(rpcclient.CapabilityBatchInvoke),
+	string(rpcclient.CapabilityRunOutput),
+	string(rpcclient.CapabilityBridgeLeases),
+	string(rpcclient.CapabilityBridgeResults),
+	string(rpcclient.CapabilityBridgeDiscovery),
+	string(rpcclient.CapabilityRunState),
+}
+
+// Server is an http.Handler for the emulated routes.
+type Server struct {
+	script *Script
+	token  string
+	state  *store
+	now    func() time.Time
+	mux    *http.ServeMux
+}
+
+// New returns an emulator serving script with no authentication.
+func New(script *Script) *Server {
+	s := &Server{
+		script: script,
+		state:  newStore(),
+		now:    time.Now,
+	}
+	s.mux = s.routes()
+	return s
+}
+
+// WithToken returns a copy that requires token, as a bearer token or as the
+// password of basic auth, on every route. The basic auth user names the
+// caller's bridge worker, like the authenticated user on a controller.
+func (s *Server) WithToken(token string) *Server {
+	cp := *s
+	cp.token = token
+	cp.mux = cp.routes()
+	return &cp
+}
+
+// Reset forgets every run and queued bridge request.
+func (s *Server) Reset() {
+	s.state.reset()
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("GET /step-rpc/v1/{$}", s.handle(s.health))
+	mux.Handle("GET /step-rpc/v1/catalog", s.handle(s.catalog))
+	mux.Handle("POST /step-rpc/v1/invoke", s.handle(s.invoke))
+	mux.Handle("POST /step-rpc/v1/batchInvoke", s.handle(s.batchInvoke))
+	mux.Handle("GET /step-rpc/v1/runs/{$}", s.handle(s.runIDRequired))
+	mux.Handle("GET /step-rpc/v1/runs/{runId}", s.handle(s.runStatus))
+	mux.Handle("GET /step-rpc/v1/runs/{runId}/result", s.handle(s.runResult))
+	mux.Handle("GET /step-rpc/v1/runs/{runId}/log", s.handle(s.runLog))
+	mux.Handle("GET /step-rpc/v1/bridge/pending", s.handle(s.bridgePending))
+	mux.Handle("GET /step-rpc/v1/bridge/runs", s.handle(s.bridgeRuns))
+	mux.Handle("POST /step-rpc/v1/bridge/claim", s.handle(s.bridgeClaim))
+	mux.Handle("POST /step-rpc/v1/bridge/heartbeat", s.handle(s.bridgeHeartbeat))
+	mux.Handle("POST /step-rpc/v1/bridge/progress", s.handle(s.bridgeProgress))
+	mux.Handle("POST /step-rpc/v1/bridge/complete", s.handle(s.bridgeComplete))
+	mux.Handle("GET /emulator/state", s.handle(s.adminState))
+	mux.Handle("POST /emulator/reset", s.handle(s.adminReset))
+	return mux
+}
+
+// apiError is a failure reported in the plugin's error format.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	details map[string]string
+}
+
+func (e *apiError) Error() string { return e.code + ": " + e.message }
+
+func badRequest(message string) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: message}
+}
+
+func runNotFound(runID string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: string(rpcclient.CodeRunNotFound), message: "no run found for id '" + runID + "'"}
+}
+
+func bridgeRequestNotFound(runID string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: string(rpcclient.CodeRunNotFound), message: "no pending bridge request found for run '" + runID + "'"}
+}
+
+// handlerFunc serves one route for the authenticated user. A nil message with
+// a nil error is answered 204 No Content.
+type handlerFunc func(r *http.Request, user string) (proto.Message, error)
+
+// handle authenticates the caller, runs h, and writes the response.
+func (s *Server) handle(h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.authenticate(r)
+		if !ok {
+			writeError(w, &apiError{status: http.StatusUnauthorized, code: CodeUnauthenticated, message: "missing or invalid credentials"})
+			return
+		}
+		resp, err := h(r, user)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if resp == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		body, err := protojson.Marshal(resp)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
+
+// authenticate checks the token, if one is set, and names the caller.
+func (s *Server) authenticate(r *http.Request) (string, bool) {
+	user, password, basic := r.BasicAuth()
+	if user == "" {
+		user = "anonymous"
+	}
+	if s.token == "" {
+		return user, true
+	}
+	presented := password
+	if !basic {
+		bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			return "", false
+		}
+		presented = bearer
+	}
+	return user, subtle.ConstantTimeCompare([]byte(presented), []byte(s.token)) == 1
+}
+
+// writeError writes err in the plugin's {"error": {...}} format. Errors other
+// than apiError are internal failures.
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, code: "internal_error", message: err.Error()}
+	}
+	body, _ := protojson.Marshal(&steprpcv1.ErrorResponse{Error: &steprpcv1.Error{
+		Code:    apiErr.code,
+		Message: apiErr.message,
+		Details: apiErr.details,
+	}})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.status)
+	_, _ = w.Write(body)
+}
+
+// readProto decodes the request body into out. An empty body is an error when
+// required and otherwise leaves out unset.
+func readProto(r *http.Request, out proto.Message, required bool) error {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodyBytes))
+	if err != nil {
+		return &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadJSON), message: err.Error()}
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		if required {
+			return badRequest("request body is required")
+		}
+		return nil
+	}
+	if err := protojson.Unmarshal(body, out); err != nil {
+		return &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadJSON), message: "request body must be valid JSON"}
+	}
+	return nil
+}
+
+func (s *Server) health(*http.Request, string) (proto.Message, error) {
+	return &steprpcv1.HealthResponse{
+		ApiVersion:   rpcclient.SupportedAPIVersion,
+		Service:      Service,
+		Status:       "ok",
+		Capabilities: capabilities,
+	}, nil
+}
+
+func (s *Server) catalog(*http.Request, string) (proto.Message, error) {
+	return &steprpcv1.CatalogResponse{Operations: s.script.catalog()}, nil
+}
+
+func (s *Server) invoke(r *http.Request, _ string) (proto.Message, error) {
+	req := &steprpcv1.InvokeRequest{}
+	if err := readProto(r, req, true); err != nil {
+		return nil, err
+	}
+	resp, err := s.invokeOne(r.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Server) batchInvoke(r *http.Request, _ string) (proto.Message, error) {
+	req := &steprpcv1.BatchInvokeRequest{}
+	if err := readProto(r, req, true); err != nil {
+		return nil, err
+	}
+	if n := len(req.GetRequests()); n > maxBatchSize {
+		return nil, &apiError{
+			status:  http.StatusBadRequest,
+			code:    string(rpcclient.CodeBatchTooLarge),
+			message: "batch of " + strconv.Itoa(n) + " exceeds " + strconv.Itoa(maxBatchSize) + " requests",
+			details: map[string]string{"maxBatchSize": strconv.Itoa(maxBatchSize)},
+		}
+	}
+	out := &steprpcv1.BatchInvokeResponse{}
+	for _, one := range req.GetRequests() {
+		resp, err := s.invokeOne(r.Context(), one)
+		var apiErr *apiError
+		switch {
+		case err == nil:
+			out.Results = append(out.Results, &steprpcv1.BatchInvokeResult{Response: resp})
+		case errors.As(err, &apiErr):
+			out.Results = append(out.Results, &steprpcv1.BatchInvokeResult{
+				Error:  &steprpcv1.Error{Code: apiErr.code, Message: apiErr.message},
+				Status: int32(apiErr.status), //nolint:gosec // HTTP status codes fit in int32
+			})
+		default:
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// invokeOne starts or replays one invocation. Direct operations hold the call
+// for their scripted duration; bridge operations are queued and return at
+// once.
+func (s *Server) invokeOne(ctx context.Context, req *steprpcv1.InvokeRequest) (*steprpcv1.InvokeResponse, error) {
+	requestID, operation := req.GetRequestId(), req.GetOperation()
+	if requestID == "" || operation == "" {
+		return nil, badRequest("requestId and operation are required")
+	}
+	if !s.script.allows(operation) {
+		return nil, &apiError{
+			status:  http.StatusBadRequest,
+			code:    string(rpcclient.CodeOperationNotAllowed),
+			message: "operation '" + operation + "' is not in allowlist",
+		}
+	}
+
+	s.state.mu.Lock()
+	if existing := s.state.byRequest[requestID]; existing != nil {
+		defer s.state.mu.Unlock()
+		if existing.operation != operation {
+			return nil, badRequest("requestId '" + requestID + "' was already used for operation '" + existing.operation + "'")
+		}
+		return existing.invokeResponse(), nil
+	}
+
+	now := s.now()
+	op := s.script.operation(operation)
+	r := &run{
+		requestID: requestID,
+		runID:     newRunID(),
+		operation: operation,
+		mode:      steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT,
+		createdAt: now,
+		updatedAt: now,
+		result:    &structpb.Struct{},
+	}
+	switch {
+	case op == nil:
+		r.finish(rpcclient.RunStateFailed, &steprpcv1.Error{
+			Code:    string(rpcclient.CodeOperationNotFound),
+			Message: "operation '" + operation + "' was not found among installed Jenkins operations",
+		}, nil, now)
+	case op.Mode == ModeCPSBridgeRequired:
+		target, args, err := bridgeTarget(req.GetArgs())
+		if err != nil {
+			s.state.mu.Unlock()
+			return nil, err
+		}
+		r.mode = steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED
+		r.state = rpcclient.RunStateQueued
+		r.target, r.args = target, args
+		if op.AutoComplete {
+			time.AfterFunc(op.Duration, func() { s.autoComplete(r, op) })
+		}
+	default:
+		r.state = rpcclient.RunStateRunning
+		r.startedAt = now
+		r.attempts = 1
+	}
+	s.state.add(r)
+	resp := r.invokeResponse()
+	s.state.mu.Unlock()
+	if op == nil || op.Mode == ModeCPSBridgeRequired {
+		return resp, nil
+	}
+
+	if op.Duration > 0 {
+		timer := time.NewTimer(op.Duration)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	r.log = op.Log
+	if op.Outcome == OutcomeFailed {
+		r.finish(rpcclient.RunStateFailed, op.runError(), op.result, s.now())
+	} else {
+		r.finish(rpcclient.RunStateSucceeded, nil, op.result, s.now())
+	}
+	return r.invokeResponse(), nil
+}
+
+// autoComplete finishes a scripted bridge request nobody has completed or
+// holds a live lease on.
+func (s *Server) autoComplete(r *run, op *Operation) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	now := s.now()
+	if s.state.queued(r.runID) != r || r.lease.live(now) {
+		return
+	}
+	s.state.dequeue(r)
+	r.deliver(Service, now)
+	r.log = op.Log
+	if op.Outcome == OutcomeFailed {
+		r.finish(rpcclient.RunStateFailed, op.runError(), op.result, now)
+	} else {
+		r.finish(rpcclient.RunStateSucceeded, nil, op.result, now)
+	}
+}
+
+// bridgeTarget resolves the run a bridge request is queued for from
+// args.runContext and returns the args without it, as workers receive them.
+func bridgeTarget(args *structpb.Struct) (string, *structpb.Struct, error) {
+	rc := args.GetFields()["runContext"].GetStructValue()
+	if rc == nil {
+		return "", nil, badRequest("args.runContext is required")
+	}
+	target := rc.GetFields()["runExternalizableId"].GetStringValue()
+	if target == "" {
+		job := rc.GetFields()["jobFullName"].GetStringValue()
+		build := rc.GetFields()["buildNumber"]
+		number := build.GetStringValue()
+		if _, ok := build.GetKind().(*structpb.Value_NumberValue); ok {
+			number = strconv.FormatInt(int64(build.GetNumberValue()), 10)
+		}
+		if job == "" || number == "" {
+			return "", nil, badRequest("args.runContext must include either runExternalizableId or jobFullName/buildNumber plus nodeName/workspace")
+		}
+		target = job + "#" + number
+	}
+
+	stepArgs := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	for k, v := range args.GetFields() {
+		if k != "runContext" {
+			stepArgs.Fields[k] = v
+		}
+	}
+	return target, stepArgs, nil
+}
+
+func (s *Server) runIDRequired(*http.Request, string) (proto.Message, error) {
+	return nil, badRequest("run id is required")
+}
+
+// lookup returns the run named by the runId path value. Callers hold mu.
+func (s *Server) lookup(r *http.Request) (*run, error) {
+	runID := r.PathValue("runId")
+	found := s.state.runs[runID]
+	if found == nil {
+		return nil, runNotFound(runID)
+	}
+	return found, nil
+}
+
+func (s *Server) runStatus(r *http.Request, _ string) (proto.Message, error) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	found, err := s.lookup(r)
+	if err != nil {
+		return nil, err
+	}
+	return found.statusResponse(), nil
+}
+
+func (s *Server) runResult(r *http.Request, _ string) (proto.Message, error) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	found, err := s.lookup(r)
+	if err != nil {
+		return nil, err
+	}
+	return &steprpcv1.RunResultResponse{
+		RunId:    found.runID,
+		State:    string(found.state),
+		Result:   found.result,
+		Error:    found.err,
+		RunState: found.state.Proto(),
+	}, nil
+}
+
+func (s *Server) runLog(r *http.Request, _ string) (proto.Message, error) {
+	offset := int64(0)
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || n < 0 {
+			return nil, badRequest("offset must be a non-negative integer")
+		}
+		offset = n
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	found, err := s.lookup(r)
+	if err != nil {
+		return nil, err
+	}
+	log := found.log
+	start := int(min(offset, int64(len(log))))
+	end := utf8Boundary(log, min(len(log), start+maxLogChunkBytes))
+	return &steprpcv1.RunLogResponse{
+		RunId:      found.runID,
+		Offset:     int64(start),
+		Text:       log[start:end],
+		NextOffset: int64(end),
+		Complete:   found.state.IsTerminal() && end == len(log),
+	}, nil
+}
+
+// utf8Boundary moves end back so a chunk never splits a UTF-8 sequence.
+func utf8Boundary(s string, end int) int {
+	for end > 0 && end < len(s) && s[end]&0xC0 == 0x80 {
+		end--
+	}
+	return end
+}
+
+// bridgePending hands out the oldest unleased request for the run, waiting up
+// to waitSeconds for one. Every answer is a delivery, after which the request
+// is withheld for pendingDeliveryTTL, so a long poll waits for a new request;
+// see bridgeClaim for the leased form.
+func (s *Server) bridgePending(r *http.Request, user string) (proto.Message, error) {
+	q := r.URL.Query()
+	target := q.Get("runExternalizableId")
+	if strings.TrimSpace(target) == "" {
+		return nil, badRequest("runExternalizableId query parameter is required")
+	}
+	wait := time.Duration(0)
+	if raw := q.Get("waitSeconds"); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || n < 0 {
+			return nil, badRequest("waitSeconds must be a non-negative integer")
+		}
+		wait = min(time.Duration(n)*time.Second, maxPendingWait)
+	}
+	worker := q.Get("workerId")
+	if worker == "" {
+		worker = user
+	}
+
+	var deadline <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	for {
+		s.state.mu.Lock()
+		now := s.now()
+		if next := s.state.next(target, now); next != nil {
+			// An expired lease is dropped so a legacy worker can complete
+			// without one.
+			next.lease = nil
+			next.deliveredUntil = now.Add(pendingDeliveryTTL)
+			next.deliver(worker, now)
+			resp := next.pendingResponse()
+			s.state.mu.Unlock()
+			return resp, nil
+		}
+		enqueued := s.state.enqueued
+		s.state.mu.Unlock()
+
+		if wait == 0 {
+			return nil, &apiError{
+				status:  http.StatusNotFound,
+				code:    string(rpcclient.CodeNoPendingRequest),
+				message: "no pending bridge request for run '" + target + "'",
+			}
+		}
+		select {
+		case <-enqueued:
+		case <-deadline:
+			return nil, nil
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		}
+	}
+}
+
+func (s *Server) bridgeRuns(r *http.Request, _ string) (proto.Message, error) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	return &steprpcv1.BridgeRunsResponse{Runs: s.state.summaries(r.URL.Query().Get("folder"), s.now())}, nil
+}
+
+func (s *Server) bridgeClaim(r *http.Request, user string) (proto.Message, error) {
+	req := &steprpcv1.BridgeClaimRequest{}
+	if err := readProto(r, req, false); err != nil {
+		return nil, err
+	}
+	target := req.GetRunExternalizableId()
+	if strings.TrimSpace(target) == "" {
+		return nil, badRequest("runExternalizableId is required")
+	}
+	worker := req.GetWorkerId()
+	if worker == "" {
+		worker = user
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	now := s.now()
+	next := s.state.next(target, now)
+	if next == nil {
+		return nil, &apiError{
+			status:  http.StatusNotFound,
+			code:    string(rpcclient.CodeNoPendingRequest),
+			message: "no pending bridge request for run '" + target + "'",
+		}
+	}
+	next.lease = &lease{id: newLeaseID(), workerID: worker, expires: now.Add(leaseTTL(req.GetLeaseSeconds()))}
+	next.deliveredUntil = time.Time{}
+	next.deliver(worker, now)
+	return &steprpcv1.BridgeClaimResponse{
+		Request:        next.pendingResponse(),
+		LeaseId:        next.lease.id,
+		LeaseExpiresAt: timestamppb.New(next.lease.expires),
+		Attempt:        next.attempts,
+	}, nil
+}
+
+func (s *Server) bridgeHeartbeat(r *http.Request, _ string) (proto.Message, error) {
+	req := &steprpcv1.BridgeHeartbeatRequest{}
+	if err := readProto(r, req, false); err != nil {
+		return nil, err
+	}
+	if req.GetRunId() == "" || req.GetLeaseId() == "" {
+		return nil, badRequest("runId and leaseId are required")
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	now := s.now()
+	queued := s.state.queued(req.GetRunId())
+	if queued == nil {
+		return nil, bridgeRequestNotFound(req.GetRunId())
+	}
+	if err := queued.checkLease(req.GetLeaseId(), now); err != nil {
+		return nil, err
+	}
+	queued.lease.expires = now.Add(leaseTTL(req.GetLeaseSeconds()))
+	return &steprpcv1.BridgeHeartbeatResponse{
+		RunId:          queued.runID,
+		LeaseId:        queued.lease.id,
+		LeaseExpiresAt: timestamppb.New(queued.lease.expires),
+	}, nil
+}
+
+func (s *Server) bridgeProgress(r *http.Request, _ string) (proto.Message, error) {
+	req := &steprpcv1.BridgeProgressRequest{}
+	if err := readProto(r, req, false); err != nil {
+		return nil, err
+	}
+	if req.GetRunId() == "" {
+		return nil, badRequest("runId is required")
+	}
+	if req.GetPercent() < 0 || req.GetPercent() > 100 {
+		return nil, badRequest("percent must be a whole number from 0 to 100")
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	now := s.now()
+	queued := s.state.queued(req.GetRunId())
+	if queued == nil {
+		return nil, bridgeRequestNotFound(req.GetRunId())
+	}
+	if err := queued.authorize(req.GetLeaseId(), false, now); err != nil {
+		return nil, err
+	}
+	queued.progress = &steprpcv1.RunProgress{
+		Percent:   req.GetPercent(),
+		Message:   req.GetMessage(),
+		UpdatedAt: timestamppb.New(now),
+	}
+	queued.updatedAt = now
+	return &steprpcv1.BridgeProgressResponse{RunId: queued.runID, Progress: queued.progress}, nil
+}
+
+func (s *Server) bridgeComplete(r *http.Request, _ string) (proto.Message, error) {
+	req := &steprpcv1.BridgeCompleteRequest{}
+	if err := readProto(r, req, true); err != nil {
+		return nil, err
+	}
+	if req.GetRunId() == "" || (req.GetState() == "" && req.GetRunState() == steprpcv1.RunState_RUN_STATE_UNSPECIFIED) {
+		return nil, badRequest("runId and state are required")
+	}
+	state := rpcclient.ParseRunState(req.GetState())
+	if req.GetState() == "" {
+		state = rpcclient.RunStateFromProto(req.GetRunState())
+	}
+	if !state.IsTerminal() {
+		return nil, badRequest("state must be one of succeeded, failed, cancelled")
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	now := s.now()
+	queued := s.state.queued(req.GetRunId())
+	if queued == nil {
+		return nil, bridgeRequestNotFound(req.GetRunId())
+	}
+	if err := queued.authorize(req.GetLeaseId(), state == rpcclient.RunStateCancelled, now); err != nil {
+		return nil, err
+	}
+	s.state.dequeue(queued)
+
+	var runErr *steprpcv1.Error
+	if e := req.GetError(); e.GetCode() != "" {
+		runErr = &steprpcv1.Error{Code: e.GetCode(), Message: e.GetMessage()}
+		if runErr.Message == "" {
+			runErr.Message = "operation execution failed"
+		}
+	}
+	queued.finish(state, runErr, req.GetResult(), now)
+	return &steprpcv1.BridgeCompleteResponse{
+		RequestId: queued.requestID,
+		RunId:     queued.runID,
+		State:     string(state),
+		RunState:  state.Proto(),
+	}, nil
+}
+
+// leaseTTL clamps a requested lease length; zero or negative means the
+// default.
+func leaseTTL(seconds int32) time.Duration {
+	if seconds <= 0 {
+		return defaultLeaseTTL
+	}
+	return min(time.Duration(seconds)*time.Second, maxLeaseTTL)
+}
+
+// adminState reports every run's status, oldest first, and the bridge requests
+// still queued in the claim response shape, with lease fields once leased:
+//
+//	{"runs": [RunStatusResponse...], "queue": [BridgeClaimResponse...]}
+func (s *Server) adminState(*http.Request, string) (proto.Message, error) {
+	s.state.mu.Lock()
+	runs := make([]*run, 0, len(s.state.runs))
+	for _, r := range s.state.runs {
+		runs = append(runs, r)
+	}
+	slices.SortFunc(runs, func(a, b *run) int { return a.createdAt.Compare(b.createdAt) })
+	var statuses, queue []proto.Message
+	for _, r := range runs {
+		statuses = append(statuses, r.statusResponse())
+	}
+	for _, r := range s.state.queue {
+		entry := &steprpcv1.BridgeClaimResponse{Request: r.pendingResponse(), Attempt: r.attempts}
+		if r.lease != nil {
+			entry.LeaseId = r.lease.id
+			entry.LeaseExpiresAt = timestamppb.New(r.lease.expires)
+		}
+		queue = append(queue, entry)
+	}
+	s.state.mu.Unlock()
+
+	out := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	for name, msgs := range map[string][]proto.Message{"runs": statuses, "queue": queue} {
+		list, err := jsonList(msgs)
+		if err != nil {
+			return nil, err
+		}
+		out.Fields[name] = structpb.NewListValue(list)
+	}
+	return out, nil
+}
+
+// jsonList converts messages to their protojson form as a Struct list.
+func jsonList(msgs []proto.Message) (*structpb.ListValue, error) {
+	list := &structpb.ListValue{Values: []*structpb.Value{}}
+	for _, m := range msgs {
+		data, err := protojson.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		v := &structpb.Value{}
+		if err := protojson.Unmarshal(data, v); err != nil {
+			return nil, err
+		}
+		list.Values = append(list.Values, v)
+	}
+	return list, nil
+}
+
+func (s *Server) adminReset(*http.Request, string) (proto.Message, error) {
+	s.Reset()
+	return nil, nil
+}
@@ -0,0 +1,383 @@
+package emulator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testScript = `
+allowlist: [echo, flaky, stash, deploy, junit]
+operations:
+  - name: echo
+    duration: 10ms
+    log: "hello\n"
+    result: {message: hello, count: 2}
+  - name: flaky
+    outcome: failed
+    error: {code: operation_failed, message: disk full}
+  - name: stash
+    mode: cpsBridgeRequired
+  - name: deploy
+    mode: cpsBridgeRequired
+    duration: 10ms
+    autoComplete: true
+    log: "deployed\n"
+`
+
+const target = "team/app#7"
+
+func newTestClient(t *testing.T) (*rpcclient.Client, *Server) {
+	t.Helper()
+	script, err := Parse([]byte(testScript))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	srv := New(script)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	c, err := rpcclient.New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c, err = c.Handshake(context.Background())
+	if err != nil {
+		t.Fatalf("Handshake() error = %v", err)
+	}
+	return c, srv
+}
+
+func bridgeArgs(t *testing.T) *structpb.Struct {
+	t.Helper()
+	args, err := rpcclient.InjectRunContext(
+		&structpb.Struct{Fields: map[string]*structpb.Value{"name": structpb.NewStringValue("src")}},
+		rpcclient.NewRunContextForBuild("team/app", 7, "agent-1", "/ws"),
+	)
+	if err != nil {
+		t.Fatalf("InjectRunContext() error = %v", err)
+	}
+	return args
+}
+
+func wantCode(t *testing.T, err error, status int, code rpcclient.ErrorCode) {
+	t.Helper()
+	var httpErr *rpcclient.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != status || httpErr.Code() != code {
+		t.Fatalf("error = %v, want %d %s", err, status, code)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for name, src := range map[string]string{
+		"unknown key":      "operations: [{name: echo, retries: 2}]",
+		"missing name":     "operations: [{mode: direct}]",
+		"duplicate":        "operations: [{name: echo}, {name: echo}]",
+		"bad mode":         "operations: [{name: echo, mode: async}]",
+		"bad outcome":      "operations: [{name: echo, outcome: maybe}]",
+		"direct auto":      "operations: [{name: echo, autoComplete: true}]",
+		"error needs code": "operations: [{name: echo, error: {message: x}}]",
+	} {
+		if _, err := Parse([]byte(src)); err == nil {
+			t.Fatalf("Parse(%s) error = nil, want error", name)
+		}
+	}
+
+	s, err := Parse([]byte("operations: [{name: echo, duration: 2s}]"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if op := s.Operations[0]; op.Mode != ModeDirect || op.Outcome != OutcomeSucceeded || op.Duration != 2*time.Second {
+		t.Fatalf("defaults = %+v", op)
+	}
+}
+
+func TestEmulator_HealthAndCatalog(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+
+	if !c.Capabilities().Has(rpcclient.CapabilityBridgeDiscovery) {
+		t.Fatalf("capabilities = %v", c.Capabilities())
+	}
+	catalog, err := c.GetCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	var names []string
+	for _, op := range catalog.GetOperations() {
+		names = append(names, op.GetName()+":"+op.GetExecutionMode().String())
+	}
+	want := "deploy:OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED echo:OPERATION_EXECUTION_MODE_DIRECT " +
+		"flaky:OPERATION_EXECUTION_MODE_DIRECT junit:OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED " +
+		"stash:OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"
+	if got := strings.Join(names, " "); got != want {
+		t.Fatalf("catalog = %s", got)
+	}
+}
+
+func TestEmulator_DirectInvoke(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	resp, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "echo"})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if resp.GetState() != "succeeded" || resp.GetRunState() != steprpcv1.RunState_RUN_STATE_SUCCEEDED || !strings.HasPrefix(resp.GetRunId(), "rpc-") {
+		t.Fatalf("Invoke() = %v", resp)
+	}
+	status, err := c.GetRunStatus(ctx, resp.GetRunId())
+	if err != nil {
+		t.Fatalf("GetRunStatus() error = %v", err)
+	}
+	if status.GetAttempts() != 1 || status.GetStartedAt() == nil || status.GetCompletedAt() == nil ||
+		status.GetResult().GetFields()["message"].GetStringValue() != "hello" {
+		t.Fatalf("GetRunStatus() = %v", status)
+	}
+	logResp, err := c.GetRunLog(ctx, resp.GetRunId(), 0)
+	if err != nil {
+		t.Fatalf("GetRunLog() error = %v", err)
+	}
+	if logResp.GetText() != "hello\n" || !logResp.GetComplete() || logResp.GetNextOffset() != 6 {
+		t.Fatalf("GetRunLog() = %v", logResp)
+	}
+
+	replay, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "echo"})
+	if err != nil || replay.GetRunId() != resp.GetRunId() {
+		t.Fatalf("replay = %v, %v; want run %s", replay, err, resp.GetRunId())
+	}
+	_, err = c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "flaky"})
+	wantCode(t, err, http.StatusBadRequest, rpcclient.CodeBadRequest)
+	_, err = c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-2", Operation: "shell"})
+	wantCode(t, err, http.StatusBadRequest, rpcclient.CodeOperationNotAllowed)
+
+	failed, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-3", Operation: "flaky"})
+	if err != nil || failed.GetError().GetMessage() != "disk full" || failed.GetState() != "failed" {
+		t.Fatalf("Invoke(flaky) = %v, %v", failed, err)
+	}
+	missing, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-4", Operation: "junit", Args: bridgeArgs(t)})
+	if err != nil || missing.GetError().GetCode() != string(rpcclient.CodeOperationNotFound) {
+		t.Fatalf("Invoke(junit) = %v, %v", missing, err)
+	}
+
+	_, err = c.GetRunStatus(ctx, "rpc-missing")
+	wantCode(t, err, http.StatusNotFound, rpcclient.CodeRunNotFound)
+}
+
+func TestEmulator_BridgeLeases(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-0", Operation: "stash"})
+	wantCode(t, err, http.StatusBadRequest, rpcclient.CodeBadRequest)
+
+	resp, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "stash", Args: bridgeArgs(t)})
+	if err != nil || resp.GetState() != "queued" {
+		t.Fatalf("Invoke() = %v, %v", resp, err)
+	}
+	runs, err := c.ListBridgeRuns(ctx, "team")
+	if err != nil || len(runs.GetRuns()) != 1 || runs.GetRuns()[0].GetRunExternalizableId() != target || runs.GetRuns()[0].GetPending() != 1 {
+		t.Fatalf("ListBridgeRuns() = %v, %v", runs, err)
+	}
+
+	claim, err := c.ClaimBridgeRequest(ctx, target, 30*time.Second)
+	if err != nil {
+		t.Fatalf("ClaimBridgeRequest() error = %v", err)
+	}
+	if claim.GetAttempt() != 1 || claim.GetRequest().GetArgs().GetFields()["runContext"] != nil {
+		t.Fatalf("ClaimBridgeRequest() = %v", claim)
+	}
+	_, err = c.ClaimBridgeRequest(ctx, target, 0)
+	if !errors.Is(err, rpcclient.ErrNoPendingRequest) {
+		t.Fatalf("second ClaimBridgeRequest() error = %v, want ErrNoPendingRequest", err)
+	}
+	if _, err := c.HeartbeatBridgeLease(ctx, resp.GetRunId(), claim.GetLeaseId(), time.Minute); err != nil {
+		t.Fatalf("HeartbeatBridgeLease() error = %v", err)
+	}
+	_, err = c.HeartbeatBridgeLease(ctx, resp.GetRunId(), "stale", time.Minute)
+	wantCode(t, err, http.StatusConflict, rpcclient.CodeLeaseMismatch)
+	if _, err := c.ReportBridgeProgress(ctx, &steprpcv1.BridgeProgressRequest{
+		RunId: resp.GetRunId(), LeaseId: claim.GetLeaseId(), Percent: 50, Message: "halfway",
+	}); err != nil {
+		t.Fatalf("ReportBridgeProgress() error = %v", err)
+	}
+
+	_, err = c.CompleteBridgeRequest(ctx, &steprpcv1.BridgeCompleteRequest{RunId: resp.GetRunId(), State: "succeeded"})
+	wantCode(t, err, http.StatusConflict, rpcclient.CodeLeaseMismatch)
+	done, err := c.CompleteBridgeRequestWithResult(ctx, &steprpcv1.BridgeCompleteRequest{
+		RunId: resp.GetRunId(), State: "succeeded", LeaseId: claim.GetLeaseId(),
+	}, map[string]any{"files": 3})
+	if err != nil || done.GetRunState() != steprpcv1.RunState_RUN_STATE_SUCCEEDED {
+		t.Fatalf("CompleteBridgeRequestWithResult() = %v, %v", done, err)
+	}
+
+	status, err := c.GetRunStatus(ctx, resp.GetRunId())
+	if err != nil {
+		t.Fatalf("GetRunStatus() error = %v", err)
+	}
+	if status.GetState() != "succeeded" || status.GetProgress().GetPercent() != 50 || status.GetWorkerId() != "anonymous" ||
+		status.GetResult().GetFields()["files"].GetNumberValue() != 3 {
+		t.Fatalf("GetRunStatus() = %v", status)
+	}
+	_, err = c.CompleteBridgeRequest(ctx, &steprpcv1.BridgeCompleteRequest{RunId: resp.GetRunId(), State: "failed"})
+	wantCode(t, err, http.StatusNotFound, rpcclient.CodeRunNotFound)
+}
+
+func TestEmulator_PendingAndCancel(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	got := make(chan *steprpcv1.BridgePendingResponse, 1)
+	go func() {
+		pending, err := c.WaitBridgePending(ctx, target, 5*time.Second)
+		if err != nil {
+			t.Errorf("WaitBridgePending() error = %v", err)
+		}
+		got <- pending
+	}()
+	time.Sleep(20 * time.Millisecond)
+	resp, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "stash", Args: bridgeArgs(t)})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if pending := <-got; pending.GetRunId() != resp.GetRunId() {
+		t.Fatalf("WaitBridgePending() = %v", pending)
+	}
+
+	// A delivered request is not handed out again, so a long poll waits for
+	// the next one.
+	_, err = c.GetBridgePending(ctx, target)
+	wantCode(t, err, http.StatusNotFound, rpcclient.CodeNoPendingRequest)
+	go func() {
+		pending, err := c.WaitBridgePending(ctx, target, 5*time.Second)
+		if err != nil {
+			t.Errorf("WaitBridgePending() error = %v", err)
+		}
+		got <- pending
+	}()
+	time.Sleep(20 * time.Millisecond)
+	next, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-2", Operation: "stash", Args: bridgeArgs(t)})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if pending := <-got; pending.GetRunId() != next.GetRunId() {
+		t.Fatalf("WaitBridgePending() = %v, want the new request", pending)
+	}
+
+	for _, run := range []*steprpcv1.InvokeResponse{resp, next} {
+		if _, err := c.CancelRun(ctx, run.GetRunId(), "not needed"); err != nil {
+			t.Fatalf("CancelRun() error = %v", err)
+		}
+	}
+	status, err := c.GetRunStatus(ctx, resp.GetRunId())
+	if err != nil || status.GetState() != "cancelled" || status.GetAttempts() != 1 {
+		t.Fatalf("GetRunStatus() = %v, %v", status, err)
+	}
+	_, err = c.WaitBridgePending(ctx, target, time.Second)
+	if !errors.Is(err, rpcclient.ErrNoPendingRequest) {
+		t.Fatalf("WaitBridgePending() error = %v, want ErrNoPendingRequest", err)
+	}
+}
+
+func TestEmulator_AutoCompleteAndAdmin(t *testing.T) {
+	t.Parallel()
+	script, err := Parse([]byte(testScript))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	ts := httptest.NewServer(New(script).WithToken("secret"))
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	anon, err := rpcclient.New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	_, err = anon.GetHealth(ctx)
+	wantCode(t, err, http.StatusUnauthorized, CodeUnauthenticated)
+
+	c, err := rpcclient.New(ts.URL, "secret", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	resp, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "deploy", Args: bridgeArgs(t)})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	status, err := c.WaitRunTerminal(ctx, resp.GetRunId(), rpcclient.PollPolicy{InitialInterval: 5 * time.Millisecond, MaxInterval: 5 * time.Millisecond})
+	if err != nil || status.GetState() != "succeeded" || status.GetWorkerId() != Service {
+		t.Fatalf("WaitRunTerminal() = %v, %v", status, err)
+	}
+	if _, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-2", Operation: "stash", Args: bridgeArgs(t)}); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+
+	var state struct {
+		Runs  []map[string]any `json:"runs"`
+		Queue []map[string]any `json:"queue"`
+	}
+	adminGet(t, ts.URL+"/emulator/state", http.StatusOK, &state)
+	if len(state.Runs) != 2 || state.Runs[0]["runId"] != resp.GetRunId() || len(state.Queue) != 1 {
+		t.Fatalf("state = %+v", state)
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/emulator/reset", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err := ts.Client().Do(req)
+	if err != nil || res.StatusCode != http.StatusNoContent {
+		t.Fatalf("reset = %v, %v", res, err)
+	}
+	_ = res.Body.Close()
+	_, err = c.GetRunStatus(ctx, resp.GetRunId())
+	wantCode(t, err, http.StatusNotFound, rpcclient.CodeRunNotFound)
+}
+
+func TestEmulator_BatchTooLarge(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(New(DefaultScript()))
+	t.Cleanup(ts.Close)
+
+	body := `{"requests":[` + strings.Repeat(`{"requestId":"r","operation":"echo"},`, maxBatchSize) + `{"requestId":"r","operation":"echo"}]}`
+	res, err := ts.Client().Post(ts.URL+"/step-rpc/v1/batchInvoke", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	var raw map[string]map[string]any
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if res.StatusCode != http.StatusBadRequest || raw["error"]["code"] != string(rpcclient.CodeBatchTooLarge) {
+		t.Fatalf("batchInvoke = %d %v", res.StatusCode, raw)
+	}
+}
+
+func adminGet(t *testing.T, url string, status int, out any) {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	req.SetBasicAuth("admin", "secret")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s error = %v", url, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != status {
+		t.Fatalf("GET %s status = %d, want %d", url, res.StatusCode, status)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+}
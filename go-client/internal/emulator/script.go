@@ -0,0 +1,242 @@
+package emulator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+// Execution modes an Operation may declare.
+const (
+	ModeDirect            = "direct"
+	ModeCPSBridgeRequired = "cpsBridgeRequired"
+)
+
+// Outcomes an Operation may declare.
+const (
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+)
+
+// Script describes the operations the emulator serves:
+//
+//	allowlist: [echo, junit, stash]
+//	operations:
+//	  - name: echo
+//	    mode: direct
+//	    duration: 200ms
+//	    log: "hello\n"
+//	    result: {message: hello}
+//	  - name: junit
+//	    mode: direct
+//	    outcome: failed
+//	    error: {code: operation_failed, message: "3 tests failed"}
+//	  - name: stash
+//	    mode: cpsBridgeRequired
+//	    duration: 1s
+//	    autoComplete: true
+//
+// Direct operations finish within the invoke call after sleeping for
+// duration. Bridge operations are queued for their args.runContext run and
+// wait for a worker, unless autoComplete finishes them after duration. An
+// empty allowlist allows every scripted operation, like a controller without
+// one.
+type Script struct {
+	Allowlist  []string     `yaml:"allowlist"`
+	Operations []*Operation `yaml:"operations"`
+}
+
+// Operation scripts one operation's catalog entry and execution.
+type Operation struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Mode is ModeDirect (the default) or ModeCPSBridgeRequired.
+	Mode     string        `yaml:"mode"`
+	Duration time.Duration `yaml:"duration"`
+	// Outcome is OutcomeSucceeded (the default) or OutcomeFailed.
+	Outcome string `yaml:"outcome"`
+	// Error is reported by failed runs; it defaults to operation_failed.
+	Error *ScriptError `yaml:"error"`
+	// Log is the run's console output.
+	Log    string         `yaml:"log"`
+	Result map[string]any `yaml:"result"`
+	// AutoComplete finishes bridge requests without a worker.
+	AutoComplete bool `yaml:"autoComplete"`
+
+	result *structpb.Struct
+}
+
+// ScriptError is the error a failed run reports.
+type ScriptError struct {
+	Code    string `yaml:"code"`
+	Message string `yaml:"message"`
+}
+
+// DefaultScript serves a succeeding direct echo and a bridge-only stash, for
+// running the emulator without a script file.
+func DefaultScript() *Script {
+	s := &Script{Operations: []*Operation{
+		{Name: "echo", Log: "echo\n", Result: map[string]any{"message": "echo"}},
+		{Name: "stash", Mode: ModeCPSBridgeRequired},
+	}}
+	if err := s.Validate(); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Load reads a script file.
+func Load(path string) (*Script, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from operator flags
+	if err != nil {
+		return nil, fmt.Errorf("read emulator script: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a YAML or JSON script and validates it. Unknown keys are
+// rejected.
+func Parse(data []byte) (*Script, error) {
+	s := &Script{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(s); err != nil {
+			return nil, fmt.Errorf("decode emulator script: %w", err)
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Validate fills in defaults and checks names, modes, outcomes, and results.
+func (s *Script) Validate() error {
+	seen := map[string]bool{}
+	for i, op := range s.Operations {
+		if op == nil || op.Name == "" {
+			return fmt.Errorf("operations[%d].name is required", i)
+		}
+		if seen[op.Name] {
+			return fmt.Errorf("operations[%d]: duplicate operation %q", i, op.Name)
+		}
+		seen[op.Name] = true
+
+		switch op.Mode {
+		case "":
+			op.Mode = ModeDirect
+		case ModeDirect, ModeCPSBridgeRequired:
+		default:
+			return fmt.Errorf("operations[%d].mode: want %s or %s, got %q", i, ModeDirect, ModeCPSBridgeRequired, op.Mode)
+		}
+		switch op.Outcome {
+		case "":
+			op.Outcome = OutcomeSucceeded
+		case OutcomeSucceeded, OutcomeFailed:
+		default:
+			return fmt.Errorf("operations[%d].outcome: want %s or %s, got %q", i, OutcomeSucceeded, OutcomeFailed, op.Outcome)
+		}
+		if op.Duration < 0 {
+			return fmt.Errorf("operations[%d].duration must not be negative", i)
+		}
+		if op.AutoComplete && op.Mode != ModeCPSBridgeRequired {
+			return fmt.Errorf("operations[%d].autoComplete needs mode %s", i, ModeCPSBridgeRequired)
+		}
+		if op.Error != nil && op.Error.Code == "" {
+			return fmt.Errorf("operations[%d].error.code is required", i)
+		}
+		result, err := structpb.NewStruct(op.Result)
+		if err != nil {
+			return fmt.Errorf("operations[%d].result: %w", i, err)
+		}
+		op.result = result
+	}
+	for i, name := range s.Allowlist {
+		if name == "" {
+			return errors.New("allowlist entries must not be empty")
+		}
+		if slices.Contains(s.Allowlist[:i], name) {
+			return fmt.Errorf("allowlist: duplicate operation %q", name)
+		}
+	}
+	return nil
+}
+
+// operation returns the scripted operation called name.
+func (s *Script) operation(name string) *Operation {
+	for _, op := range s.Operations {
+		if op.Name == name {
+			return op
+		}
+	}
+	return nil
+}
+
+// allows reports whether the allowlist, if any, names operation.
+func (s *Script) allows(operation string) bool {
+	return len(s.Allowlist) == 0 || slices.Contains(s.Allowlist, operation)
+}
+
+// catalog lists operations sorted by name. With an allowlist it lists the
+// allowlist, reporting names the script lacks as bridge operations the way the
+// plugin reports allowlisted steps it did not discover.
+func (s *Script) catalog() []*steprpcv1.CatalogOperation {
+	var out []*steprpcv1.CatalogOperation
+	if len(s.Allowlist) == 0 {
+		for _, op := range s.Operations {
+			out = append(out, op.catalogEntry())
+		}
+	} else {
+		for _, name := range s.Allowlist {
+			if op := s.operation(name); op != nil {
+				out = append(out, op.catalogEntry())
+				continue
+			}
+			out = append(out, &steprpcv1.CatalogOperation{
+				Name:          name,
+				Description:   "Allowlisted operation not discovered on this controller",
+				ExecutionMode: steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED,
+			})
+		}
+	}
+	slices.SortFunc(out, func(a, b *steprpcv1.CatalogOperation) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return out
+}
+
+func (op *Operation) catalogEntry() *steprpcv1.CatalogOperation {
+	entry := &steprpcv1.CatalogOperation{
+		Name:          op.Name,
+		Description:   op.Description,
+		ExecutionMode: steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT,
+	}
+	if op.Mode == ModeCPSBridgeRequired {
+		entry.ExecutionMode = steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED
+	}
+	if entry.Description == "" {
+		entry.Description = "Scripted " + op.Mode + " operation"
+	}
+	return entry
+}
+
+// runError is the error a run of op reports when its outcome is failed.
+func (op *Operation) runError() *steprpcv1.Error {
+	if op.Error != nil {
+		msg := op.Error.Message
+		if msg == "" {
+			msg = "operation execution failed"
+		}
+		return &steprpcv1.Error{Code: op.Error.Code, Message: msg}
+	}
+	return &steprpcv1.Error{Code: "operation_failed", Message: "operation execution failed"}
+}
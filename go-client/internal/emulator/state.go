@@ -0,0 +1,287 @@
+package emulator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Lease lengths, matching the plugin.
+const (
+	defaultLeaseTTL = 60 * time.Second
+	maxLeaseTTL     = 10 * time.Minute
+)
+
+// maxPendingWait caps bridge/pending long polls, matching the plugin.
+const maxPendingWait = 30 * time.Second
+
+// pendingDeliveryTTL is how long a request handed out by bridge/pending is
+// withheld from further fetches and claims, matching the plugin.
+const pendingDeliveryTTL = defaultLeaseTTL
+
+// run is the emulator's record of one invocation. Bridge runs also sit in
+// store.queue until they are completed.
+type run struct {
+	requestID   string
+	runID       string
+	operation   string
+	mode        steprpcv1.OperationExecutionMode
+	state       rpcclient.RunState
+	createdAt   time.Time
+	startedAt   time.Time
+	updatedAt   time.Time
+	completedAt time.Time
+	attempts    int32
+	workerID    string
+	err         *steprpcv1.Error
+	result      *structpb.Struct
+	log         string
+	progress    *steprpcv1.RunProgress
+
+	target string
+	args   *structpb.Struct
+	lease  *lease
+	// deliveredUntil withholds a request bridge/pending handed out.
+	deliveredUntil time.Time
+}
+
+type lease struct {
+	id       string
+	workerID string
+	expires  time.Time
+}
+
+func (l *lease) live(now time.Time) bool {
+	return l != nil && now.Before(l.expires)
+}
+
+// store holds every run and the bridge queue in enqueue order.
+type store struct {
+	mu        sync.Mutex
+	runs      map[string]*run
+	byRequest map[string]*run
+	queue     []*run
+	// enqueued is closed and replaced whenever a request is queued, waking
+	// long polls.
+	enqueued chan struct{}
+}
+
+func newStore() *store {
+	return &store{
+		runs:      map[string]*run{},
+		byRequest: map[string]*run{},
+		enqueued:  make(chan struct{}),
+	}
+}
+
+// reset forgets every run. Long polls in progress keep waiting on the new
+// queue.
+func (s *store) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs = map[string]*run{}
+	s.byRequest = map[string]*run{}
+	s.queue = nil
+}
+
+// add records r, queueing it when it has a target run. Callers hold mu.
+func (s *store) add(r *run) {
+	s.runs[r.runID] = r
+	s.byRequest[r.requestID] = r
+	if r.target != "" {
+		s.queue = append(s.queue, r)
+		close(s.enqueued)
+		s.enqueued = make(chan struct{})
+	}
+}
+
+// next returns the oldest request for target without a live lease or recent
+// bridge/pending delivery. Callers hold mu.
+func (s *store) next(target string, now time.Time) *run {
+	for _, r := range s.queue {
+		if r.target == target && r.available(now) {
+			return r
+		}
+	}
+	return nil
+}
+
+// queued returns the queued request for runID. Callers hold mu.
+func (s *store) queued(runID string) *run {
+	for _, r := range s.queue {
+		if r.runID == runID {
+			return r
+		}
+	}
+	return nil
+}
+
+// dequeue removes r from the queue. Callers hold mu.
+func (s *store) dequeue(r *run) {
+	for i, q := range s.queue {
+		if q == r {
+			s.queue = append(s.queue[:i], s.queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// summaries counts queued and leased requests per target run inside folder,
+// sorted by run. Callers hold mu.
+func (s *store) summaries(folder string, now time.Time) []*steprpcv1.BridgeRunSummary {
+	prefix := strings.Trim(folder, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	byTarget := map[string]*steprpcv1.BridgeRunSummary{}
+	for _, r := range s.queue {
+		if !strings.HasPrefix(r.target, prefix) {
+			continue
+		}
+		sum := byTarget[r.target]
+		if sum == nil {
+			sum = &steprpcv1.BridgeRunSummary{RunExternalizableId: r.target}
+			byTarget[r.target] = sum
+		}
+		if !r.available(now) {
+			sum.Leased++
+		} else {
+			sum.Pending++
+		}
+	}
+	out := make([]*steprpcv1.BridgeRunSummary, 0, len(byTarget))
+	for _, sum := range byTarget {
+		out = append(out, sum)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].GetRunExternalizableId() < out[j].GetRunExternalizableId() })
+	return out
+}
+
+// available reports whether r may be handed to a worker at now.
+func (r *run) available(now time.Time) bool {
+	return !r.lease.live(now) && !now.Before(r.deliveredUntil)
+}
+
+// deliver hands r to a worker: one more attempt, and running from now on.
+func (r *run) deliver(workerID string, now time.Time) {
+	r.attempts++
+	r.workerID = workerID
+	if r.state == rpcclient.RunStateQueued {
+		r.state = rpcclient.RunStateRunning
+		r.startedAt = now
+	}
+	r.updatedAt = now
+}
+
+// finish moves r to a terminal state.
+func (r *run) finish(state rpcclient.RunState, runErr *steprpcv1.Error, result *structpb.Struct, now time.Time) {
+	r.state = state
+	r.err = runErr
+	if result != nil {
+		r.result = result
+	}
+	r.lease = nil
+	r.updatedAt = now
+	r.completedAt = now
+}
+
+// authorize checks a worker's leaseID before it reports on r. A leased
+// request needs its current, live lease unless the worker is cancelling it, and
+// a leaseID for an unleased request is a mismatch.
+func (r *run) authorize(leaseID string, cancel bool, now time.Time) error {
+	if leaseID == "" && (r.lease == nil || cancel) {
+		return nil
+	}
+	return r.checkLease(leaseID, now)
+}
+
+// checkLease rejects leaseID unless it is r's current, live lease.
+func (r *run) checkLease(leaseID string, now time.Time) error {
+	switch {
+	case r.lease == nil || r.lease.id != leaseID:
+		return &apiError{
+			status:  http.StatusConflict,
+			code:    string(rpcclient.CodeLeaseMismatch),
+			message: "lease '" + leaseID + "' is not the current lease for run '" + r.runID + "'",
+		}
+	case !r.lease.live(now):
+		return &apiError{
+			status:  http.StatusConflict,
+			code:    string(rpcclient.CodeLeaseExpired),
+			message: "lease '" + leaseID + "' for run '" + r.runID + "' expired at " + r.lease.expires.UTC().Format(time.RFC3339Nano),
+		}
+	default:
+		return nil
+	}
+}
+
+func (r *run) invokeResponse() *steprpcv1.InvokeResponse {
+	return &steprpcv1.InvokeResponse{
+		RequestId: r.requestID,
+		RunId:     r.runID,
+		State:     string(r.state),
+		Error:     r.err,
+		RunState:  r.state.Proto(),
+	}
+}
+
+func (r *run) statusResponse() *steprpcv1.RunStatusResponse {
+	resp := &steprpcv1.RunStatusResponse{
+		RequestId:     r.requestID,
+		RunId:         r.runID,
+		Operation:     r.operation,
+		State:         string(r.state),
+		CreatedAt:     timestamppb.New(r.createdAt),
+		Error:         r.err,
+		UpdatedAt:     timestamppb.New(r.updatedAt),
+		Attempts:      r.attempts,
+		ExecutionMode: r.mode,
+		WorkerId:      r.workerID,
+		RunState:      r.state.Proto(),
+		Progress:      r.progress,
+	}
+	if !r.startedAt.IsZero() {
+		resp.StartedAt = timestamppb.New(r.startedAt)
+	}
+	if !r.completedAt.IsZero() {
+		resp.CompletedAt = timestamppb.New(r.completedAt)
+	}
+	if r.state.IsTerminal() && len(r.result.GetFields()) > 0 {
+		resp.Result = r.result
+	}
+	return resp
+}
+
+func (r *run) pendingResponse() *steprpcv1.BridgePendingResponse {
+	return &steprpcv1.BridgePendingResponse{
+		RequestId:                 r.requestID,
+		RunId:                     r.runID,
+		Operation:                 r.operation,
+		Args:                      r.args,
+		TargetRunExternalizableId: r.target,
+	}
+}
+
+// newRunID returns an id in the plugin's rpc-<12 hex> form.
+func newRunID() string {
+	return "rpc-" + randomHex(6)
+}
+
+func newLeaseID() string {
+	return randomHex(16)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,187 @@
+// Package gateway implements steprpc.v1.StepRpcService by calling the Jenkins
+// Step RPC plugin over HTTP.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultWatchInterval is how often WatchRun polls run status.
+const DefaultWatchInterval = time.Second
+
+// Server is a StepRpcService backed by a plugin client.
+type Server struct {
+	steprpcv1.UnimplementedStepRpcServiceServer
+
+	client        *rpcclient.Client
+	watchInterval time.Duration
+}
+
+// New returns a server that forwards every call through client.
+func New(client *rpcclient.Client) *Server {
+	return &Server{client: client, watchInterval: DefaultWatchInterval}
+}
+
+// WithWatchInterval returns a copy that polls run status every d in WatchRun.
+func (s *Server) WithWatchInterval(d time.Duration) *Server {
+	cp := *s
+	if d > 0 {
+		cp.watchInterval = d
+	}
+	return &cp
+}
+
+// Health returns the plugin's health document.
+func (s *Server) Health(ctx context.Context, _ *steprpcv1.HealthRequest) (*steprpcv1.HealthResponse, error) {
+	resp, err := s.client.GetHealth(ctx)
+	return resp, toStatus(err)
+}
+
+// Catalog returns the plugin's operation catalog.
+func (s *Server) Catalog(ctx context.Context, _ *steprpcv1.CatalogRequest) (*steprpcv1.CatalogResponse, error) {
+	resp, err := s.client.GetCatalog(ctx)
+	return resp, toStatus(err)
+}
+
+// Invoke starts an operation.
+func (s *Server) Invoke(ctx context.Context, req *steprpcv1.InvokeRequest) (*steprpcv1.InvokeResponse, error) {
+	if req.GetOperation() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation is required")
+	}
+	resp, err := s.client.Invoke(ctx, req)
+	return resp, toStatus(err)
+}
+
+// GetRunStatus returns the current status of a run.
+func (s *Server) GetRunStatus(ctx context.Context, req *steprpcv1.GetRunStatusRequest) (*steprpcv1.RunStatusResponse, error) {
+	if req.GetRunId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "run_id is required")
+	}
+	resp, err := s.client.GetRunStatus(ctx, req.GetRunId())
+	return resp, toStatus(err)
+}
+
+// WatchRun sends the run's status whenever its state changes and returns after
+// sending a terminal state.
+func (s *Server) WatchRun(req *steprpcv1.WatchRunRequest, stream steprpcv1.StepRpcService_WatchRunServer) error {
+	if req.GetRunId() == "" {
+		return status.Error(codes.InvalidArgument, "run_id is required")
+	}
+	ctx := stream.Context()
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	last := rpcclient.RunStateUnknown
+	for {
+		resp, err := s.client.GetRunStatus(ctx, req.GetRunId())
+		if err != nil {
+			return toStatus(err)
+		}
+		if state := rpcclient.RunStateOf(resp); state != last {
+			s.client.ObserveTransition(req.GetRunId(), last, state)
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = state
+		}
+		if last.IsTerminal() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// BridgePending returns the pending CPS bridge request for a run, long-polling
+// for req.wait_seconds when set.
+func (s *Server) BridgePending(ctx context.Context, req *steprpcv1.BridgePendingRequest) (*steprpcv1.BridgePendingResponse, error) {
+	if req.GetRunExternalizableId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "run_externalizable_id is required")
+	}
+	resp, err := s.client.WaitBridgePending(ctx, req.GetRunExternalizableId(), time.Duration(req.GetWaitSeconds())*time.Second)
+	return resp, toStatus(err)
+}
+
+// BridgeComplete completes a CPS bridge request.
+func (s *Server) BridgeComplete(ctx context.Context, req *steprpcv1.BridgeCompleteRequest) (*steprpcv1.BridgeCompleteResponse, error) {
+	if req.GetRunId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "run_id is required")
+	}
+	resp, err := s.client.CompleteBridgeRequest(ctx, req)
+	return resp, toStatus(err)
+}
+
+// toStatus converts a client error to a gRPC status error. A structured plugin
+// error is attached to the status details as a *steprpcv1.Error.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if errors.Is(err, rpcclient.ErrCapabilityUnsupported) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var httpErr *rpcclient.HTTPError
+	if !errors.As(err, &httpErr) {
+		if errors.Is(err, rpcclient.ErrNoPendingRequest) {
+			// A long poll that ended with nothing pending (204).
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	st := status.New(codeOf(httpErr), err.Error())
+	if httpErr.ProtoError != nil {
+		if detailed, derr := st.WithDetails(httpErr.ProtoError); derr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// codeOf maps a plugin HTTP error to the closest gRPC code.
+func codeOf(e *rpcclient.HTTPError) codes.Code {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusConflict:
+		return codes.FailedPrecondition
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	switch e.Category() {
+	case rpcclient.CategoryNotFound:
+		return codes.NotFound
+	case rpcclient.CategoryBadRequest:
+		return codes.InvalidArgument
+	case rpcclient.CategoryRateLimited:
+		return codes.ResourceExhausted
+	case rpcclient.CategoryServerError:
+		return codes.Internal
+	case rpcclient.CategoryAuth:
+		return codes.PermissionDenied
+	case rpcclient.CategoryUnknown, rpcclient.CategoryNetwork:
+		return codes.Unknown
+	default:
+		return codes.Unknown
+	}
+}
@@ -0,0 +1,297 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakePlugin is an in-process stand-in for the plugin's HTTP API. Runs report
+// "running" for the first status poll and "succeeded" afterwards.
+type fakePlugin struct {
+	mu    sync.Mutex
+	polls map[string]int
+}
+
+func (f *fakePlugin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	writeError := func(status int, code, message string, details map[string]string) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": code, "message": message, "details": details}})
+	}
+
+	switch {
+	case r.URL.Path == "/step-rpc/v1/":
+		_, _ = io.WriteString(w, `{"apiVersion":"v1","service":"jenkins-step-rpc-plugin","status":"ok"}`)
+	case r.URL.Path == "/step-rpc/v1/catalog":
+		_, _ = io.WriteString(w, `{"operations":[{"name":"echo","executionMode":"OPERATION_EXECUTION_MODE_DIRECT"}]}`)
+	case r.URL.Path == "/step-rpc/v1/invoke":
+		var req struct {
+			RequestID string `json:"requestId"`
+			Operation string `json:"operation"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Operation != "echo" {
+			writeError(http.StatusForbidden, "operation_not_allowed", "operation is not allowlisted", map[string]string{"operation": req.Operation})
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"requestId":%q,"runId":"run-1","state":"queued"}`, req.RequestID)
+	case strings.HasPrefix(r.URL.Path, "/step-rpc/v1/runs/"):
+		runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+		if runID != "run-1" {
+			writeError(http.StatusNotFound, "run_not_found", "run not found", map[string]string{"runId": runID})
+			return
+		}
+		f.mu.Lock()
+		f.polls[runID]++
+		n := f.polls[runID]
+		f.mu.Unlock()
+		state := "running"
+		if n > 2 {
+			state = "succeeded"
+		}
+		_, _ = fmt.Fprintf(w, `{"requestId":"req-1","runId":"run-1","operation":"echo","state":%q}`, state)
+	case r.URL.Path == "/step-rpc/v1/bridge/pending" && r.URL.Query().Get("waitSeconds") != "":
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == "/step-rpc/v1/bridge/pending":
+		writeError(http.StatusNotFound, "no_pending_request", "nothing pending", nil)
+	case r.URL.Path == "/step-rpc/v1/bridge/complete":
+		_, _ = io.WriteString(w, `{"requestId":"req-1","runId":"run-1","state":"succeeded"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// dialGateway serves a gateway for pluginURL over an in-memory listener.
+func dialGateway(t *testing.T, pluginURL string) steprpcv1.StepRpcServiceClient {
+	t.Helper()
+	client, err := rpcclient.New(pluginURL, "", &http.Client{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	steprpcv1.RegisterStepRpcServiceServer(srv, New(client).WithWatchInterval(time.Millisecond))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return steprpcv1.NewStepRpcServiceClient(conn)
+}
+
+func newFakePlugin(t *testing.T) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(&fakePlugin{polls: map[string]int{}})
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestGateway_EndToEnd(t *testing.T) {
+	t.Parallel()
+
+	gw := dialGateway(t, newFakePlugin(t).URL)
+	ctx := context.Background()
+
+	health, err := gw.Health(ctx, &steprpcv1.HealthRequest{})
+	if err != nil || health.GetStatus() != "ok" {
+		t.Fatalf("Health() = %v, %v", health, err)
+	}
+	catalog, err := gw.Catalog(ctx, &steprpcv1.CatalogRequest{})
+	if err != nil || len(catalog.GetOperations()) != 1 {
+		t.Fatalf("Catalog() = %v, %v", catalog, err)
+	}
+	invoked, err := gw.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "echo"})
+	if err != nil || invoked.GetRunId() != "run-1" {
+		t.Fatalf("Invoke() = %v, %v", invoked, err)
+	}
+
+	stream, err := gw.WatchRun(ctx, &steprpcv1.WatchRunRequest{RunId: invoked.GetRunId()})
+	if err != nil {
+		t.Fatalf("WatchRun() error = %v", err)
+	}
+	var states []string
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		states = append(states, resp.GetState())
+	}
+	if strings.Join(states, ",") != "running,succeeded" {
+		t.Fatalf("WatchRun states = %v, want one message per state change", states)
+	}
+
+	st, err := gw.GetRunStatus(ctx, &steprpcv1.GetRunStatusRequest{RunId: "run-1"})
+	if err != nil || st.GetState() != "succeeded" {
+		t.Fatalf("GetRunStatus() = %v, %v", st, err)
+	}
+	done, err := gw.BridgeComplete(ctx, &steprpcv1.BridgeCompleteRequest{RunId: "run-1", State: "succeeded"})
+	if err != nil || done.GetState() != "succeeded" {
+		t.Fatalf("BridgeComplete() = %v, %v", done, err)
+	}
+}
+
+func TestGateway_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	gw := dialGateway(t, newFakePlugin(t).URL)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		code    codes.Code
+		errCode string
+		details map[string]string
+	}{
+		{
+			name: "operation not allowed",
+			call: func() error {
+				_, err := gw.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "r", Operation: "sh"})
+				return err
+			},
+			code:    codes.PermissionDenied,
+			errCode: "operation_not_allowed",
+			details: map[string]string{"operation": "sh"},
+		},
+		{
+			name: "run not found",
+			call: func() error {
+				_, err := gw.GetRunStatus(ctx, &steprpcv1.GetRunStatusRequest{RunId: "missing"})
+				return err
+			},
+			code:    codes.NotFound,
+			errCode: "run_not_found",
+			details: map[string]string{"runId": "missing"},
+		},
+		{
+			name: "watch unknown run",
+			call: func() error {
+				stream, err := gw.WatchRun(ctx, &steprpcv1.WatchRunRequest{RunId: "missing"})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			code:    codes.NotFound,
+			errCode: "run_not_found",
+			details: map[string]string{"runId": "missing"},
+		},
+		{
+			name: "no pending bridge request",
+			call: func() error {
+				_, err := gw.BridgePending(ctx, &steprpcv1.BridgePendingRequest{RunExternalizableId: "job#1"})
+				return err
+			},
+			code:    codes.NotFound,
+			errCode: "no_pending_request",
+		},
+		{
+			name: "long poll ends with nothing pending",
+			call: func() error {
+				_, err := gw.BridgePending(ctx, &steprpcv1.BridgePendingRequest{RunExternalizableId: "job#1", WaitSeconds: 1})
+				return err
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "missing run id",
+			call: func() error {
+				_, err := gw.GetRunStatus(ctx, &steprpcv1.GetRunStatusRequest{})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		st := status.Convert(tt.call())
+		if st.Code() != tt.code {
+			t.Fatalf("%s: code = %v (%v), want %v", tt.name, st.Code(), st.Message(), tt.code)
+		}
+		if tt.errCode == "" {
+			continue
+		}
+		var pe *steprpcv1.Error
+		for _, d := range st.Details() {
+			if e, ok := d.(*steprpcv1.Error); ok {
+				pe = e
+			}
+		}
+		if pe.GetCode() != tt.errCode {
+			t.Fatalf("%s: detail code = %q, want %q", tt.name, pe.GetCode(), tt.errCode)
+		}
+		for k, v := range tt.details {
+			if pe.GetDetails()[k] != v {
+				t.Fatalf("%s: details = %v, want %s=%s", tt.name, pe.GetDetails(), k, v)
+			}
+		}
+	}
+}
+
+func TestGateway_PluginUnreachable(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.NotFoundHandler())
+	url := ts.URL
+	ts.Close()
+
+	gw := dialGateway(t, url)
+	if _, err := gw.Health(context.Background(), &steprpcv1.HealthRequest{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Health() error = %v, want Unavailable", err)
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		want   codes.Code
+	}{
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusConflict, codes.FailedPrecondition},
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusInternalServerError, codes.Internal},
+		{http.StatusNotImplemented, codes.Unimplemented},
+		{http.StatusServiceUnavailable, codes.Unavailable},
+		{http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{http.StatusTeapot, codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := codeOf(&rpcclient.HTTPError{StatusCode: tt.status}); got != tt.want {
+			t.Fatalf("codeOf(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Audit outcomes.
+const (
+	OutcomeAllowed = "allowed"
+	OutcomeDenied  = "denied"
+	OutcomeFailed  = "failed"
+)
+
+// AuditEvent is one request handled by the proxy. Args are redacted with the
+// same rules as the plugin's AuditLogger.
+type AuditEvent struct {
+	Time      time.Time      `json:"time"`
+	Action    string         `json:"action"`
+	Caller    string         `json:"caller,omitempty"`
+	Tenant    string         `json:"tenant,omitempty"`
+	Operation string         `json:"operation,omitempty"`
+	RequestID string         `json:"requestId,omitempty"`
+	RunID     string         `json:"runId,omitempty"`
+	Args      map[string]any `json:"args,omitempty"`
+	Outcome   string         `json:"outcome"`
+	// Status is the HTTP status returned to the caller; Code is the error code.
+	Status   int           `json:"status"`
+	Code     string        `json:"code,omitempty"`
+	Message  string        `json:"message,omitempty"`
+	Duration time.Duration `json:"durationNanos"`
+}
+
+// AuditSink receives audit events. Record must be safe for concurrent use.
+type AuditSink interface {
+	Record(AuditEvent)
+}
+
+// JSONAuditLog writes one JSON object per line.
+type JSONAuditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONAuditLog returns a sink that writes to w.
+func NewJSONAuditLog(w io.Writer) *JSONAuditLog {
+	return &JSONAuditLog{w: w}
+}
+
+// Record implements AuditSink. Write errors are dropped so auditing never
+// fails a request.
+func (l *JSONAuditLog) Record(e AuditEvent) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.w.Write(append(line, '\n'))
+}
+
+type discardAudit struct{}
+
+func (discardAudit) Record(AuditEvent) {}
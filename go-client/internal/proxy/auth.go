@@ -0,0 +1,197 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrUnauthenticated is returned by an Authenticator that rejects a request.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Caller is the authenticated identity behind a request.
+type Caller struct {
+	// Subject identifies the caller, e.g. a service account or user.
+	Subject string
+	// Tenant selects the caller's allowlist, quota, and argument rules.
+	Tenant string
+}
+
+// Authenticator resolves the caller of a request. Errors should wrap
+// ErrUnauthenticated.
+type Authenticator interface {
+	Authenticate(r *http.Request) (Caller, error)
+}
+
+// APIKey is a static key, stored as the hex SHA-256 of the key so the config
+// file never holds the secret itself.
+type APIKey struct {
+	SHA256  string `yaml:"sha256"`
+	Subject string `yaml:"subject"`
+	Tenant  string `yaml:"tenant"`
+}
+
+// APIKeys authenticates "Authorization: Bearer <key>" or "X-API-Key: <key>".
+type APIKeys []APIKey
+
+// HashAPIKey returns the SHA256 value to store for key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate implements Authenticator.
+func (k APIKeys) Authenticate(r *http.Request) (Caller, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		key, _ = bearerToken(r)
+	}
+	if key == "" {
+		return Caller{}, fmt.Errorf("%w: missing API key", ErrUnauthenticated)
+	}
+	sum := HashAPIKey(key)
+	for _, candidate := range k {
+		if subtle.ConstantTimeCompare([]byte(sum), []byte(strings.ToLower(candidate.SHA256))) == 1 {
+			return Caller{Subject: candidate.Subject, Tenant: candidate.Tenant}, nil
+		}
+	}
+	return Caller{}, fmt.Errorf("%w: unknown API key", ErrUnauthenticated)
+}
+
+// JWT authenticates HS256 bearer tokens. The subject comes from "sub" and the
+// tenant from TenantClaim.
+type JWT struct {
+	Secret []byte
+	// Issuer and Audience, when set, must match "iss" and "aud".
+	Issuer   string
+	Audience string
+	// TenantClaim names the claim holding the tenant. Empty means "tenant".
+	TenantClaim string
+	// Leeway tolerates clock skew when checking "exp" and "nbf".
+	Leeway time.Duration
+
+	now func() time.Time
+}
+
+// Authenticate implements Authenticator.
+func (j *JWT) Authenticate(r *http.Request) (Caller, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return Caller{}, fmt.Errorf("%w: missing bearer token", ErrUnauthenticated)
+	}
+	claims, err := j.verify(token)
+	if err != nil {
+		return Caller{}, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	sub, _ := claims["sub"].(string)
+	tenantClaim := j.TenantClaim
+	if tenantClaim == "" {
+		tenantClaim = "tenant"
+	}
+	tenant, _ := claims[tenantClaim].(string)
+	if sub == "" || tenant == "" {
+		return Caller{}, fmt.Errorf("%w: token needs sub and %s claims", ErrUnauthenticated, tenantClaim)
+	}
+	return Caller{Subject: sub, Tenant: tenant}, nil
+}
+
+func (j *JWT) verify(token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("decode header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported alg %q", header.Alg)
+	}
+	mac := hmac.New(sha256.New, j.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, mac.Sum(nil)) {
+		return nil, errors.New("bad signature")
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("decode claims: %w", err)
+	}
+	now := time.Now()
+	if j.now != nil {
+		now = j.now()
+	}
+	if exp, ok := claims["exp"].(float64); ok && now.After(time.Unix(int64(exp), 0).Add(j.Leeway)) {
+		return nil, errors.New("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(j.Leeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("token not yet valid")
+	}
+	if j.Issuer != "" && claims["iss"] != j.Issuer {
+		return nil, errors.New("wrong issuer")
+	}
+	if j.Audience != "" && !hasAudience(claims["aud"], j.Audience) {
+		return nil, errors.New("wrong audience")
+	}
+	return claims, nil
+}
+
+func decodeSegment(seg string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func hasAudience(aud any, want string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == want
+	case []any:
+		return slices.Contains(v, any(want))
+	default:
+		return false
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// FirstOf tries each authenticator in order and returns the first success.
+type FirstOf []Authenticator
+
+// Authenticate implements Authenticator.
+func (f FirstOf) Authenticate(r *http.Request) (Caller, error) {
+	errs := make([]error, 0, len(f))
+	for _, a := range f {
+		caller, err := a.Authenticate(r)
+		if err == nil {
+			return caller, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Caller{}, fmt.Errorf("%w: no authenticators configured", ErrUnauthenticated)
+	}
+	return Caller{}, errors.Join(errs...)
+}
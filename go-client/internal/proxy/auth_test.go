@@ -0,0 +1,154 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var jwtNow = time.Unix(1_700_000_000, 0)
+
+func signJWT(t *testing.T, alg string, secret []byte, claims map[string]any) string {
+	t.Helper()
+	enc := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signing := enc(map[string]string{"alg": alg, "typ": "JWT"}) + "." + enc(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signing))
+	return signing + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func bearerRequest(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/step-rpc/v1/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestJWT_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("shared-secret")
+	j := &JWT{Secret: secret, Issuer: "sso", Audience: "steprpc", TenantClaim: "team", Leeway: time.Minute, now: func() time.Time { return jwtNow }}
+	valid := map[string]any{"sub": "alice", "team": "team-a", "iss": "sso", "aud": []any{"other", "steprpc"}, "exp": jwtNow.Add(time.Hour).Unix()}
+
+	caller, err := j.Authenticate(bearerRequest(signJWT(t, "HS256", secret, valid)))
+	if err != nil || caller != (Caller{Subject: "alice", Tenant: "team-a"}) {
+		t.Fatalf("Authenticate() = %+v, %v", caller, err)
+	}
+
+	with := func(k string, v any) map[string]any {
+		c := map[string]any{}
+		for key, val := range valid {
+			c[key] = val
+		}
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+		return c
+	}
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"bad signature", signJWT(t, "HS256", []byte("other"), valid)},
+		{"alg none", signJWT(t, "none", secret, valid)},
+		{"expired", signJWT(t, "HS256", secret, with("exp", jwtNow.Add(-2*time.Minute).Unix()))},
+		{"not yet valid", signJWT(t, "HS256", secret, with("nbf", jwtNow.Add(2*time.Minute).Unix()))},
+		{"wrong issuer", signJWT(t, "HS256", secret, with("iss", "evil"))},
+		{"wrong audience", signJWT(t, "HS256", secret, with("aud", "other"))},
+		{"missing tenant", signJWT(t, "HS256", secret, with("team", nil))},
+		{"malformed", "a.b"},
+	}
+	for _, tt := range tests {
+		if _, err := j.Authenticate(bearerRequest(tt.token)); !errors.Is(err, ErrUnauthenticated) {
+			t.Fatalf("%s: Authenticate() error = %v, want ErrUnauthenticated", tt.name, err)
+		}
+	}
+
+	// Within leeway an expired token is still accepted.
+	if _, err := j.Authenticate(bearerRequest(signJWT(t, "HS256", secret, with("exp", jwtNow.Add(-30*time.Second).Unix())))); err != nil {
+		t.Fatalf("Authenticate() within leeway error = %v", err)
+	}
+}
+
+func TestAPIKeys_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	keys := APIKeys{{SHA256: strings.ToUpper(HashAPIKey("k1")), Subject: "ci", Tenant: "team-a"}}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-API-Key", "k1")
+	if caller, err := keys.Authenticate(r); err != nil || caller.Subject != "ci" {
+		t.Fatalf("Authenticate(X-API-Key) = %+v, %v", caller, err)
+	}
+	if _, err := keys.Authenticate(bearerRequest("k1")); err != nil {
+		t.Fatalf("Authenticate(bearer) error = %v", err)
+	}
+	if _, err := keys.Authenticate(bearerRequest("k2")); !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("Authenticate(unknown) error = %v, want ErrUnauthenticated", err)
+	}
+
+	// FirstOf falls through to the next authenticator.
+	j := &JWT{Secret: []byte("s"), now: func() time.Time { return jwtNow }}
+	token := signJWT(t, "HS256", []byte("s"), map[string]any{"sub": "alice", "tenant": "team-b"})
+	if caller, err := (FirstOf{keys, j}).Authenticate(bearerRequest(token)); err != nil || caller.Tenant != "team-b" {
+		t.Fatalf("FirstOf.Authenticate() = %+v, %v", caller, err)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Setenv("TEST_PROXY_JWT_SECRET", "s3cret")
+
+	cfg, err := Parse([]byte(`
+apiKeys:
+  - {sha256: ` + HashAPIKey("k") + `, subject: ci, tenant: team-a}
+jwt: {secretEnv: TEST_PROXY_JWT_SECRET, audience: steprpc}
+tenants:
+  team-a:
+    operations: [echo]
+    rateLimit: {requestsPerSecond: 5, burst: 10}
+    args:
+      echo: {forbidden: [script]}
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if _, err := cfg.Authenticator(); err != nil {
+		t.Fatalf("Authenticator() error = %v", err)
+	}
+	if name, err := cfg.Tenants["team-a"].checkArgs("echo", map[string]any{"script": "x"}); name != "script" || err == nil {
+		t.Fatalf("checkArgs() = %q, %v, want script rejected", name, err)
+	}
+
+	invalid := map[string]string{
+		"unknown key":    "tenants: {a: {operatoins: [x]}}",
+		"unknown tenant": "apiKeys: [{sha256: " + HashAPIKey("k") + ", subject: ci, tenant: nope}]",
+		"short hash":     "apiKeys: [{sha256: abc, subject: ci, tenant: a}]\ntenants: {a: {}}",
+		"bad rate":       "tenants: {a: {rateLimit: {requestsPerSecond: 0}}}",
+		"jwt no secret":  "jwt: {issuer: x}",
+	}
+	for name, doc := range invalid {
+		if _, err := Parse([]byte(doc)); err == nil {
+			t.Fatalf("%s: Parse() error = nil, want error", name)
+		}
+	}
+
+	t.Setenv("TEST_PROXY_JWT_SECRET", "")
+	if _, err := cfg.Authenticator(); err == nil {
+		t.Fatalf("Authenticator() error = nil, want missing secret")
+	}
+}
@@ -0,0 +1,176 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AllOperations in Tenant.Operations allows every operation.
+const AllOperations = "*"
+
+// Config is the proxy's caller and tenant configuration:
+//
+//	apiKeys:
+//	  - {sha256: <hex sha256 of key>, subject: ci-bot, tenant: team-a}
+//	jwt: {secretEnv: PROXY_JWT_SECRET, issuer: https://sso.example.com, audience: steprpc}
+//	tenants:
+//	  team-a:
+//	    operations: [echo, archiveArtifacts]
+//	    rateLimit: {requestsPerSecond: 5, burst: 10}
+//	    args:
+//	      archiveArtifacts: {required: [artifacts], forbidden: [excludes]}
+type Config struct {
+	APIKeys APIKeys            `yaml:"apiKeys"`
+	JWT     *JWTConfig         `yaml:"jwt"`
+	Tenants map[string]*Tenant `yaml:"tenants"`
+}
+
+// JWTConfig configures HS256 bearer token authentication.
+type JWTConfig struct {
+	// SecretEnv names the environment variable holding the signing secret.
+	SecretEnv   string        `yaml:"secretEnv"`
+	Issuer      string        `yaml:"issuer"`
+	Audience    string        `yaml:"audience"`
+	TenantClaim string        `yaml:"tenantClaim"`
+	Leeway      time.Duration `yaml:"leeway"`
+}
+
+// Tenant holds the rules applied to every caller in a tenant.
+type Tenant struct {
+	// Operations the tenant may invoke and see in the catalog.
+	Operations []string   `yaml:"operations"`
+	RateLimit  *RateLimit `yaml:"rateLimit"`
+	// Args maps an operation name, or AllOperations, to argument rules.
+	Args map[string]ArgRule `yaml:"args"`
+	// Bridge allows the tenant to poll and complete CPS bridge requests.
+	Bridge bool `yaml:"bridge"`
+}
+
+// RateLimit is a token bucket applied to all of a tenant's requests.
+type RateLimit struct {
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+	Burst             int     `yaml:"burst"`
+}
+
+// ArgRule constrains top-level invoke argument keys.
+type ArgRule struct {
+	Required  []string `yaml:"required"`
+	Forbidden []string `yaml:"forbidden"`
+}
+
+// Load reads a config file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from operator flags
+	if err != nil {
+		return nil, fmt.Errorf("read proxy config: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a YAML or JSON config and validates it. Unknown keys are
+// rejected.
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("decode proxy config: %w", err)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that every API key names a known tenant and that limits are sane.
+func (c *Config) Validate() error {
+	for i, k := range c.APIKeys {
+		if len(k.SHA256) != 64 {
+			return fmt.Errorf("apiKeys[%d].sha256: want 64 hex characters", i)
+		}
+		if k.Subject == "" {
+			return fmt.Errorf("apiKeys[%d].subject is required", i)
+		}
+		if _, ok := c.Tenants[k.Tenant]; !ok {
+			return fmt.Errorf("apiKeys[%d].tenant: unknown tenant %q", i, k.Tenant)
+		}
+	}
+	if c.JWT != nil && c.JWT.SecretEnv == "" {
+		return errors.New("jwt.secretEnv is required")
+	}
+	names := make([]string, 0, len(c.Tenants))
+	for name := range c.Tenants {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		t := c.Tenants[name]
+		if t == nil {
+			return fmt.Errorf("tenants.%s: empty tenant", name)
+		}
+		if rl := t.RateLimit; rl != nil && (rl.RequestsPerSecond <= 0 || rl.Burst < 0) {
+			return fmt.Errorf("tenants.%s.rateLimit: requestsPerSecond must be positive and burst not negative", name)
+		}
+	}
+	return nil
+}
+
+// Authenticator builds the configured authenticators. The JWT secret is read
+// from the environment now, so a missing secret fails at startup.
+func (c *Config) Authenticator() (Authenticator, error) {
+	var auth FirstOf
+	if len(c.APIKeys) > 0 {
+		auth = append(auth, c.APIKeys)
+	}
+	if j := c.JWT; j != nil {
+		secret := strings.TrimSpace(os.Getenv(j.SecretEnv))
+		if secret == "" {
+			return nil, fmt.Errorf("jwt.secretEnv: environment variable %s is not set", j.SecretEnv)
+		}
+		auth = append(auth, &JWT{
+			Secret:      []byte(secret),
+			Issuer:      j.Issuer,
+			Audience:    j.Audience,
+			TenantClaim: j.TenantClaim,
+			Leeway:      j.Leeway,
+		})
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("no apiKeys or jwt configured")
+	}
+	return auth, nil
+}
+
+func (t *Tenant) allowsOperation(op string) bool {
+	return slices.Contains(t.Operations, AllOperations) || slices.Contains(t.Operations, op)
+}
+
+// checkArgs applies the AllOperations rule and then the operation's rule.
+func (t *Tenant) checkArgs(op string, args map[string]any) (string, error) {
+	for _, key := range []string{AllOperations, op} {
+		rule, ok := t.Args[key]
+		if !ok {
+			continue
+		}
+		for _, name := range rule.Required {
+			if _, ok := args[name]; !ok {
+				return name, fmt.Errorf("argument %q is required", name)
+			}
+		}
+		for _, name := range rule.Forbidden {
+			if _, ok := args[name]; ok {
+				return name, fmt.Errorf("argument %q is not allowed", name)
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,148 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// ownerIdleTTL drops runs nobody asked about for this long, such as runs
+	// whose owner stopped polling before they finished.
+	ownerIdleTTL = 24 * time.Hour
+	// ownerTerminalTTL keeps a finished run readable by its owner for a while
+	// after the proxy saw it end.
+	ownerTerminalTTL = time.Hour
+	// ownerSweepInterval bounds how often assign scans for expired runs.
+	ownerSweepInterval = time.Minute
+)
+
+// runOwners remembers which tenant started each run, and which bridge tenant
+// it was last delivered to, so tenants cannot read or complete each other's
+// runs. Entries are kept in memory: they expire ownerTerminalTTL after the
+// proxy sees the run end, or ownerIdleTTL after last use, and a restarted
+// proxy knows no runs until their owners invoke them again.
+type runOwners struct {
+	mu        sync.Mutex
+	runs      map[string]*runOwner
+	now       func() time.Time
+	nextSweep time.Time
+}
+
+type runOwner struct {
+	owner    string
+	worker   string
+	terminal bool
+	expires  time.Time
+}
+
+func newRunOwners() *runOwners {
+	return &runOwners{runs: map[string]*runOwner{}, now: time.Now}
+}
+
+// assign records tenant as the owner of runID. A run keeps its first owner:
+// a tenant reusing another tenant's requestId gets the controller's existing
+// run back and must not take it over.
+func (o *runOwners) assign(runID, tenant string) bool {
+	if runID == "" {
+		return true
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	o.sweep(now)
+	run := o.live(runID, now)
+	if run != nil && run.owner != tenant {
+		return false
+	}
+	if run == nil {
+		run = &runOwner{owner: tenant}
+		o.runs[runID] = run
+	}
+	o.touch(run, false, now)
+	return true
+}
+
+// claim records tenant as the bridge worker a request of runID was delivered
+// to. It reports false for runs not started through this proxy.
+func (o *runOwners) claim(runID, tenant string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	run := o.live(runID, now)
+	if run == nil {
+		return false
+	}
+	run.worker = tenant
+	o.touch(run, false, now)
+	return true
+}
+
+// check hides runs started by other tenants, or not through this proxy, as
+// not found.
+func (o *runOwners) check(runID, tenant string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	run := o.live(runID, now)
+	if run == nil || run.owner != tenant {
+		return runNotFound(runID)
+	}
+	o.touch(run, false, now)
+	return nil
+}
+
+// checkBridge is check for bridge reports, which the run's owner and the
+// bridge tenant it was delivered to may both send.
+func (o *runOwners) checkBridge(runID, tenant string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	run := o.live(runID, now)
+	if run == nil || (run.owner != tenant && run.worker != tenant) {
+		return runNotFound(runID)
+	}
+	o.touch(run, false, now)
+	return nil
+}
+
+// ended records that runID reached a terminal state.
+func (o *runOwners) ended(runID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.now()
+	if run := o.live(runID, now); run != nil {
+		o.touch(run, true, now)
+	}
+}
+
+func (o *runOwners) live(runID string, now time.Time) *runOwner {
+	run, ok := o.runs[runID]
+	if !ok || !now.Before(run.expires) {
+		return nil
+	}
+	return run
+}
+
+// touch extends run's expiry. A terminal run is never extended past
+// ownerTerminalTTL from when it ended.
+func (o *runOwners) touch(run *runOwner, terminal bool, now time.Time) {
+	switch {
+	case terminal && !run.terminal:
+		run.terminal = true
+		run.expires = now.Add(ownerTerminalTTL)
+	case !run.terminal:
+		run.expires = now.Add(ownerIdleTTL)
+	}
+}
+
+func (o *runOwners) sweep(now time.Time) {
+	if now.Before(o.nextSweep) {
+		return
+	}
+	o.nextSweep = now.Add(ownerSweepInterval)
+	for id, run := range o.runs {
+		if !now.Before(run.expires) {
+			delete(o.runs, id)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRunOwners_Expiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	o := newRunOwners()
+	o.now = func() time.Time { return now }
+
+	o.assign("run-live", "team-a")
+	o.assign("run-done", "team-a")
+	o.ended("run-done")
+
+	now = now.Add(ownerTerminalTTL)
+	if err := o.check("run-done", "team-a"); err == nil {
+		t.Fatalf("check() on a run past its terminal retention = nil, want run_not_found")
+	}
+	if err := o.check("run-live", "team-a"); err != nil {
+		t.Fatalf("check() on a live run error = %v", err)
+	}
+	// An expired run no longer blocks another tenant's requestId reuse.
+	if !o.assign("run-done", "team-b") {
+		t.Fatalf("assign() of an expired run = false")
+	}
+
+	now = now.Add(ownerIdleTTL)
+	o.assign("run-new", "team-a")
+	if _, ok := o.runs["run-live"]; ok {
+		t.Fatalf("idle run was not swept")
+	}
+	if len(o.runs) != 1 {
+		t.Fatalf("runs = %d after sweep, want only run-new", len(o.runs))
+	}
+}
+
+func TestRunOwners_TerminalNotExtended(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	o := newRunOwners()
+	o.now = func() time.Time { return now }
+
+	o.assign("run-1", "team-a")
+	o.ended("run-1")
+	now = now.Add(ownerTerminalTTL / 2)
+	if err := o.check("run-1", "team-a"); err != nil {
+		t.Fatalf("check() error = %v", err)
+	}
+	now = now.Add(ownerTerminalTTL / 2)
+	if err := o.check("run-1", "team-a"); err == nil {
+		t.Fatalf("check() kept a finished run alive past its retention")
+	}
+}
@@ -0,0 +1,477 @@
+// Package proxy serves the plugin's /step-rpc/v1/* routes in front of a
+// controller. It authenticates callers with its own credentials, applies
+// per-tenant operation allowlists, rate quotas, and argument rules, forwards
+// allowed calls with a single service credential, and records an audit trail
+// that includes the caller identity the controller never sees.
+package proxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/redact"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/policy"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Error codes the proxy adds to the plugin's set.
+const (
+	CodeUnauthenticated     = "unauthenticated"
+	CodeTenantUnknown       = "tenant_unknown"
+	CodeRateLimited         = "rate_limited"
+	CodeArgumentRejected    = "argument_rejected"
+	CodePolicyDenied        = "policy_denied"
+	CodeUpstreamUnavailable = "upstream_unavailable"
+)
+
+// maxBodyBytes bounds request bodies read by the proxy.
+const maxBodyBytes = 1 << 20
+
+// Server is an http.Handler for the proxied routes.
+type Server struct {
+	client  *rpcclient.Client
+	auth    Authenticator
+	tenants map[string]*tenantState
+	audit   AuditSink
+	policy  *policy.Policy
+	runs    *runOwners
+	mux     *http.ServeMux
+}
+
+type tenantState struct {
+	*Tenant
+	limiter *rate.Limiter
+}
+
+// New returns a proxy that forwards through client, which should carry the
+// service credential for the controller.
+func New(client *rpcclient.Client, auth Authenticator, tenants map[string]*Tenant) *Server {
+	s := &Server{
+		client:  client,
+		auth:    auth,
+		tenants: make(map[string]*tenantState, len(tenants)),
+		audit:   discardAudit{},
+		runs:    newRunOwners(),
+	}
+	for name, t := range tenants {
+		state := &tenantState{Tenant: t}
+		if rl := t.RateLimit; rl != nil {
+			state.limiter = rate.NewLimiter(rate.Limit(rl.RequestsPerSecond), max(rl.Burst, 1))
+		}
+		s.tenants[name] = state
+	}
+	s.mux = s.routes()
+	return s
+}
+
+// WithAudit returns a copy that records events to sink.
+func (s *Server) WithAudit(sink AuditSink) *Server {
+	cp := *s
+	cp.audit = sink
+	cp.mux = cp.routes()
+	return &cp
+}
+
+// WithPolicy returns a copy that checks invocations against p after the
+// tenant's allowlist and argument rules. The proxy does not look up execution
+// modes, so p sees them as unspecified.
+func (s *Server) WithPolicy(p *policy.Policy) *Server {
+	cp := *s
+	cp.policy = p
+	cp.mux = cp.routes()
+	return &cp
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("GET /step-rpc/v1/{$}", s.handle("health", s.health))
+	mux.Handle("GET /step-rpc/v1/catalog", s.handle("catalog", s.catalog))
+	mux.Handle("POST /step-rpc/v1/invoke", s.handle("invoke", s.invoke))
+	mux.Handle("GET /step-rpc/v1/runs/{runId}", s.handle("runs.get", s.runStatus))
+	mux.Handle("GET /step-rpc/v1/bridge/pending", s.handle("bridge.pending", s.bridgePending))
+	mux.Handle("GET /step-rpc/v1/bridge/runs", s.handle("bridge.runs", s.bridgeRuns))
+	mux.Handle("POST /step-rpc/v1/bridge/claim", s.handle("bridge.claim", s.bridgeClaim))
+	mux.Handle("POST /step-rpc/v1/bridge/heartbeat", s.handle("bridge.heartbeat", s.bridgeHeartbeat))
+	mux.Handle("POST /step-rpc/v1/bridge/progress", s.handle("bridge.progress", s.bridgeProgress))
+	mux.Handle("POST /step-rpc/v1/bridge/complete", s.handle("bridge.complete", s.bridgeComplete))
+	return mux
+}
+
+// apiError is a failure the proxy reports in the plugin's error format.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	details map[string]string
+}
+
+func (e *apiError) Error() string { return e.code + ": " + e.message }
+
+// handlerFunc serves one route. A nil message with a nil error is answered 204
+// No Content.
+type handlerFunc func(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error)
+
+// handle authenticates the caller, applies the tenant's quota, runs h, writes
+// the response, and records one audit event.
+func (s *Server) handle(action string, h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ev := &AuditEvent{Time: start, Action: action}
+		defer func() {
+			ev.Duration = time.Since(start)
+			s.audit.Record(*ev)
+		}()
+
+		caller, err := s.auth.Authenticate(r)
+		if err != nil {
+			s.writeError(w, ev, &apiError{status: http.StatusUnauthorized, code: CodeUnauthenticated, message: err.Error()})
+			return
+		}
+		ev.Caller, ev.Tenant = caller.Subject, caller.Tenant
+		tenant, ok := s.tenants[caller.Tenant]
+		if !ok {
+			s.writeError(w, ev, &apiError{status: http.StatusForbidden, code: CodeTenantUnknown, message: "caller's tenant is not configured"})
+			return
+		}
+		if delay, ok := tenant.reserve(); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			s.writeError(w, ev, &apiError{status: http.StatusTooManyRequests, code: CodeRateLimited, message: "tenant request quota exceeded"})
+			return
+		}
+
+		resp, err := h(r, tenant, ev)
+		if err != nil {
+			s.writeError(w, ev, err)
+			return
+		}
+		if resp == nil {
+			ev.Outcome, ev.Status = OutcomeAllowed, http.StatusNoContent
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		body, err := protojson.Marshal(resp)
+		if err != nil {
+			s.writeError(w, ev, err)
+			return
+		}
+		ev.Outcome, ev.Status = OutcomeAllowed, http.StatusOK
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
+
+// reserve takes a token when one is available now and otherwise reports how
+// long until one is.
+func (t *tenantState) reserve() (time.Duration, bool) {
+	if t.limiter == nil {
+		return 0, true
+	}
+	res := t.limiter.Reserve()
+	if delay := res.Delay(); delay > 0 {
+		res.Cancel()
+		return delay, false
+	}
+	return 0, true
+}
+
+// writeError writes err in the plugin's {"error": {...}} format. Errors raised
+// by the proxy are audited as denied; upstream errors keep the controller's
+// status and body and are audited as failed.
+func (s *Server) writeError(w http.ResponseWriter, ev *AuditEvent, err error) {
+	var (
+		apiErr  *apiError
+		httpErr *rpcclient.HTTPError
+		pe      *steprpcv1.Error
+		status  int
+	)
+	switch {
+	case errors.As(err, &apiErr):
+		ev.Outcome = OutcomeDenied
+		status = apiErr.status
+		pe = &steprpcv1.Error{Code: apiErr.code, Message: apiErr.message, Details: apiErr.details}
+	case errors.As(err, &httpErr):
+		ev.Outcome = OutcomeFailed
+		status = httpErr.StatusCode
+		pe = httpErr.ProtoError
+		if pe == nil {
+			pe = &steprpcv1.Error{Code: CodeUpstreamUnavailable, Message: http.StatusText(status)}
+		}
+	case errors.Is(err, context.DeadlineExceeded):
+		ev.Outcome = OutcomeFailed
+		status = http.StatusGatewayTimeout
+		pe = &steprpcv1.Error{Code: CodeUpstreamUnavailable, Message: err.Error()}
+	default:
+		ev.Outcome = OutcomeFailed
+		status = http.StatusBadGateway
+		pe = &steprpcv1.Error{Code: CodeUpstreamUnavailable, Message: err.Error()}
+	}
+	ev.Status, ev.Code, ev.Message = status, pe.GetCode(), pe.GetMessage()
+
+	body, _ := protojson.Marshal(&steprpcv1.ErrorResponse{Error: pe})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func (s *Server) health(r *http.Request, _ *tenantState, _ *AuditEvent) (proto.Message, error) {
+	return s.client.GetHealth(r.Context())
+}
+
+// catalog returns only the operations the tenant may invoke.
+func (s *Server) catalog(r *http.Request, tenant *tenantState, _ *AuditEvent) (proto.Message, error) {
+	resp, err := s.client.GetCatalog(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	out := &steprpcv1.CatalogResponse{}
+	for _, op := range resp.GetOperations() {
+		if tenant.allowsOperation(op.GetName()) {
+			out.Operations = append(out.Operations, op)
+		}
+	}
+	return out, nil
+}
+
+func (s *Server) invoke(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	req := &steprpcv1.InvokeRequest{}
+	if err := readProto(r, req); err != nil {
+		return nil, err
+	}
+	ev.Operation, ev.RequestID = req.GetOperation(), req.GetRequestId()
+	args := req.GetArgs().AsMap()
+	ev.Args = redact.Args(args)
+
+	if strings.TrimSpace(req.GetOperation()) == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "operation is required"}
+	}
+	if !tenant.allowsOperation(req.GetOperation()) {
+		return nil, &apiError{
+			status:  http.StatusForbidden,
+			code:    string(rpcclient.CodeOperationNotAllowed),
+			message: "operation is not allowed for tenant",
+			details: map[string]string{"operation": req.GetOperation()},
+		}
+	}
+	if name, err := tenant.checkArgs(req.GetOperation(), args); err != nil {
+		return nil, &apiError{
+			status:  http.StatusForbidden,
+			code:    CodeArgumentRejected,
+			message: err.Error(),
+			details: map[string]string{"operation": req.GetOperation(), "argument": name},
+		}
+	}
+
+	if s.policy != nil {
+		in := policy.Input{
+			Operation: req.GetOperation(),
+			Args:      args,
+			Caller:    policy.Caller{Subject: ev.Caller, Tenant: ev.Tenant},
+		}
+		var denied *policy.DenyError
+		if err := s.policy.Check(in); errors.As(err, &denied) {
+			return nil, &apiError{status: http.StatusForbidden, code: CodePolicyDenied, message: denied.Error(), details: denied.Details()}
+		}
+	}
+
+	resp, err := s.client.Invoke(r.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	ev.RunID = resp.GetRunId()
+	if !s.runs.assign(resp.GetRunId(), ev.Tenant) {
+		return nil, &apiError{
+			status:  http.StatusBadRequest,
+			code:    string(rpcclient.CodeBadRequest),
+			message: "requestId is already in use",
+			details: map[string]string{"requestId": req.GetRequestId()},
+		}
+	}
+	if rpcclient.RunStateOf(resp).IsTerminal() {
+		s.runs.ended(resp.GetRunId())
+	}
+	return resp, nil
+}
+
+func (s *Server) runStatus(r *http.Request, _ *tenantState, ev *AuditEvent) (proto.Message, error) {
+	runID := r.PathValue("runId")
+	ev.RunID = runID
+	if err := s.runs.check(runID, ev.Tenant); err != nil {
+		return nil, err
+	}
+	resp, err := s.client.GetRunStatus(r.Context(), runID)
+	if err != nil {
+		return nil, err
+	}
+	ev.Operation, ev.RequestID = resp.GetOperation(), resp.GetRequestId()
+	if rpcclient.RunStateOf(resp).IsTerminal() {
+		s.runs.ended(runID)
+	}
+	return resp, nil
+}
+
+func (s *Server) bridgePending(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	id := r.URL.Query().Get("runExternalizableId")
+	if id == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "runExternalizableId is required"}
+	}
+	client := s.client
+	if worker := r.URL.Query().Get("workerId"); worker != "" {
+		client = client.WithBridgeWorkerID(worker)
+	}
+	wait, _ := strconv.Atoi(r.URL.Query().Get("waitSeconds"))
+	resp, err := client.WaitBridgePending(r.Context(), id, time.Duration(max(wait, 0))*time.Second)
+	if wait > 0 && errors.Is(err, rpcclient.ErrNoPendingRequest) {
+		// Long polls keep the controller's answer: 204 when nothing became
+		// pending.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ev.RunID, ev.RequestID = resp.GetRunId(), resp.GetRequestId()
+	// As with bridgeClaim, a request for a run this proxy did not start is
+	// hidden, and the tenant it was delivered to becomes the run's worker.
+	if !s.runs.claim(ev.RunID, ev.Tenant) {
+		return nil, runNotFound(ev.RunID)
+	}
+	return resp, nil
+}
+
+func (s *Server) bridgeRuns(r *http.Request, tenant *tenantState, _ *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	return s.client.ListBridgeRuns(r.Context(), r.URL.Query().Get("folder"))
+}
+
+func (s *Server) bridgeClaim(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	req := &steprpcv1.BridgeClaimRequest{}
+	if err := readProto(r, req); err != nil {
+		return nil, err
+	}
+	if req.GetRunExternalizableId() == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "runExternalizableId is required"}
+	}
+	client := s.client
+	if req.GetWorkerId() != "" {
+		client = client.WithBridgeWorkerID(req.GetWorkerId())
+	}
+	resp, err := client.ClaimBridgeRequest(r.Context(), req.GetRunExternalizableId(), time.Duration(req.GetLeaseSeconds())*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	ev.RunID, ev.RequestID = resp.GetRequest().GetRunId(), resp.GetRequest().GetRequestId()
+	// The controller has already leased the request. A run not started through
+	// this proxy stays hidden and its lease is left to expire.
+	if !s.runs.claim(ev.RunID, ev.Tenant) {
+		return nil, runNotFound(ev.RunID)
+	}
+	return resp, nil
+}
+
+func (s *Server) bridgeHeartbeat(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	req := &steprpcv1.BridgeHeartbeatRequest{}
+	if err := readProto(r, req); err != nil {
+		return nil, err
+	}
+	ev.RunID = req.GetRunId()
+	if req.GetRunId() == "" || req.GetLeaseId() == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "runId and leaseId are required"}
+	}
+	if err := s.runs.checkBridge(req.GetRunId(), ev.Tenant); err != nil {
+		return nil, err
+	}
+	return s.client.HeartbeatBridgeLease(r.Context(), req.GetRunId(), req.GetLeaseId(), time.Duration(req.GetLeaseSeconds())*time.Second)
+}
+
+func (s *Server) bridgeProgress(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	req := &steprpcv1.BridgeProgressRequest{}
+	if err := readProto(r, req); err != nil {
+		return nil, err
+	}
+	ev.RunID = req.GetRunId()
+	if req.GetRunId() == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "runId is required"}
+	}
+	if err := s.runs.checkBridge(req.GetRunId(), ev.Tenant); err != nil {
+		return nil, err
+	}
+	return s.client.ReportBridgeProgress(r.Context(), req)
+}
+
+func (s *Server) bridgeComplete(r *http.Request, tenant *tenantState, ev *AuditEvent) (proto.Message, error) {
+	if !tenant.Bridge {
+		return nil, errBridgeNotAllowed
+	}
+	req := &steprpcv1.BridgeCompleteRequest{}
+	if err := readProto(r, req); err != nil {
+		return nil, err
+	}
+	ev.RunID = req.GetRunId()
+	if req.GetRunId() == "" || req.GetState() == "" {
+		return nil, &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadRequest), message: "runId and state are required"}
+	}
+	if err := s.runs.checkBridge(req.GetRunId(), ev.Tenant); err != nil {
+		return nil, err
+	}
+	resp, err := s.client.CompleteBridgeRequest(r.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	ev.RequestID = resp.GetRequestId()
+	s.runs.ended(req.GetRunId())
+	return resp, nil
+}
+
+var errBridgeNotAllowed = &apiError{
+	status:  http.StatusForbidden,
+	code:    string(rpcclient.CodeOperationNotAllowed),
+	message: "tenant may not use the CPS bridge",
+}
+
+func readProto(r *http.Request, out proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodyBytes))
+	if err != nil {
+		return &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadJSON), message: err.Error()}
+	}
+	if err := protojson.Unmarshal(body, out); err != nil {
+		return &apiError{status: http.StatusBadRequest, code: string(rpcclient.CodeBadJSON), message: err.Error()}
+	}
+	return nil
+}
+
+func runNotFound(runID string) error {
+	return &apiError{
+		status:  http.StatusNotFound,
+		code:    string(rpcclient.CodeRunNotFound),
+		message: "run not found",
+		details: map[string]string{"runId": runID},
+	}
+}
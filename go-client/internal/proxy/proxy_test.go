@@ -0,0 +1,513 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/redact"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/policy"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const serviceToken = "svc-token"
+
+type memAudit struct {
+	mu     sync.Mutex
+	events []AuditEvent
+}
+
+func (m *memAudit) Record(e AuditEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, e)
+}
+
+func (m *memAudit) last(t *testing.T) AuditEvent {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.events) == 0 {
+		t.Fatalf("no audit events recorded")
+	}
+	return m.events[len(m.events)-1]
+}
+
+// controller fakes the plugin. It rejects requests without the service token,
+// rejects the "boom" operation, and counts invokes.
+func controller(t *testing.T, invokes *int32) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+serviceToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/step-rpc/v1/catalog":
+			_, _ = io.WriteString(w, `{"operations":[{"name":"echo"},{"name":"archiveArtifacts"},{"name":"sh"}]}`)
+		case r.URL.Path == "/step-rpc/v1/invoke":
+			atomic.AddInt32(invokes, 1)
+			var req struct {
+				RequestID string `json:"requestId"`
+				Operation string `json:"operation"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Operation == "boom" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, `{"error":{"code":"bad_request","message":"boom is broken"}}`)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"requestId":%q,"runId":"run-%s","state":"queued"}`, req.RequestID, req.RequestID)
+		case r.URL.Path == "/step-rpc/v1/bridge/pending":
+			// Long polls find nothing; plain fetches are answered like claims.
+			if wait := r.URL.Query().Get("waitSeconds"); wait != "" && wait != "0" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			runID := "run-elsewhere"
+			if r.URL.Query().Get("runExternalizableId") == "job#1" {
+				runID = "run-r-bridge"
+			}
+			_, _ = fmt.Fprintf(w, `{"requestId":"r-bridge","runId":%q,"operation":"echo"}`, runID)
+		case r.URL.Path == "/step-rpc/v1/bridge/claim":
+			var req struct {
+				RunExternalizableID string `json:"runExternalizableId"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			// job#1 hands out the run started by requestId r-bridge; anything
+			// else a run this proxy never saw.
+			runID := "run-elsewhere"
+			if req.RunExternalizableID == "job#1" {
+				runID = "run-r-bridge"
+			}
+			_, _ = fmt.Fprintf(w, `{"request":{"requestId":"r-bridge","runId":%q,"operation":"echo"},"leaseId":"lease-1"}`, runID)
+		case r.URL.Path == "/step-rpc/v1/bridge/heartbeat":
+			_, _ = io.WriteString(w, `{"runId":"run-r-bridge","leaseId":"lease-1"}`)
+		case r.URL.Path == "/step-rpc/v1/bridge/complete":
+			_, _ = io.WriteString(w, `{"requestId":"r-bridge","runId":"run-r-bridge","state":"succeeded"}`)
+		case r.URL.Path == "/step-rpc/v1/bridge/progress":
+			var req struct {
+				RunID   string `json:"runId"`
+				Percent int    `json:"percent"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_, _ = fmt.Fprintf(w, `{"runId":%q,"progress":{"percent":%d}}`, req.RunID, req.Percent)
+		case strings.HasPrefix(r.URL.Path, "/step-rpc/v1/runs/"):
+			runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+			_, _ = fmt.Fprintf(w, `{"runId":%q,"operation":"echo","state":"succeeded"}`, runID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+var testTenants = map[string]*Tenant{
+	"team-a": {
+		Operations: []string{"echo", "archiveArtifacts", "boom"},
+		Args:       map[string]ArgRule{"archiveArtifacts": {Required: []string{"artifacts"}, Forbidden: []string{"excludes"}}},
+	},
+	"team-b": {
+		Operations: []string{AllOperations},
+		RateLimit:  &RateLimit{RequestsPerSecond: 0.001, Burst: 2},
+	},
+	"workers":       {Bridge: true},
+	"other-workers": {Bridge: true},
+}
+
+type fixture struct {
+	url     string
+	a, b    *rpcclient.Client
+	audit   *memAudit
+	invokes *int32
+}
+
+// startProxy serves a proxy in front of a fake controller with clients for
+// team-a and team-b callers. opts adjust the proxy before it starts.
+func startProxy(t *testing.T, opts ...func(*Server) *Server) *fixture {
+	t.Helper()
+	f := &fixture{audit: &memAudit{}, invokes: new(int32)}
+	upstream, err := rpcclient.New(controller(t, f.invokes).URL, serviceToken, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	auth := APIKeys{
+		{SHA256: HashAPIKey("key-a"), Subject: "alice", Tenant: "team-a"},
+		{SHA256: HashAPIKey("key-b"), Subject: "bob", Tenant: "team-b"},
+		{SHA256: HashAPIKey("key-w"), Subject: "worker", Tenant: "workers"},
+		{SHA256: HashAPIKey("key-w2"), Subject: "other-worker", Tenant: "other-workers"},
+	}
+	srv := New(upstream, auth, testTenants).WithAudit(f.audit)
+	for _, opt := range opts {
+		srv = opt(srv)
+	}
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	f.url = ts.URL
+	f.a = f.client(t, "key-a")
+	f.b = f.client(t, "key-b")
+	return f
+}
+
+func (f *fixture) client(t *testing.T, key string) *rpcclient.Client {
+	t.Helper()
+	c, err := rpcclient.New(f.url, key, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func invokeReq(t *testing.T, id, op string, args map[string]any) *steprpcv1.InvokeRequest {
+	t.Helper()
+	s, err := structpb.NewStruct(args)
+	if err != nil {
+		t.Fatalf("NewStruct() error = %v", err)
+	}
+	return &steprpcv1.InvokeRequest{RequestId: id, Operation: op, Args: s}
+}
+
+func TestProxy_InvokeForwardsAndAudits(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	a, audit, invokes := f.a, f.audit, f.invokes
+	resp, err := a.Invoke(context.Background(), invokeReq(t, "r1", "echo", map[string]any{"message": "hi", "apiToken": "s3cret"}))
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if resp.GetRunId() != "run-r1" || atomic.LoadInt32(invokes) != 1 {
+		t.Fatalf("Invoke() = %v, invokes = %d", resp, atomic.LoadInt32(invokes))
+	}
+
+	ev := audit.last(t)
+	if ev.Action != "invoke" || ev.Caller != "alice" || ev.Tenant != "team-a" || ev.Operation != "echo" ||
+		ev.RunID != "run-r1" || ev.Outcome != OutcomeAllowed || ev.Status != http.StatusOK {
+		t.Fatalf("audit event = %+v", ev)
+	}
+	if ev.Args["apiToken"] != redact.Mask || ev.Args["message"] != "hi" {
+		t.Fatalf("audit args = %v, want token redacted", ev.Args)
+	}
+
+	st, err := a.GetRunStatus(context.Background(), resp.GetRunId())
+	if err != nil || st.GetState() != "succeeded" {
+		t.Fatalf("GetRunStatus() = %v, %v", st, err)
+	}
+}
+
+func TestProxy_BridgeLongPoll(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	w := f.client(t, "key-w")
+	if _, err := w.WaitBridgePending(context.Background(), "job#1", time.Second); !errors.Is(err, rpcclient.ErrNoPendingRequest) {
+		t.Fatalf("WaitBridgePending() error = %v, want ErrNoPendingRequest", err)
+	}
+	if ev := f.audit.last(t); ev.Action != "bridge.pending" || ev.Outcome != OutcomeAllowed || ev.Status != http.StatusNoContent {
+		t.Fatalf("audit event = %+v, want the controller's 204 passed through", ev)
+	}
+}
+
+func TestProxy_BridgeOwnership(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	w := f.client(t, "key-w")
+	ctx := context.Background()
+	progress := &steprpcv1.BridgeProgressRequest{RunId: "run-r-bridge", Percent: 30}
+
+	if _, err := f.a.Invoke(ctx, invokeReq(t, "r-bridge", "echo", nil)); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if _, err := w.ReportBridgeProgress(ctx, progress); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("ReportBridgeProgress(unclaimed) error = %v, want ErrRunNotFound", err)
+	}
+	if _, err := w.ClaimBridgeRequest(ctx, "job#2", 0); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("ClaimBridgeRequest(foreign run) error = %v, want ErrRunNotFound", err)
+	}
+
+	claim, err := w.ClaimBridgeRequest(ctx, "job#1", 0)
+	if err != nil || claim.GetRequest().GetRunId() != "run-r-bridge" {
+		t.Fatalf("ClaimBridgeRequest() = %v, %v", claim, err)
+	}
+	resp, err := w.ReportBridgeProgress(ctx, progress)
+	if err != nil || resp.GetProgress().GetPercent() != 30 {
+		t.Fatalf("ReportBridgeProgress() = %v, %v", resp, err)
+	}
+	if ev := f.audit.last(t); ev.Action != "bridge.progress" || ev.RunID != "run-r-bridge" {
+		t.Fatalf("audit event = %+v", ev)
+	}
+	if _, err := w.HeartbeatBridgeLease(ctx, "run-r-bridge", "lease-1", 0); err != nil {
+		t.Fatalf("HeartbeatBridgeLease() error = %v", err)
+	}
+	if _, err := w.CompleteBridgeRequest(ctx, &steprpcv1.BridgeCompleteRequest{RunId: "run-r-bridge", State: "succeeded"}); err != nil {
+		t.Fatalf("CompleteBridgeRequest() error = %v", err)
+	}
+
+	if _, err := f.a.ReportBridgeProgress(ctx, progress); !errors.Is(err, rpcclient.ErrOperationNotAllowed) {
+		t.Fatalf("ReportBridgeProgress(no bridge) error = %v, want ErrOperationNotAllowed", err)
+	}
+}
+
+func TestProxy_BridgePendingOwnership(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	w, w2 := f.client(t, "key-w"), f.client(t, "key-w2")
+	ctx := context.Background()
+	complete := &steprpcv1.BridgeCompleteRequest{RunId: "run-r-bridge", State: "succeeded"}
+
+	if _, err := f.a.Invoke(ctx, invokeReq(t, "r-bridge", "echo", nil)); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if _, err := w.GetBridgePending(ctx, "job#2"); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("GetBridgePending(foreign run) error = %v, want ErrRunNotFound", err)
+	}
+	if _, err := w.CompleteBridgeRequest(ctx, complete); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("CompleteBridgeRequest(undelivered) error = %v, want ErrRunNotFound", err)
+	}
+
+	pending, err := w.GetBridgePending(ctx, "job#1")
+	if err != nil || pending.GetRunId() != "run-r-bridge" {
+		t.Fatalf("GetBridgePending() = %v, %v", pending, err)
+	}
+	if ev := f.audit.last(t); ev.Action != "bridge.pending" || ev.RunID != "run-r-bridge" {
+		t.Fatalf("audit event = %+v", ev)
+	}
+	if _, err := w.CompleteBridgeRequest(ctx, complete); err != nil {
+		t.Fatalf("CompleteBridgeRequest() error = %v", err)
+	}
+	if _, err := w2.CompleteBridgeRequest(ctx, complete); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("CompleteBridgeRequest(other bridge tenant) error = %v, want ErrRunNotFound", err)
+	}
+}
+
+func TestProxy_RequestIDReuseKeepsOwner(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	ctx := context.Background()
+	resp, err := f.a.Invoke(ctx, invokeReq(t, "r-shared", "echo", nil))
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+
+	// The controller replays team-a's run for the reused requestId.
+	if _, err := f.b.Invoke(ctx, invokeReq(t, "r-shared", "echo", nil)); !errors.Is(err, rpcclient.ErrBadRequest) {
+		t.Fatalf("Invoke(reused requestId) error = %v, want ErrBadRequest", err)
+	}
+	if _, err := f.b.GetRunStatus(ctx, resp.GetRunId()); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("GetRunStatus(team-b) error = %v, want ErrRunNotFound", err)
+	}
+	if _, err := f.a.GetRunStatus(ctx, resp.GetRunId()); err != nil {
+		t.Fatalf("GetRunStatus(team-a) error = %v", err)
+	}
+}
+
+func TestProxy_Denials(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	a, b, audit, invokes := f.a, f.b, f.audit, f.invokes
+	ctx := context.Background()
+
+	if _, err := a.Invoke(ctx, invokeReq(t, "r1", "sh", nil)); !errors.Is(err, rpcclient.ErrOperationNotAllowed) {
+		t.Fatalf("Invoke(sh) error = %v, want ErrOperationNotAllowed", err)
+	}
+	if ev := audit.last(t); ev.Outcome != OutcomeDenied || ev.Code != string(rpcclient.CodeOperationNotAllowed) || ev.Operation != "sh" {
+		t.Fatalf("audit event = %+v", ev)
+	}
+
+	_, err := a.Invoke(ctx, invokeReq(t, "r2", "archiveArtifacts", map[string]any{"artifacts": "*.jar", "excludes": "x"}))
+	var httpErr *rpcclient.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Code() != CodeArgumentRejected || httpErr.Details()["argument"] != "excludes" {
+		t.Fatalf("Invoke(excludes) error = %v, want argument_rejected for excludes", err)
+	}
+	if _, err := a.Invoke(ctx, invokeReq(t, "r3", "archiveArtifacts", nil)); !errors.As(err, &httpErr) || httpErr.Details()["argument"] != "artifacts" {
+		t.Fatalf("Invoke(no artifacts) error = %v, want artifacts required", err)
+	}
+	if n := atomic.LoadInt32(invokes); n != 0 {
+		t.Fatalf("controller invokes = %d, want denied calls not forwarded", n)
+	}
+
+	// team-b cannot see team-a's runs, nor runs the proxy never started.
+	resp, err := a.Invoke(ctx, invokeReq(t, "r4", "echo", nil))
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if _, err := b.GetRunStatus(ctx, resp.GetRunId()); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("GetRunStatus(other tenant) error = %v, want ErrRunNotFound", err)
+	}
+	if _, err := a.GetRunStatus(ctx, "run-elsewhere"); !errors.Is(err, rpcclient.ErrRunNotFound) {
+		t.Fatalf("GetRunStatus(unknown) error = %v, want ErrRunNotFound", err)
+	}
+
+	if _, err := a.GetBridgePending(ctx, "job#1"); !errors.Is(err, rpcclient.ErrOperationNotAllowed) {
+		t.Fatalf("GetBridgePending() error = %v, want ErrOperationNotAllowed", err)
+	}
+	if _, err := a.ClaimBridgeRequest(ctx, "job#1", 0); !errors.Is(err, rpcclient.ErrOperationNotAllowed) {
+		t.Fatalf("ClaimBridgeRequest() error = %v, want ErrOperationNotAllowed", err)
+	}
+	if _, err := a.ListBridgeRuns(ctx, ""); !errors.Is(err, rpcclient.ErrOperationNotAllowed) {
+		t.Fatalf("ListBridgeRuns() error = %v, want ErrOperationNotAllowed", err)
+	}
+
+	if _, err := f.client(t, "wrong-key").GetCatalog(ctx); rpcclient.CategoryOf(err) != rpcclient.CategoryAuth {
+		t.Fatalf("GetCatalog(wrong key) error = %v, want auth error", err)
+	}
+	if ev := audit.last(t); ev.Code != CodeUnauthenticated || ev.Status != http.StatusUnauthorized {
+		t.Fatalf("audit event = %+v", ev)
+	}
+}
+
+func TestProxy_PolicyDenied(t *testing.T) {
+	t.Parallel()
+
+	rules, err := policy.Parse([]byte(`
+default: allow
+rules:
+  - name: no-parent-paths
+    effect: deny
+    reason: artifacts must not contain '..'
+    tenants: [team-a]
+    when: [{path: artifacts, contains: ".."}]
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	f := startProxy(t, func(s *Server) *Server { return s.WithPolicy(rules) })
+	ctx := context.Background()
+
+	_, err = f.a.Invoke(ctx, invokeReq(t, "r1", "archiveArtifacts", map[string]any{"artifacts": "../etc/*"}))
+	var httpErr *rpcclient.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusForbidden || httpErr.Code() != CodePolicyDenied ||
+		httpErr.Details()["rule"] != "no-parent-paths" || httpErr.Details()["reason"] != "artifacts must not contain '..'" {
+		t.Fatalf("Invoke() error = %v, want policy_denied by no-parent-paths", err)
+	}
+	if ev := f.audit.last(t); ev.Outcome != OutcomeDenied || ev.Code != CodePolicyDenied {
+		t.Fatalf("audit event = %+v", ev)
+	}
+	if n := atomic.LoadInt32(f.invokes); n != 0 {
+		t.Fatalf("controller invokes = %d, want denied call not forwarded", n)
+	}
+
+	if _, err := f.a.Invoke(ctx, invokeReq(t, "r2", "archiveArtifacts", map[string]any{"artifacts": "out/*.jar"})); err != nil {
+		t.Fatalf("Invoke(allowed) error = %v", err)
+	}
+}
+
+func TestProxy_UpstreamErrorPassesThrough(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	a, audit := f.a, f.audit
+	_, err := a.Invoke(context.Background(), invokeReq(t, "r1", "boom", nil))
+	if !errors.Is(err, rpcclient.ErrBadRequest) {
+		t.Fatalf("Invoke(boom) error = %v, want controller's bad_request", err)
+	}
+	if ev := audit.last(t); ev.Outcome != OutcomeFailed || ev.Status != http.StatusBadRequest || ev.Message != "boom is broken" {
+		t.Fatalf("audit event = %+v", ev)
+	}
+}
+
+func TestProxy_CatalogFilteredByTenant(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	a, b := f.a, f.b
+	names := func(c *rpcclient.Client) string {
+		resp, err := c.GetCatalog(context.Background())
+		if err != nil {
+			t.Fatalf("GetCatalog() error = %v", err)
+		}
+		var out []string
+		for _, op := range resp.GetOperations() {
+			out = append(out, op.GetName())
+		}
+		return strings.Join(out, ",")
+	}
+	if got := names(a); got != "echo,archiveArtifacts" {
+		t.Fatalf("team-a catalog = %s", got)
+	}
+	if got := names(b); got != "echo,archiveArtifacts,sh" {
+		t.Fatalf("team-b catalog = %s", got)
+	}
+}
+
+func TestProxy_RateQuota(t *testing.T) {
+	t.Parallel()
+
+	f := startProxy(t)
+	b, audit := f.b, f.audit
+	ctx := context.Background()
+	for range 2 {
+		if _, err := b.GetCatalog(ctx); err != nil {
+			t.Fatalf("GetCatalog() error = %v", err)
+		}
+	}
+	_, err := b.GetCatalog(ctx)
+	var httpErr *rpcclient.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Category() != rpcclient.CategoryRateLimited || httpErr.Code() != CodeRateLimited {
+		t.Fatalf("GetCatalog() error = %v, want rate_limited", err)
+	}
+	if ev := audit.last(t); ev.Outcome != OutcomeDenied || ev.Tenant != "team-b" {
+		t.Fatalf("audit event = %+v", ev)
+	}
+}
+
+func TestProxy_RetryAfterHeader(t *testing.T) {
+	t.Parallel()
+
+	upstream, err := rpcclient.New(controller(t, new(int32)).URL, serviceToken, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	tenants := map[string]*Tenant{"t": {Operations: []string{AllOperations}, RateLimit: &RateLimit{RequestsPerSecond: 0.5, Burst: 1}}}
+	h := New(upstream, APIKeys{{SHA256: HashAPIKey("k"), Subject: "s", Tenant: "t"}}, tenants)
+
+	var codes []int
+	var retryAfter string
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, "/step-rpc/v1/catalog", nil)
+		req.Header.Set("X-API-Key", "k")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		codes = append(codes, rec.Code)
+		retryAfter = rec.Header().Get("Retry-After")
+	}
+	if codes[0] != http.StatusOK || codes[1] != http.StatusTooManyRequests || retryAfter != "2" {
+		t.Fatalf("codes = %v, Retry-After = %q; want 200, 429 with Retry-After 2", codes, retryAfter)
+	}
+}
+
+func TestJSONAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var buf strings.Builder
+	log := NewJSONAuditLog(&buf)
+	log.Record(AuditEvent{Time: time.Unix(0, 0).UTC(), Action: "invoke", Caller: "alice", Outcome: OutcomeAllowed, Status: http.StatusOK})
+	log.Record(AuditEvent{Action: "runs.get", Outcome: OutcomeDenied})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("audit log = %q, want 2 lines", buf.String())
+	}
+	var ev AuditEvent
+	if err := json.Unmarshal([]byte(lines[0]), &ev); err != nil || ev.Caller != "alice" || ev.Outcome != OutcomeAllowed {
+		t.Fatalf("first event = %+v, %v", ev, err)
+	}
+}
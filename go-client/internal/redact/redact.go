@@ -0,0 +1,63 @@
+// Package redact masks sensitive invoke arguments before they are persisted or
+// logged. The rules mirror the plugin's AuditLogger so client-side artifacts
+// never reveal more than the controller's audit log.
+package redact
+
+import "strings"
+
+// Mask replaces the value of every sensitive key.
+const Mask = "***"
+
+var sensitivePatterns = []string{
+	"password",
+	"secret",
+	"token",
+	"key",
+	"credential",
+	"api_key",
+	"apikey",
+	"access_token",
+	"private_key",
+}
+
+// IsSensitiveKey reports whether key names a value that must be masked.
+func IsSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, p := range sensitivePatterns {
+		if strings.Contains(lower, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Args returns a copy of args with sensitive values masked, recursing into
+// nested objects and lists. The input is not modified.
+func Args(args map[string]any) map[string]any {
+	if args == nil {
+		return nil
+	}
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		out[k] = value(k, v)
+	}
+	return out
+}
+
+func value(key string, v any) any {
+	if IsSensitiveKey(key) {
+		return Mask
+	}
+	switch tv := v.(type) {
+	case map[string]any:
+		return Args(tv)
+	case []any:
+		out := make([]any, len(tv))
+		for i, item := range tv {
+			out[i] = value(key, item)
+		}
+		return out
+	default:
+		return v
+	}
+}
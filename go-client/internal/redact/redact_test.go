@@ -0,0 +1,54 @@
+package redact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArgs(t *testing.T) {
+	t.Parallel()
+
+	in := map[string]any{
+		"artifacts": "build/*.jar",
+		"apiToken":  "t0ps3cret",
+		"nested": map[string]any{
+			"password": "hunter2",
+			"path":     "/tmp",
+		},
+		"items": []any{map[string]any{"privateKey": "pem"}, "plain"},
+	}
+	want := map[string]any{
+		"artifacts": "build/*.jar",
+		"apiToken":  Mask,
+		"nested": map[string]any{
+			"password": Mask,
+			"path":     "/tmp",
+		},
+		"items": []any{map[string]any{"privateKey": Mask}, "plain"},
+	}
+
+	got := Args(in)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Args() = %v, want %v", got, want)
+	}
+	if in["apiToken"] != "t0ps3cret" {
+		t.Fatalf("Args() mutated input")
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	t.Parallel()
+
+	for key, want := range map[string]bool{
+		"PASSWORD":       true,
+		"credentialsId":  true,
+		"access_token":   true,
+		"artifacts":      false,
+		"testResults":    false,
+		"allowEmptyList": false,
+	} {
+		if got := IsSensitiveKey(key); got != want {
+			t.Fatalf("IsSensitiveKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
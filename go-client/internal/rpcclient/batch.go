@@ -0,0 +1,173 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MaxBatchSize is the most requests InvokeBatch sends in one round trip.
+// Longer lists are split into several batches.
+const MaxBatchSize = 100
+
+// batchFallbackConcurrency bounds the concurrent Invoke calls InvokeBatch
+// makes against servers without the batch endpoint.
+const batchFallbackConcurrency = 8
+
+// BatchResult is the outcome of one InvokeBatch request: Response when the
+// server accepted it, Err otherwise. Both are set when the run was accepted
+// but could not be journaled.
+type BatchResult struct {
+	Response *steprpcv1.InvokeResponse
+	Err      error
+}
+
+// preparedInvoke is a request that passed prepareInvoke.
+type preparedInvoke struct {
+	index   int
+	req     *steprpcv1.InvokeRequest
+	payload []byte
+}
+
+// InvokeBatch invokes reqs in as few round trips as possible and returns one
+// result per request, in order. Each request is validated, policy-checked, and
+// journaled as Invoke would; the server dedups each by requestId, so resending
+// a batch is safe.
+//
+// Batches longer than MaxBatchSize, or than a limit reported by the server,
+// are split. Servers without the batch endpoint, because they advertise
+// capabilities without batch_invoke or answer 404, get concurrent Invoke calls
+// instead. The error joins the failed requests' errors.
+func (c *Client) InvokeBatch(ctx context.Context, reqs []*steprpcv1.InvokeRequest) ([]BatchResult, error) {
+	results := make([]BatchResult, len(reqs))
+	var ready []preparedInvoke
+	for i, req := range reqs {
+		prepared, payload, err := c.prepareInvoke(ctx, req, steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		ready = append(ready, preparedInvoke{index: i, req: prepared, payload: payload})
+	}
+
+	useBatch := c.capabilities == nil || !c.capabilities.Advertised || c.capabilities.Has(CapabilityBatchInvoke)
+	size := MaxBatchSize
+	for len(ready) > 0 {
+		n := min(size, len(ready))
+		chunk := ready[:n]
+		if !useBatch {
+			c.invokeEach(ctx, chunk, results)
+			ready = ready[n:]
+			continue
+		}
+
+		err := c.sendBatch(ctx, chunk, results)
+		switch {
+		case err == nil:
+			ready = ready[n:]
+		case endpointMissing(err):
+			useBatch = false
+		case errors.Is(err, ErrBatchTooLarge) && n > 1:
+			size = smallerBatch(err, n)
+		default:
+			for _, p := range chunk {
+				results[p.index].Err = err
+			}
+			ready = ready[n:]
+		}
+	}
+
+	var errs []error
+	for i, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("batch invoke request %d: %w", i, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (c *Client) sendBatch(ctx context.Context, chunk []preparedInvoke, results []BatchResult) error {
+	batch := &steprpcv1.BatchInvokeRequest{Requests: make([]*steprpcv1.InvokeRequest, len(chunk))}
+	for i, p := range chunk {
+		batch.Requests[i] = p.req
+	}
+	payload, err := protojson.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("marshal batch invoke request: %w", err)
+	}
+	body, err := c.postJSON(ctx, "/step-rpc/v1/batchInvoke", payload)
+	if err != nil {
+		return fmt.Errorf("send batch invoke request: %w", err)
+	}
+	out := &steprpcv1.BatchInvokeResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode batch invoke response: %w", err)
+	}
+	if len(out.GetResults()) != len(chunk) {
+		return fmt.Errorf("decode batch invoke response: got %d results for %d requests", len(out.GetResults()), len(chunk))
+	}
+
+	for i, res := range out.GetResults() {
+		p := chunk[i]
+		if res.GetError() != nil {
+			status := int(res.GetStatus())
+			if status == 0 {
+				status = http.StatusBadRequest
+			}
+			results[p.index].Err = c.journalRejected(p.req, &HTTPError{StatusCode: status, ProtoError: res.GetError()})
+			continue
+		}
+		results[p.index].Response = res.GetResponse()
+		results[p.index].Err = c.journalAccepted(p.req, res.GetResponse())
+	}
+	return nil
+}
+
+// invokeEach sends chunk as single invokes, batchFallbackConcurrency at a time.
+func (c *Client) invokeEach(ctx context.Context, chunk []preparedInvoke, results []BatchResult) {
+	sem := make(chan struct{}, batchFallbackConcurrency)
+	var wg sync.WaitGroup
+	for _, p := range chunk {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			out, err := c.sendInvoke(ctx, p.payload)
+			if err != nil {
+				results[p.index].Err = c.journalRejected(p.req, err)
+				return
+			}
+			results[p.index].Response = out
+			results[p.index].Err = c.journalAccepted(p.req, out)
+		}()
+	}
+	wg.Wait()
+}
+
+// endpointMissing reports whether err is an unstructured 404 or 405, which is
+// how servers that predate the batch endpoint answer it.
+func endpointMissing(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.ProtoError != nil {
+		return false
+	}
+	return httpErr.StatusCode == http.StatusNotFound || httpErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// smallerBatch returns the batch size to retry with after batch_too_large:
+// the server's maxBatchSize when it names a smaller one, else half of n.
+func smallerBatch(err error, n int) int {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		if limit, ok := httpErr.Details().Int("maxBatchSize"); ok && limit > 0 && int(limit) < n {
+			return int(limit)
+		}
+	}
+	return max(n/2, 1) //nolint:mnd // halve and retry
+}
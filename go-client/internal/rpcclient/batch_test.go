@@ -0,0 +1,285 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// batchServer mimics the plugin's invoke endpoints with requestId dedup. The
+// "forbidden" operation is rejected. With batch false it answers batchInvoke
+// like a server that predates it.
+type batchServer struct {
+	batch    bool
+	maxBatch int
+
+	mu          sync.Mutex
+	runs        map[string]string // requestId -> runId
+	batchSizes  []int
+	singles     int
+	inFlight    int
+	maxInFlight int
+}
+
+func (s *batchServer) invokeOne(in *steprpcv1.InvokeRequest) *steprpcv1.BatchInvokeResult {
+	if in.GetOperation() == "forbidden" {
+		return &steprpcv1.BatchInvokeResult{
+			Status: http.StatusBadRequest,
+			Error:  &steprpcv1.Error{Code: string(CodeOperationNotAllowed), Message: "not in allowlist"},
+		}
+	}
+	runID, ok := s.runs[in.GetRequestId()]
+	if !ok {
+		runID = "rpc-" + in.GetRequestId()
+		s.runs[in.GetRequestId()] = runID
+	}
+	return &steprpcv1.BatchInvokeResult{
+		Response: &steprpcv1.InvokeResponse{RequestId: in.GetRequestId(), RunId: runID, State: "queued"},
+	}
+}
+
+func newBatchServer(t *testing.T, s *batchServer) *httptest.Server {
+	t.Helper()
+	s.runs = map[string]string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/step-rpc/v1/invoke", func(w http.ResponseWriter, r *http.Request) {
+		var in steprpcv1.InvokeRequest
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), &in); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+			return
+		}
+		s.mu.Lock()
+		s.singles++
+		s.inFlight++
+		s.maxInFlight = max(s.maxInFlight, s.inFlight)
+		res := s.invokeOne(&in)
+		s.mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if res.GetError() != nil {
+			w.WriteHeader(int(res.GetStatus()))
+			out, _ := protojson.Marshal(&steprpcv1.ErrorResponse{Error: res.GetError()})
+			_, _ = w.Write(out)
+			return
+		}
+		out, _ := protojson.Marshal(res.GetResponse())
+		_, _ = w.Write(out)
+	})
+	mux.HandleFunc("/step-rpc/v1/batchInvoke", func(w http.ResponseWriter, r *http.Request) {
+		if !s.batch {
+			http.NotFound(w, r)
+			return
+		}
+		var in steprpcv1.BatchInvokeRequest
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), &in); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.batchSizes = append(s.batchSizes, len(in.GetRequests()))
+		if s.maxBatch > 0 && len(in.GetRequests()) > s.maxBatch {
+			w.WriteHeader(http.StatusBadRequest)
+			out, _ := protojson.Marshal(&steprpcv1.ErrorResponse{Error: &steprpcv1.Error{
+				Code:    string(CodeBatchTooLarge),
+				Message: "too many requests",
+				Details: map[string]string{"maxBatchSize": fmt.Sprint(s.maxBatch)},
+			}})
+			_, _ = w.Write(out)
+			return
+		}
+		out := &steprpcv1.BatchInvokeResponse{}
+		for _, req := range in.GetRequests() {
+			out.Results = append(out.Results, s.invokeOne(req))
+		}
+		body, _ := protojson.Marshal(out)
+		_, _ = w.Write(body)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func batchRequests(n int) []*steprpcv1.InvokeRequest {
+	reqs := make([]*steprpcv1.InvokeRequest, n)
+	for i := range reqs {
+		reqs[i] = &steprpcv1.InvokeRequest{RequestId: fmt.Sprintf("r-%d", i), Operation: "echo"}
+	}
+	return reqs
+}
+
+// checkResults verifies every result except those at failing, which must be
+// operation_not_allowed.
+func checkResults(t *testing.T, reqs []*steprpcv1.InvokeRequest, results []BatchResult, failing ...int) {
+	t.Helper()
+	if len(results) != len(reqs) {
+		t.Fatalf("results = %d, want %d", len(results), len(reqs))
+	}
+	fail := map[int]bool{}
+	for _, i := range failing {
+		fail[i] = true
+	}
+	for i, res := range results {
+		if fail[i] {
+			if !errors.Is(res.Err, ErrOperationNotAllowed) || res.Response != nil {
+				t.Fatalf("results[%d] = %+v, want operation_not_allowed", i, res)
+			}
+			continue
+		}
+		if res.Err != nil || res.Response.GetRunId() != "rpc-"+reqs[i].GetRequestId() {
+			t.Fatalf("results[%d] = %+v, want run for %s", i, res, reqs[i].GetRequestId())
+		}
+	}
+}
+
+func TestInvokeBatch_ChunksAndKeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	srv := &batchServer{batch: true}
+	ts := newBatchServer(t, srv)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	reqs := batchRequests(250)
+	reqs[7].Operation = "forbidden"
+	reqs[180].RequestId = reqs[3].GetRequestId() // same key: the first run comes back
+	results, err := c.InvokeBatch(context.Background(), reqs)
+	if !errors.Is(err, ErrOperationNotAllowed) {
+		t.Fatalf("InvokeBatch() error = %v, want joined operation_not_allowed", err)
+	}
+	checkResults(t, reqs, results, 7)
+	if results[180].Response.GetRunId() != results[3].Response.GetRunId() {
+		t.Fatalf("dedup: run %s != %s", results[180].Response.GetRunId(), results[3].Response.GetRunId())
+	}
+	if fmt.Sprint(srv.batchSizes) != "[100 100 50]" || srv.singles != 0 {
+		t.Fatalf("batches = %v, singles = %d, want [100 100 50] and 0", srv.batchSizes, srv.singles)
+	}
+}
+
+func TestInvokeBatch_HonorsServerLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := &batchServer{batch: true, maxBatch: 30}
+	ts := newBatchServer(t, srv)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	reqs := batchRequests(70)
+	results, err := c.InvokeBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatalf("InvokeBatch() error = %v", err)
+	}
+	checkResults(t, reqs, results)
+	if fmt.Sprint(srv.batchSizes) != "[70 30 30 10]" {
+		t.Fatalf("batches = %v, want one rejected then [30 30 10]", srv.batchSizes)
+	}
+}
+
+func TestInvokeBatch_FallsBackToSingleInvokes(t *testing.T) {
+	t.Parallel()
+
+	srv := &batchServer{}
+	ts := newBatchServer(t, srv)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	reqs := batchRequests(40)
+	reqs[12].Operation = "forbidden"
+	results, err := c.InvokeBatch(context.Background(), reqs)
+	if !errors.Is(err, ErrOperationNotAllowed) {
+		t.Fatalf("InvokeBatch() error = %v, want operation_not_allowed", err)
+	}
+	checkResults(t, reqs, results, 12)
+	if len(srv.batchSizes) != 0 || srv.singles != 40 {
+		t.Fatalf("batches = %v, singles = %d, want fallback for all 40", srv.batchSizes, srv.singles)
+	}
+	if srv.maxInFlight < 2 || srv.maxInFlight > batchFallbackConcurrency {
+		t.Fatalf("max concurrent invokes = %d, want 2..%d", srv.maxInFlight, batchFallbackConcurrency)
+	}
+}
+
+func TestInvokeBatch_AdvertisedWithoutCapability(t *testing.T) {
+	t.Parallel()
+
+	srv := &batchServer{batch: true}
+	ts := newBatchServer(t, srv)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: "v1", Capabilities: []string{"invoke", "runs"}})
+
+	reqs := batchRequests(3)
+	results, err := c.InvokeBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatalf("InvokeBatch() error = %v", err)
+	}
+	checkResults(t, reqs, results)
+	if len(srv.batchSizes) != 0 || srv.singles != 3 {
+		t.Fatalf("batches = %v, singles = %d, want single invokes only", srv.batchSizes, srv.singles)
+	}
+}
+
+func TestInvokeBatch_PreparesEachRequest(t *testing.T) {
+	t.Parallel()
+
+	srv := &batchServer{batch: true}
+	ts := newBatchServer(t, srv)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	journal := openTestJournal(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	c = c.WithJournal(journal)
+
+	reqs := []*steprpcv1.InvokeRequest{
+		{RequestId: "r-0", Operation: "echo"},
+		nil,
+		{Operation: "echo"}, // the journal needs a requestId
+		{RequestId: "r-3", Operation: "echo"},
+	}
+	results, err := c.InvokeBatch(context.Background(), reqs)
+	if err == nil || results[1].Err == nil || results[2].Err == nil {
+		t.Fatalf("InvokeBatch() = %+v, %v, want items 1 and 2 rejected", results, err)
+	}
+	if results[0].Err != nil || results[3].Err != nil {
+		t.Fatalf("InvokeBatch() = %+v, want items 0 and 3 accepted", results)
+	}
+	if fmt.Sprint(srv.batchSizes) != "[2]" {
+		t.Fatalf("batches = %v, want only valid requests sent", srv.batchSizes)
+	}
+
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	var kinds []string
+	for _, e := range entries {
+		kinds = append(kinds, string(e.Kind)+":"+e.RequestID)
+	}
+	want := "[intent:r-0 intent:r-3 accepted:r-0 accepted:r-3]"
+	if fmt.Sprint(kinds) != want {
+		t.Fatalf("journal = %v, want %s", kinds, want)
+	}
+}
@@ -0,0 +1,67 @@
+package rpcclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ReportBridgeProgress records how far a worker has got with a CPS bridge
+// request. The caller sees the last report as RunStatusResponse.progress. A
+// request obtained through ClaimBridgeRequest must carry its lease in
+// req.LeaseId, as for CompleteBridgeRequest; when it is empty and ctx carries
+// the run's lease (see BridgeLeaseFromContext), that lease is sent.
+func (c *Client) ReportBridgeProgress(ctx context.Context, req *steprpcv1.BridgeProgressRequest) (*steprpcv1.BridgeProgressResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("bridge progress request is required")
+	}
+	if strings.TrimSpace(req.GetRunId()) == "" {
+		return nil, fmt.Errorf("runID is required")
+	}
+	if req.GetPercent() < 0 || req.GetPercent() > 100 {
+		return nil, fmt.Errorf("percent must be between 0 and 100, got %d", req.GetPercent())
+	}
+	if err := c.requireCapability(CapabilityBridgeResults); err != nil {
+		return nil, err
+	}
+	if lease, ok := BridgeLeaseFromContext(ctx); ok && req.GetLeaseId() == "" && lease.RunID == req.GetRunId() {
+		req = proto.CloneOf(req)
+		req.LeaseId = lease.LeaseID
+	}
+
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bridge progress request: %w", err)
+	}
+	body, err := c.postJSON(ctx, "/step-rpc/v1/bridge/progress", payload)
+	if err != nil {
+		return nil, fmt.Errorf("send bridge progress request: %w", err)
+	}
+	out := &steprpcv1.BridgeProgressResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("decode bridge progress response: %w", err)
+	}
+	return out, nil
+}
+
+// CompleteBridgeRequestWithResult completes a CPS bridge request like
+// CompleteBridgeRequest and returns result to the caller, who reads it from
+// GetRunStatus or GetRunResult once the run is terminal. result must convert
+// with structpb.NewStruct: JSON-like maps, slices, strings, numbers, and bools.
+func (c *Client) CompleteBridgeRequestWithResult(ctx context.Context, req *steprpcv1.BridgeCompleteRequest, result map[string]any) (*steprpcv1.BridgeCompleteResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("bridge complete request is required")
+	}
+	data, err := structpb.NewStruct(result)
+	if err != nil {
+		return nil, fmt.Errorf("convert bridge result: %w", err)
+	}
+	req = proto.CloneOf(req)
+	req.Result = data
+	return c.CompleteBridgeRequest(ctx, req)
+}
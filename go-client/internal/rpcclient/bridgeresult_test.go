@@ -0,0 +1,128 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestReportBridgeProgress(t *testing.T) {
+	t.Parallel()
+
+	var got *steprpcv1.BridgeProgressRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/step-rpc/v1/bridge/progress" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		got = &steprpcv1.BridgeProgressRequest{}
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), got); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runId":"rpc-1","progress":{"percent":40,"message":"copying","updatedAt":"2026-01-02T03:04:05Z"}}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	resp, err := c.ReportBridgeProgress(context.Background(), &steprpcv1.BridgeProgressRequest{
+		RunId:   "rpc-1",
+		LeaseId: "lease-1",
+		Percent: 40,
+		Message: "copying",
+	})
+	if err != nil {
+		t.Fatalf("ReportBridgeProgress() error = %v", err)
+	}
+	if got.GetLeaseId() != "lease-1" || got.GetPercent() != 40 || got.GetMessage() != "copying" {
+		t.Fatalf("sent %v", got)
+	}
+	if resp.GetProgress().GetPercent() != 40 || resp.GetProgress().GetUpdatedAt() == nil {
+		t.Fatalf("progress = %v", resp.GetProgress())
+	}
+}
+
+func TestReportBridgeProgress_Validation(t *testing.T) {
+	t.Parallel()
+
+	c, err := New("http://jenkins.invalid", "", nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for _, req := range []*steprpcv1.BridgeProgressRequest{
+		nil,
+		{Percent: 10},
+		{RunId: "rpc-1", Percent: -1},
+		{RunId: "rpc-1", Percent: 101},
+	} {
+		if _, err := c.ReportBridgeProgress(context.Background(), req); err == nil {
+			t.Fatalf("ReportBridgeProgress(%v) error = nil, want validation error", req)
+		}
+	}
+
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge"}})
+	_, err = c.ReportBridgeProgress(context.Background(), &steprpcv1.BridgeProgressRequest{RunId: "rpc-1", Percent: 50})
+	if !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("ReportBridgeProgress() error = %v, want ErrCapabilityUnsupported", err)
+	}
+}
+
+func TestCompleteBridgeRequestWithResult(t *testing.T) {
+	t.Parallel()
+
+	var got *steprpcv1.BridgeCompleteRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = &steprpcv1.BridgeCompleteRequest{}
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), got); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"requestId":"req-1","runId":"rpc-1","state":"succeeded"}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	req := &steprpcv1.BridgeCompleteRequest{RunId: "rpc-1", State: "succeeded"}
+	if _, err := c.CompleteBridgeRequestWithResult(context.Background(), req, map[string]any{
+		"content": "hello",
+		"files":   []any{"a.txt", "b.txt"},
+	}); err != nil {
+		t.Fatalf("CompleteBridgeRequestWithResult() error = %v", err)
+	}
+	if req.GetResult() != nil {
+		t.Fatalf("caller's request was modified: %v", req)
+	}
+	fields := got.GetResult().GetFields()
+	if fields["content"].GetStringValue() != "hello" || len(fields["files"].GetListValue().GetValues()) != 2 {
+		t.Fatalf("result sent = %v", got.GetResult())
+	}
+
+	if _, err := c.CompleteBridgeRequestWithResult(context.Background(), req, map[string]any{"ch": make(chan int)}); err == nil {
+		t.Fatalf("CompleteBridgeRequestWithResult(chan) error = nil, want conversion error")
+	}
+}
+
+func TestCompleteBridgeRequest_ResultNeedsCapability(t *testing.T) {
+	t.Parallel()
+
+	c, err := New("http://jenkins.invalid", "", nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge"}})
+
+	_, err = c.CompleteBridgeRequestWithResult(context.Background(), &steprpcv1.BridgeCompleteRequest{RunId: "rpc-1", State: "succeeded"}, map[string]any{"ok": true})
+	if !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("CompleteBridgeRequestWithResult() error = %v, want ErrCapabilityUnsupported", err)
+	}
+}
@@ -0,0 +1,147 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// SupportedAPIVersion is the HealthResponse.api_version this client speaks.
+const SupportedAPIVersion = "v1"
+
+// Capability names a server feature advertised in HealthResponse.capabilities.
+type Capability string
+
+// Capabilities in the v1 baseline. Servers that predate capability negotiation
+// are assumed to support exactly these.
+const (
+	CapabilityInvoke    Capability = "invoke"
+	CapabilityRunStatus Capability = "runs"
+	CapabilityCatalog   Capability = "catalog"
+	CapabilityCPSBridge Capability = "bridge"
+)
+
+// Optional capabilities, not part of the baseline.
+const (
+	// CapabilityWebhooks marks servers that deliver InvokeRequest.callback_url
+	// notifications.
+	CapabilityWebhooks Capability = "webhooks"
+	// CapabilityBatchInvoke marks servers with the batchInvoke endpoint.
+	CapabilityBatchInvoke Capability = "batch_invoke"
+	// CapabilityRunOutput marks servers with the run result and log endpoints.
+	CapabilityRunOutput Capability = "run_output"
+	// CapabilityBridgeLeases marks servers with the bridge claim and heartbeat
+	// endpoints.
+	CapabilityBridgeLeases Capability = "bridge_leases"
+	// CapabilityBridgeResults marks servers with the bridge progress endpoint
+	// that accept a result with bridge completions.
+	CapabilityBridgeResults Capability = "bridge_results"
+	// CapabilityBridgeDiscovery marks servers that list runs with queued
+	// bridge requests.
+	CapabilityBridgeDiscovery Capability = "bridge_discovery"
+	// CapabilityRunState marks servers that read the RunState enum in
+	// BridgeCompleteRequest.run_state.
+	CapabilityRunState Capability = "run_state"
+)
+
+var baselineCapabilities = []Capability{
+	CapabilityInvoke,
+	CapabilityRunStatus,
+	CapabilityCatalog,
+	CapabilityCPSBridge,
+}
+
+var (
+	// ErrIncompatibleAPIVersion is returned by Handshake when the server reports
+	// an api_version this client does not speak.
+	ErrIncompatibleAPIVersion = errors.New("incompatible api version")
+
+	// ErrCapabilityUnsupported is returned, without a round trip, when a
+	// handshaken server did not advertise the capability a call needs.
+	ErrCapabilityUnsupported = errors.New("capability not supported by server")
+)
+
+// Capabilities is the feature set negotiated with a server during Handshake.
+type Capabilities struct {
+	APIVersion string
+	// Advertised is false when the server sent no capability list and the v1
+	// baseline was assumed.
+	Advertised bool
+	set        map[Capability]struct{}
+}
+
+func newCapabilities(health *steprpcv1.HealthResponse) *Capabilities {
+	caps := &Capabilities{
+		APIVersion: health.GetApiVersion(),
+		Advertised: len(health.GetCapabilities()) > 0,
+		set:        map[Capability]struct{}{},
+	}
+	if !caps.Advertised {
+		for _, c := range baselineCapabilities {
+			caps.set[c] = struct{}{}
+		}
+		return caps
+	}
+	for _, c := range health.GetCapabilities() {
+		caps.set[Capability(c)] = struct{}{}
+	}
+	return caps
+}
+
+// Has reports whether the server supports c. A nil Capabilities (no handshake)
+// supports everything.
+func (c *Capabilities) Has(capability Capability) bool {
+	if c == nil {
+		return true
+	}
+	_, ok := c.set[capability]
+	return ok
+}
+
+// GetHealth fetches the v1 health document.
+func (c *Client) GetHealth(ctx context.Context) (*steprpcv1.HealthResponse, error) {
+	out := &steprpcv1.HealthResponse{}
+	if err := c.getProto(ctx, "/step-rpc/v1/", out, "health"); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Handshake reads the health document, checks api_version, and returns a copy
+// of the client that refuses calls needing capabilities the server lacks.
+func (c *Client) Handshake(ctx context.Context) (*Client, error) {
+	health, err := c.GetHealth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("handshake: %w", err)
+	}
+	if health.GetApiVersion() != SupportedAPIVersion {
+		return nil, fmt.Errorf("handshake: %w: server=%q client=%q",
+			ErrIncompatibleAPIVersion, health.GetApiVersion(), SupportedAPIVersion)
+	}
+	cp := *c
+	cp.capabilities = newCapabilities(health)
+	return &cp, nil
+}
+
+// Capabilities returns the negotiated capabilities, or nil before Handshake.
+func (c *Client) Capabilities() *Capabilities {
+	return c.capabilities
+}
+
+func (c *Client) requireCapability(capability Capability) error {
+	if !c.capabilities.Has(capability) {
+		return fmt.Errorf("%w: %s", ErrCapabilityUnsupported, capability)
+	}
+	return nil
+}
+
+// requireNegotiated is requireCapability for features that must not be assumed
+// without a Handshake, such as callbacks an older server would silently drop.
+func (c *Client) requireNegotiated(capability Capability) error {
+	if c.capabilities == nil {
+		return fmt.Errorf("%w: %s needs a Handshake first", ErrCapabilityUnsupported, capability)
+	}
+	return c.requireCapability(capability)
+}
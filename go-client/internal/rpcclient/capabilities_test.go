@@ -0,0 +1,128 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+func healthServer(t *testing.T, health string, calls *int32) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/step-rpc/v1/" {
+			atomic.AddInt32(calls, 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/step-rpc/v1/" {
+			_, _ = w.Write([]byte(health))
+			return
+		}
+		_, _ = w.Write([]byte(`{"operations":[]}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestHandshake_LegacyServerAssumesBaseline(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := healthServer(t, `{"apiVersion":"v1","service":"jenkins-step-rpc-plugin","status":"ok"}`, &calls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	hc, err := c.Handshake(context.Background())
+	if err != nil {
+		t.Fatalf("Handshake() error = %v", err)
+	}
+	caps := hc.Capabilities()
+	if caps.Advertised {
+		t.Fatalf("Advertised = true, want false")
+	}
+	for _, capability := range baselineCapabilities {
+		if !caps.Has(capability) {
+			t.Fatalf("Has(%s) = false, want true", capability)
+		}
+	}
+	if caps.Has(CapabilityBatchInvoke) {
+		t.Fatalf("Has(batch_invoke) = true, want false")
+	}
+	if c.Capabilities() != nil {
+		t.Fatalf("Handshake() mutated the original client")
+	}
+}
+
+func TestHandshake_DisablesMissingCapabilities(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := healthServer(t, `{"apiVersion":"v1","status":"ok","capabilities":["invoke","runs"]}`, &calls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c, err = c.Handshake(context.Background())
+	if err != nil {
+		t.Fatalf("Handshake() error = %v", err)
+	}
+
+	if _, err := c.GetCatalog(context.Background()); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("GetCatalog() error = %v, want ErrCapabilityUnsupported", err)
+	}
+	if _, err := c.GetBridgePending(context.Background(), "job#1"); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("GetBridgePending() error = %v, want ErrCapabilityUnsupported", err)
+	}
+	callback := &steprpcv1.InvokeRequest{RequestId: "r-1", Operation: "echo", CallbackUrl: "https://hooks.example.com/", CallbackSecret: "s"}
+	if _, err := c.Invoke(context.Background(), callback); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("Invoke(callbackUrl) error = %v, want ErrCapabilityUnsupported", err)
+	}
+	callback.CallbackSecret = ""
+	if _, err := c.Invoke(context.Background(), callback); err == nil || !strings.Contains(err.Error(), "callbackSecret") {
+		t.Fatalf("Invoke(no secret) error = %v, want callbackSecret required", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Fatalf("calls = %d, want 0", got)
+	}
+}
+
+func TestInvoke_CallbackNeedsHandshake(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := healthServer(t, `{"apiVersion":"v1","status":"ok"}`, &calls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// Without a handshake the client cannot know the server delivers callbacks.
+	callback := &steprpcv1.InvokeRequest{RequestId: "r-1", Operation: "echo", CallbackUrl: "https://hooks.example.com/", CallbackSecret: "s"}
+	if _, err := c.Invoke(context.Background(), callback); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("Invoke(callbackUrl) error = %v, want ErrCapabilityUnsupported", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Fatalf("calls = %d, want 0", got)
+	}
+}
+
+func TestHandshake_IncompatibleAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	ts := healthServer(t, `{"apiVersion":"v2","status":"ok"}`, &calls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := c.Handshake(context.Background()); !errors.Is(err, ErrIncompatibleAPIVersion) {
+		t.Fatalf("Handshake() error = %v, want ErrIncompatibleAPIVersion", err)
+	}
+}
@@ -0,0 +1,91 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/cassette"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// replayClient serves testdata/cassettes/<name>, which is recorded from the e2e
+// test of the same name with `just e2e-record-cassettes`. Cassettes are never
+// written by hand; the test is skipped until one has been recorded.
+func replayClient(t *testing.T, name string) (*Client, *cassette.Replayer) {
+	t.Helper()
+	c, err := cassette.Load(filepath.Join("testdata", "cassettes", name))
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("cassette %s not recorded; run `just e2e-record-cassettes`", name)
+	}
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	replayer := cassette.NewReplayer(c, cassette.DefaultMatchRule)
+	client, err := New("http://replay.invalid", "", &http.Client{Transport: replayer})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return client, replayer
+}
+
+func TestCassette_Catalog(t *testing.T) {
+	t.Parallel()
+
+	c, replayer := replayClient(t, "TestCatalog.json")
+
+	catalog, err := c.GetCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	found := false
+	for _, op := range catalog.GetOperations() {
+		if op.GetName() == "archiveArtifacts" {
+			found = op.GetExecutionMode() == steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT
+		}
+	}
+	if !found {
+		t.Fatalf("catalog = %v, want archiveArtifacts in direct lane", catalog.GetOperations())
+	}
+	if replayer.Remaining() != 0 {
+		t.Fatalf("Remaining() = %d, want 0", replayer.Remaining())
+	}
+}
+
+func TestCassette_DirectInvoke(t *testing.T) {
+	t.Parallel()
+
+	c, replayer := replayClient(t, "TestDirectInvoke.json")
+
+	// Mirrors the request sent by TestDirectInvoke in tests/e2e.
+	args, err := structpb.NewStruct(map[string]any{
+		"artifacts": "artifact.txt",
+		"runContext": map[string]any{
+			"jobFullName": "e2e-archive-test",
+			"buildNumber": 1,
+			"nodeName":    "built-in",
+			"workspace":   "/var/jenkins_home/workspace/e2e-archive-test",
+		},
+	})
+	if err != nil {
+		t.Fatalf("structpb.NewStruct() error = %v", err)
+	}
+	resp, err := c.Invoke(context.Background(), &steprpcv1.InvokeRequest{
+		RequestId: "e2e-req-1",
+		Operation: "archiveArtifacts",
+		Args:      args,
+	})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if resp.GetState() != stateSucceeded {
+		t.Fatalf("state = %s, want %s", resp.GetState(), stateSucceeded)
+	}
+	if replayer.Remaining() != 0 {
+		t.Fatalf("Remaining() = %d, want 0", replayer.Remaining())
+	}
+}
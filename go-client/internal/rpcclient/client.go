@@ -12,18 +12,27 @@ import (
 	"time"
 
 	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
 const defaultPollInterval = 2 * time.Second
 
+// responseJSON decodes server responses. Unknown fields are dropped so a
+// client keeps working against a plugin that has added fields since it was
+// built.
+var responseJSON = protojson.UnmarshalOptions{DiscardUnknown: true}
+
 // PollPolicy controls polling behavior for WaitRunTerminal.
 type PollPolicy struct {
 	InitialInterval time.Duration
 	MaxInterval     time.Duration
 	MaxAttempts     int
 	MaxDuration     time.Duration
+	// FailOnRunFailure makes WaitRunTerminal return a *RunFailedError, alongside
+	// the terminal status, when the run ends in a state other than succeeded.
+	FailOnRunFailure bool
 }
 
 // Client is a minimal HTTP client scaffold for the plugin RPC API.
@@ -31,8 +40,34 @@ type Client struct {
 	baseURL     string
 	httpClient  *http.Client
 	token       string
+	username    string
 	retryPolicy *RetryPolicy
 	debugHook   *DebugHook
+	limiter     *rate.Limiter
+
+	bridgePollPolicy      *PollPolicy
+	logPollPolicy         *PollPolicy
+	bridgeReconnectPolicy *PollPolicy
+	runContext            *RunContext
+	capabilities          *Capabilities
+	journal               Journal
+	invokePolicy          InvokePolicy
+	runNotifier           RunNotifier
+	bridgeWorkerID        string
+}
+
+// InvokePolicy authorizes invocations before they are sent. mode is the
+// operation's catalog execution mode when the call comes from Execute and
+// UNSPECIFIED from Invoke.
+type InvokePolicy interface {
+	CheckInvoke(ctx context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) error
+}
+
+// RunNotifier delivers terminal run statuses pushed by the server, such as a
+// webhook receiver. NotifyTerminal returns a channel that receives runID's
+// terminal status at most once, and a function that ends the subscription.
+type RunNotifier interface {
+	NotifyTerminal(runID string) (<-chan *steprpcv1.RunStatusResponse, func())
 }
 
 // New creates a new client scaffold.
@@ -64,44 +99,165 @@ func (c *Client) WithDebugHook(h *DebugHook) *Client {
 	return &cp
 }
 
+// WithBasicAuth returns a copy of the client that authenticates with HTTP basic
+// auth, using token as the password. Jenkins API tokens are sent this way.
+func (c *Client) WithBasicAuth(username, token string) *Client {
+	cp := *c
+	cp.username = username
+	cp.token = token
+	return &cp
+}
+
+// WithRateLimit returns a copy of the client that sends at most
+// requestsPerSecond requests on average, with bursts of up to burst. Retries
+// count against the limit. A non-positive rate removes the limit.
+func (c *Client) WithRateLimit(requestsPerSecond float64, burst int) *Client {
+	cp := *c
+	cp.limiter = nil
+	if requestsPerSecond > 0 {
+		cp.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+	}
+	return &cp
+}
+
+// WithInvokePolicy returns a copy that checks every invocation against p after
+// the run context is injected. A denial is returned unchanged and nothing is sent.
+func (c *Client) WithInvokePolicy(p InvokePolicy) *Client {
+	cp := *c
+	cp.invokePolicy = p
+	return &cp
+}
+
+// WithRunNotifier returns a copy whose WaitRunTerminal also listens on n and
+// returns as soon as n reports the run terminal, without waiting for the next
+// poll. Polling continues as the fallback.
+func (c *Client) WithRunNotifier(n RunNotifier) *Client {
+	cp := *c
+	cp.runNotifier = n
+	return &cp
+}
+
+// WithBridgeWorkerID returns a copy that identifies itself as id when fetching
+// bridge requests. The server reports it in RunStatusResponse.worker_id.
+func (c *Client) WithBridgeWorkerID(id string) *Client {
+	cp := *c
+	cp.bridgeWorkerID = id
+	return &cp
+}
+
+func (c *Client) authorize(httpReq *http.Request) {
+	switch {
+	case c.username != "":
+		httpReq.SetBasicAuth(c.username, c.token)
+	case c.token != "":
+		httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	}
+}
+
 // Invoke sends an invoke request to the plugin.
 func (c *Client) Invoke(ctx context.Context, req *steprpcv1.InvokeRequest) (*steprpcv1.InvokeResponse, error) {
-	if req == nil {
-		return nil, fmt.Errorf("invoke request is required")
+	return c.invoke(ctx, req, steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED)
+}
+
+func (c *Client) invoke(ctx context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) (*steprpcv1.InvokeResponse, error) {
+	req, payload, err := c.prepareInvoke(ctx, req, mode)
+	if err != nil {
+		return nil, err
 	}
 
-	payload, err := protojson.MarshalOptions{
-		UseProtoNames: false,
-	}.Marshal(req)
+	out, err := c.sendInvoke(ctx, payload)
 	if err != nil {
-		return nil, fmt.Errorf("marshal invoke request: %w", err)
+		return nil, c.journalRejected(req, err)
 	}
+	return out, c.journalAccepted(req, out)
+}
 
-	body, err := c.doRequestWithRetry(ctx, func() (*http.Request, error) {
+func (c *Client) sendInvoke(ctx context.Context, payload []byte) (*steprpcv1.InvokeResponse, error) {
+	body, err := c.postJSON(ctx, "/step-rpc/v1/invoke", payload)
+	if err != nil {
+		return nil, fmt.Errorf("send invoke request: %w", err)
+	}
+	out := &steprpcv1.InvokeResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("decode invoke response: %w", err)
+	}
+	return out, nil
+}
+
+func (c *Client) postJSON(ctx context.Context, endpoint string, payload []byte) ([]byte, error) {
+	return c.doRequestWithRetry(ctx, func() (*http.Request, error) {
 		httpReq, reqErr := http.NewRequestWithContext(
 			ctx,
 			http.MethodPost,
-			c.baseURL+"/step-rpc/v1/invoke",
+			c.baseURL+endpoint,
 			bytes.NewReader(payload),
 		)
 		if reqErr != nil {
 			return nil, reqErr
 		}
 		httpReq.Header.Set("Content-Type", "application/json")
-		if c.token != "" {
-			httpReq.Header.Set("Authorization", "Bearer "+c.token)
-		}
+		c.authorize(httpReq)
 		return httpReq, nil
 	})
+}
+
+// prepareInvoke validates req, injects the run context, checks the invoke
+// policy, and journals the intent. It returns the request to send and its JSON.
+func (c *Client) prepareInvoke(ctx context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) (*steprpcv1.InvokeRequest, []byte, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("invoke request is required")
+	}
+	if err := c.requireCapability(CapabilityInvoke); err != nil {
+		return nil, nil, err
+	}
+	if req.GetCallbackUrl() != "" {
+		if req.GetCallbackSecret() == "" {
+			return nil, nil, fmt.Errorf("callbackSecret is required with callbackUrl")
+		}
+		if err := c.requireNegotiated(CapabilityWebhooks); err != nil {
+			return nil, nil, err
+		}
+	}
+	if c.runContext != nil {
+		args, err := InjectRunContext(req.GetArgs(), *c.runContext)
+		if err != nil {
+			return nil, nil, fmt.Errorf("inject run context: %w", err)
+		}
+		if args != req.GetArgs() {
+			req = proto.CloneOf(req)
+			req.Args = args
+		}
+	}
+	if c.invokePolicy != nil {
+		if err := c.invokePolicy.CheckInvoke(ctx, req, mode); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	payload, err := protojson.MarshalOptions{
+		UseProtoNames: false,
+	}.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("send invoke request: %w", err)
+		return nil, nil, fmt.Errorf("marshal invoke request: %w", err)
 	}
+	if c.journal != nil {
+		if strings.TrimSpace(req.GetRequestId()) == "" {
+			return nil, nil, fmt.Errorf("requestID is required when a journal is set")
+		}
+		intent := JournalEntry{Kind: JournalIntent, RequestID: req.GetRequestId(), Operation: req.GetOperation(), Request: payload}
+		if err := c.journalAppend(intent); err != nil {
+			return nil, nil, err
+		}
+	}
+	return req, payload, nil
+}
 
-	out := &steprpcv1.InvokeResponse{}
-	if err := protojson.Unmarshal(body, out); err != nil {
-		return nil, fmt.Errorf("decode invoke response: %w", err)
+func (c *Client) journalAccepted(req *steprpcv1.InvokeRequest, out *steprpcv1.InvokeResponse) error {
+	if c.journal == nil {
+		return nil
 	}
-	return out, nil
+	accepted := JournalEntry{Kind: JournalAccepted, RequestID: req.GetRequestId(), RunID: out.GetRunId(), State: stateName(out)}
+	return c.journalAppend(accepted)
 }
 
 // GetRunStatus fetches status for a run ID.
@@ -109,6 +265,9 @@ func (c *Client) GetRunStatus(ctx context.Context, runID string) (*steprpcv1.Run
 	if strings.TrimSpace(runID) == "" {
 		return nil, fmt.Errorf("runID is required")
 	}
+	if err := c.requireCapability(CapabilityRunStatus); err != nil {
+		return nil, err
+	}
 
 	out := &steprpcv1.RunStatusResponse{}
 	if err := c.getProto(ctx, "/step-rpc/v1/runs/"+url.PathEscape(runID), out, "status"); err != nil {
@@ -119,6 +278,9 @@ func (c *Client) GetRunStatus(ctx context.Context, runID string) (*steprpcv1.Run
 
 // GetCatalog fetches operation discovery metadata from the plugin.
 func (c *Client) GetCatalog(ctx context.Context) (*steprpcv1.CatalogResponse, error) {
+	if err := c.requireCapability(CapabilityCatalog); err != nil {
+		return nil, err
+	}
 	out := &steprpcv1.CatalogResponse{}
 	if err := c.getProto(ctx, "/step-rpc/v1/catalog", out, "catalog"); err != nil {
 		return nil, err
@@ -126,7 +288,9 @@ func (c *Client) GetCatalog(ctx context.Context) (*steprpcv1.CatalogResponse, er
 	return out, nil
 }
 
-// WaitRunTerminal polls run status until a terminal state is reached or context is canceled.
+// WaitRunTerminal polls run status until a terminal state is reached or context
+// is canceled. With a RunNotifier it also returns when the notifier reports the
+// run terminal first.
 func (c *Client) WaitRunTerminal(ctx context.Context, runID string, policy PollPolicy) (*steprpcv1.RunStatusResponse, error) {
 	if strings.TrimSpace(runID) == "" {
 		return nil, fmt.Errorf("runID is required")
@@ -144,14 +308,25 @@ func (c *Client) WaitRunTerminal(ctx context.Context, runID string, policy PollP
 		defer cancel()
 	}
 
+	var notified <-chan *steprpcv1.RunStatusResponse
+	if c.runNotifier != nil {
+		var stop func()
+		notified, stop = c.runNotifier.NotifyTerminal(runID)
+		defer stop()
+	}
+
 	interval := policy.InitialInterval
+	last := RunStateUnknown
 	for attempt := 0; ; attempt++ {
 		status, err := c.GetRunStatus(ctx, runID)
 		if err != nil {
 			return nil, err
 		}
-		if isTerminalState(status.GetState()) {
-			return status, nil
+		state := RunStateOf(status)
+		c.ObserveTransition(runID, last, state)
+		last = state
+		if state.IsTerminal() {
+			return c.terminal(runID, status, policy)
 		}
 
 		if policy.MaxAttempts > 0 && attempt+1 >= policy.MaxAttempts {
@@ -161,6 +336,9 @@ func (c *Client) WaitRunTerminal(ctx context.Context, runID string, policy PollP
 		select {
 		case <-ctx.Done():
 			return nil, fmt.Errorf("wait run terminal: %w", ctx.Err())
+		case status := <-notified:
+			c.ObserveTransition(runID, last, RunStateOf(status))
+			return c.terminal(runID, status, policy)
 		case <-time.After(pollJitter(interval)):
 		}
 
@@ -172,6 +350,18 @@ func (c *Client) WaitRunTerminal(ctx context.Context, runID string, policy PollP
 	}
 }
 
+// terminal journals a terminal status and applies FailOnRunFailure.
+func (c *Client) terminal(runID string, status *steprpcv1.RunStatusResponse, policy PollPolicy) (*steprpcv1.RunStatusResponse, error) {
+	entry := JournalEntry{Kind: JournalTerminal, RequestID: status.GetRequestId(), RunID: runID, State: stateName(status)}
+	if err := c.journalAppend(entry); err != nil {
+		return status, err
+	}
+	if policy.FailOnRunFailure && !RunStateOf(status).IsSuccess() {
+		return status, &RunFailedError{Status: status}
+	}
+	return status, nil
+}
+
 func pollJitter(d time.Duration) time.Duration {
 	if d <= 0 {
 		return 0
@@ -207,30 +397,24 @@ func operationsByExecutionMode(catalog *steprpcv1.CatalogResponse, mode steprpcv
 	return out
 }
 
-func isTerminalState(state string) bool {
-	switch strings.ToLower(strings.TrimSpace(state)) {
-	case "succeeded", "failed", "cancelled":
-		return true
-	default:
-		return false
-	}
+// IsTerminalState reports whether state is succeeded, failed, or cancelled.
+func IsTerminalState(state string) bool {
+	return ParseRunState(state).IsTerminal()
 }
 
-// GetBridgePending fetches the next pending CPS bridge request for a run.
+// GetBridgePending fetches the next pending CPS bridge request for a run
+// without waiting. When nothing is pending the error matches
+// ErrNoPendingRequest; workers should prefer NextBridgeRequest over polling
+// this.
 func (c *Client) GetBridgePending(ctx context.Context, runExternalizableID string) (*steprpcv1.BridgePendingResponse, error) {
-	if strings.TrimSpace(runExternalizableID) == "" {
-		return nil, fmt.Errorf("runExternalizableID is required")
-	}
-
-	out := &steprpcv1.BridgePendingResponse{}
-	endpoint := "/step-rpc/v1/bridge/pending?runExternalizableId=" + url.QueryEscape(runExternalizableID)
-	if err := c.getProto(ctx, endpoint, out, "bridge pending"); err != nil {
-		return nil, err
-	}
-	return out, nil
+	return c.WaitBridgePending(ctx, runExternalizableID, 0)
 }
 
-// CompleteBridgeRequest marks a CPS bridge request as terminal.
+// CompleteBridgeRequest marks a CPS bridge request as terminal. A request
+// obtained through ClaimBridgeRequest must carry its lease in req.LeaseId; the
+// server rejects a completion under an expired lease with ErrLeaseExpired and
+// one under a superseded lease with ErrLeaseMismatch. A req.Result needs a
+// server with CapabilityBridgeResults.
 func (c *Client) CompleteBridgeRequest(ctx context.Context, req *steprpcv1.BridgeCompleteRequest) (*steprpcv1.BridgeCompleteResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("bridge complete request is required")
@@ -238,9 +422,35 @@ func (c *Client) CompleteBridgeRequest(ctx context.Context, req *steprpcv1.Bridg
 	if strings.TrimSpace(req.GetRunId()) == "" {
 		return nil, fmt.Errorf("runID is required")
 	}
+	// Every server reads state. Servers without CapabilityRunState reject
+	// run_state as an unknown field, so the enum is only sent to servers that
+	// advertised it during Handshake.
+	req = proto.CloneOf(req)
+	if req.GetState() == "" {
+		req.State = string(RunStateFromProto(req.GetRunState()))
+	}
+	switch {
+	case c.capabilities == nil || !c.capabilities.Has(CapabilityRunState):
+		req.RunState = steprpcv1.RunState_RUN_STATE_UNSPECIFIED
+	case req.GetRunState() == steprpcv1.RunState_RUN_STATE_UNSPECIFIED:
+		req.RunState = ParseRunState(req.GetState()).Proto()
+	}
 	if strings.TrimSpace(req.GetState()) == "" {
 		return nil, fmt.Errorf("state is required")
 	}
+	if err := c.requireCapability(CapabilityCPSBridge); err != nil {
+		return nil, err
+	}
+	if req.GetLeaseId() != "" {
+		if err := c.requireCapability(CapabilityBridgeLeases); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetResult() != nil {
+		if err := c.requireCapability(CapabilityBridgeResults); err != nil {
+			return nil, err
+		}
+	}
 
 	payload, err := protojson.MarshalOptions{
 		UseProtoNames: false,
@@ -260,9 +470,7 @@ func (c *Client) CompleteBridgeRequest(ctx context.Context, req *steprpcv1.Bridg
 			return nil, reqErr
 		}
 		httpReq.Header.Set("Content-Type", "application/json")
-		if c.token != "" {
-			httpReq.Header.Set("Authorization", "Bearer "+c.token)
-		}
+		c.authorize(httpReq)
 		return httpReq, nil
 	})
 	if err != nil {
@@ -270,12 +478,23 @@ func (c *Client) CompleteBridgeRequest(ctx context.Context, req *steprpcv1.Bridg
 	}
 
 	out := &steprpcv1.BridgeCompleteResponse{}
-	if err := protojson.Unmarshal(body, out); err != nil {
+	if err := responseJSON.Unmarshal(body, out); err != nil {
 		return nil, fmt.Errorf("decode bridge completion response: %w", err)
 	}
 	return out, nil
 }
 
+// CancelRun cancels a queued CPS bridge run by completing it as cancelled.
+// Direct runs finish synchronously inside Invoke and cannot be cancelled; for
+// them, and for bridge runs already completed, the plugin reports run_not_found.
+func (c *Client) CancelRun(ctx context.Context, runID string, reason string) (*steprpcv1.BridgeCompleteResponse, error) {
+	req := &steprpcv1.BridgeCompleteRequest{RunId: runID, State: "cancelled"}
+	if reason != "" {
+		req.Error = &steprpcv1.Error{Code: "cancelled", Message: reason}
+	}
+	return c.CompleteBridgeRequest(ctx, req)
+}
+
 func (c *Client) doRequest(httpReq *http.Request) (statusCode int, body []byte, err error) {
 	if c.debugHook != nil && c.debugHook.OnRequest != nil {
 		var reqBody []byte
@@ -310,7 +529,7 @@ func (c *Client) doRequest(httpReq *http.Request) (statusCode int, body []byte,
 	if httpResp.StatusCode < http.StatusOK || httpResp.StatusCode >= http.StatusMultipleChoices {
 		httpErr := &HTTPError{StatusCode: httpResp.StatusCode}
 		errResp := &steprpcv1.ErrorResponse{}
-		if unmarshalErr := protojson.Unmarshal(body, errResp); unmarshalErr == nil && errResp.GetError() != nil {
+		if unmarshalErr := responseJSON.Unmarshal(body, errResp); unmarshalErr == nil && errResp.GetError() != nil {
 			httpErr.ProtoError = errResp.GetError()
 		}
 		if c.debugHook != nil && c.debugHook.OnResponse != nil {
@@ -327,6 +546,11 @@ func (c *Client) doRequest(httpReq *http.Request) (statusCode int, body []byte,
 
 func (c *Client) doRequestWithRetry(ctx context.Context, buildReq func() (*http.Request, error)) ([]byte, error) {
 	return doWithRetry(ctx, c.retryPolicy, func() (int, []byte, error) {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return 0, nil, fmt.Errorf("rate limit: %w", err)
+			}
+		}
 		httpReq, err := buildReq()
 		if err != nil {
 			return 0, nil, err
@@ -346,15 +570,13 @@ func (c *Client) getProto(ctx context.Context, endpoint string, out proto.Messag
 		if reqErr != nil {
 			return nil, fmt.Errorf("build %s request: %w", name, reqErr)
 		}
-		if c.token != "" {
-			httpReq.Header.Set("Authorization", "Bearer "+c.token)
-		}
+		c.authorize(httpReq)
 		return httpReq, nil
 	})
 	if err != nil {
 		return fmt.Errorf("send %s request: %w", name, err)
 	}
-	if unmarshalErr := protojson.Unmarshal(body, out); unmarshalErr != nil {
+	if unmarshalErr := responseJSON.Unmarshal(body, out); unmarshalErr != nil {
 		return fmt.Errorf("decode %s response: %w", name, unmarshalErr)
 	}
 
@@ -94,6 +94,59 @@ func TestInvoke_ErrorPayload(t *testing.T) {
 	}
 }
 
+// policyFunc adapts a function to InvokePolicy.
+type policyFunc func(context.Context, *steprpcv1.InvokeRequest, steprpcv1.OperationExecutionMode) error
+
+func (f policyFunc) CheckInvoke(ctx context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) error {
+	return f(ctx, req, mode)
+}
+
+func TestWithInvokePolicy(t *testing.T) {
+	t.Parallel()
+
+	var statusCalls, invokes int32
+	exec := executeServer(t, stateSucceeded, &statusCalls)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&invokes, 1)
+		exec.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	errDenied := errors.New("denied")
+	var gotMode steprpcv1.OperationExecutionMode
+	var gotJob string
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithRunContext(NewRunContextForRun("job#1", "built-in", "/ws")).
+		WithInvokePolicy(policyFunc(func(_ context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) error {
+			gotMode = mode
+			gotJob = req.GetArgs().GetFields()[runContextArgKey].GetStructValue().GetFields()["runExternalizableId"].GetStringValue()
+			if req.GetOperation() == "sh" {
+				return errDenied
+			}
+			return nil
+		}))
+
+	if _, err := c.Invoke(context.Background(), &steprpcv1.InvokeRequest{RequestId: "r-1", Operation: "sh"}); !errors.Is(err, errDenied) {
+		t.Fatalf("Invoke() error = %v, want policy error", err)
+	}
+	if n := atomic.LoadInt32(&invokes); n != 0 {
+		t.Fatalf("requests = %d, want denied invoke not sent", n)
+	}
+	if gotJob != "job#1" || gotMode != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED {
+		t.Fatalf("policy saw job=%q mode=%v, want injected run context and unspecified mode", gotJob, gotMode)
+	}
+
+	if _, err := c.Execute(context.Background(), "archiveArtifacts", nil); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if gotMode != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT {
+		t.Fatalf("policy saw mode %v, want the catalog lane", gotMode)
+	}
+}
+
 func TestGetRunStatus(t *testing.T) {
 	t.Parallel()
 
@@ -131,6 +184,29 @@ func TestGetRunStatus(t *testing.T) {
 	}
 }
 
+func TestGetRunStatus_IgnoresUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runId":"job#1","state":"succeeded","addedInALaterPlugin":{"x":1}}`))
+	}))
+	defer ts.Close()
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	resp, err := c.GetRunStatus(context.Background(), "job#1")
+	if err != nil {
+		t.Fatalf("GetRunStatus() error = %v", err)
+	}
+	if resp.GetState() != stateSucceeded {
+		t.Fatalf("state = %s, want %s", resp.GetState(), stateSucceeded)
+	}
+}
+
 func TestGetRunStatus_ErrorPayload(t *testing.T) {
 	t.Parallel()
 
@@ -157,6 +233,7 @@ func TestGetRunStatus_ErrorPayload(t *testing.T) {
 func TestGetBridgePending(t *testing.T) {
 	t.Parallel()
 
+	var workerID string
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			t.Fatalf("method = %s, want GET", r.Method)
@@ -167,6 +244,7 @@ func TestGetBridgePending(t *testing.T) {
 		if r.URL.Query().Get("runExternalizableId") != "job/demo#1" {
 			t.Fatalf("query runExternalizableId = %s", r.URL.Query().Get("runExternalizableId"))
 		}
+		workerID = r.URL.Query().Get("workerId")
 		w.Header().Set("Content-Type", "application/json")
 		_, _ = w.Write([]byte(`{"requestId":"req-1","runId":"rpc-1","operation":"junit","args":{"testResults":"**/*.xml"},"targetRunExternalizableId":"job/demo#1"}`))
 	}))
@@ -187,6 +265,16 @@ func TestGetBridgePending(t *testing.T) {
 	if resp.GetOperation() != "junit" {
 		t.Fatalf("operation = %s, want junit", resp.GetOperation())
 	}
+	if workerID != "" {
+		t.Fatalf("workerId = %q, want none by default", workerID)
+	}
+
+	if _, err := c.WithBridgeWorkerID("sidecar-1").GetBridgePending(context.Background(), "job/demo#1"); err != nil {
+		t.Fatalf("GetBridgePending() error = %v", err)
+	}
+	if workerID != "sidecar-1" {
+		t.Fatalf("workerId = %q, want sidecar-1", workerID)
+	}
 }
 
 func TestGetBridgePending_ErrorPayload(t *testing.T) {
@@ -486,3 +574,100 @@ func mustReadAll(t *testing.T, body io.Reader) []byte {
 	}
 	return out
 }
+
+func TestWaitRunTerminal_FailOnRunFailure(t *testing.T) {
+	t.Parallel()
+
+	ts := fixtureServer(t, http.StatusOK, "run_status_failed.json")
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	status, err := c.WaitRunTerminal(context.Background(), "run-1", PollPolicy{InitialInterval: time.Millisecond})
+	if err != nil || status.GetState() != "failed" {
+		t.Fatalf("WaitRunTerminal() = %v, %v; want failed status without error", status, err)
+	}
+
+	status, err = c.WaitRunTerminal(context.Background(), "run-1", PollPolicy{
+		InitialInterval:  time.Millisecond,
+		FailOnRunFailure: true,
+	})
+	var failed *RunFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("WaitRunTerminal() error = %v, want *RunFailedError", err)
+	}
+	if status == nil || failed.Status != status {
+		t.Fatalf("status = %v, want terminal status alongside error", status)
+	}
+	if !errors.Is(err, ErrOperationFailed) {
+		t.Fatalf("errors.Is(err, ErrOperationFailed) = false, want true")
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+
+	var got atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.Store(r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operations":[]}`))
+	}))
+	defer ts.Close()
+
+	c, err := New(ts.URL, "tok", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{"bearer", c, "Bearer tok"},
+		{"basic", c.WithBasicAuth("ci-bot", "api-token"), "Basic Y2ktYm90OmFwaS10b2tlbg=="},
+	}
+	for _, tt := range tests {
+		if _, err := tt.client.GetCatalog(context.Background()); err != nil {
+			t.Fatalf("%s: GetCatalog() error = %v", tt.name, err)
+		}
+		if got.Load() != tt.want {
+			t.Fatalf("%s: Authorization = %q, want %q", tt.name, got.Load(), tt.want)
+		}
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operations":[]}`))
+	}))
+	defer ts.Close()
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithRateLimit(20, 1)
+
+	start := time.Now()
+	for range 3 {
+		if _, err := c.GetCatalog(context.Background()); err != nil {
+			t.Fatalf("GetCatalog() error = %v", err)
+		}
+	}
+	// One token up front, then one every 50ms.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("3 requests took %v, want >= 100ms at 20 rps burst 1", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetCatalog(ctx); err == nil {
+		t.Fatalf("GetCatalog() with canceled context error = nil")
+	}
+}
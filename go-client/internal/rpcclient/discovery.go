@@ -0,0 +1,28 @@
+package rpcclient
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// ListBridgeRuns lists the builds that have CPS bridge requests queued, with
+// how many each could hand out now and how many are leased. A non-empty
+// folder limits the list to jobs inside that folder, e.g. "team/app".
+func (c *Client) ListBridgeRuns(ctx context.Context, folder string) (*steprpcv1.BridgeRunsResponse, error) {
+	if err := c.requireCapability(CapabilityBridgeDiscovery); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/step-rpc/v1/bridge/runs"
+	if folder = strings.Trim(folder, "/"); folder != "" {
+		endpoint += "?folder=" + url.QueryEscape(folder)
+	}
+	out := &steprpcv1.BridgeRunsResponse{}
+	if err := c.getProto(ctx, endpoint, out, "bridge runs"); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,49 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+func TestListBridgeRuns(t *testing.T) {
+	t.Parallel()
+
+	var folders []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/step-rpc/v1/bridge/runs" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		folders = append(folders, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runs":[{"runExternalizableId":"team/app#3","pending":2,"leased":1}]}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	resp, err := c.ListBridgeRuns(context.Background(), "/team/")
+	if err != nil {
+		t.Fatalf("ListBridgeRuns() error = %v", err)
+	}
+	if runs := resp.GetRuns(); len(runs) != 1 || runs[0].GetRunExternalizableId() != "team/app#3" || runs[0].GetPending() != 2 || runs[0].GetLeased() != 1 {
+		t.Fatalf("runs = %v", runs)
+	}
+	if _, err := c.ListBridgeRuns(context.Background(), ""); err != nil {
+		t.Fatalf("ListBridgeRuns() error = %v", err)
+	}
+	if len(folders) != 2 || folders[0] != "folder=team" || folders[1] != "" {
+		t.Fatalf("queries = %q, want [folder=team \"\"]", folders)
+	}
+
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge"}})
+	if _, err := c.ListBridgeRuns(context.Background(), ""); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("ListBridgeRuns() error = %v, want ErrCapabilityUnsupported", err)
+	}
+}
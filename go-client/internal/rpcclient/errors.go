@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
 )
@@ -42,6 +45,116 @@ func (c ErrorCategory) String() string {
 	}
 }
 
+// ErrorCode is a machine-readable error code carried in Error.code.
+type ErrorCode string
+
+// Error codes emitted by the plugin.
+const (
+	CodeBadRequest          ErrorCode = "bad_request"
+	CodeBadJSON             ErrorCode = "bad_json"
+	CodeOperationNotAllowed ErrorCode = "operation_not_allowed"
+	CodeOperationNotFound   ErrorCode = "operation_not_found"
+	CodeOperationFailed     ErrorCode = "operation_failed"
+	CodeRunNotFound         ErrorCode = "run_not_found"
+	CodeNoPendingRequest    ErrorCode = "no_pending_request"
+	CodeBatchTooLarge       ErrorCode = "batch_too_large"
+	CodeLeaseExpired        ErrorCode = "lease_expired"
+	CodeLeaseMismatch       ErrorCode = "lease_mismatch"
+)
+
+// Sentinel errors matched by errors.Is against *HTTPError and *RunError values
+// carrying the corresponding ErrorCode.
+var (
+	ErrBadRequest          = errors.New(string(CodeBadRequest))
+	ErrBadJSON             = errors.New(string(CodeBadJSON))
+	ErrOperationNotAllowed = errors.New(string(CodeOperationNotAllowed))
+	ErrOperationNotFound   = errors.New(string(CodeOperationNotFound))
+	ErrOperationFailed     = errors.New(string(CodeOperationFailed))
+	ErrRunNotFound         = errors.New(string(CodeRunNotFound))
+	ErrNoPendingRequest    = errors.New(string(CodeNoPendingRequest))
+	ErrBatchTooLarge       = errors.New(string(CodeBatchTooLarge))
+	ErrLeaseExpired        = errors.New(string(CodeLeaseExpired))
+	ErrLeaseMismatch       = errors.New(string(CodeLeaseMismatch))
+)
+
+var sentinelByCode = map[ErrorCode]error{
+	CodeBadRequest:          ErrBadRequest,
+	CodeBadJSON:             ErrBadJSON,
+	CodeOperationNotAllowed: ErrOperationNotAllowed,
+	CodeOperationNotFound:   ErrOperationNotFound,
+	CodeOperationFailed:     ErrOperationFailed,
+	CodeRunNotFound:         ErrRunNotFound,
+	CodeNoPendingRequest:    ErrNoPendingRequest,
+	CodeBatchTooLarge:       ErrBatchTooLarge,
+	CodeLeaseExpired:        ErrLeaseExpired,
+	CodeLeaseMismatch:       ErrLeaseMismatch,
+}
+
+// Sentinel returns the sentinel error for the code, or nil for unknown codes.
+func (c ErrorCode) Sentinel() error {
+	return sentinelByCode[c]
+}
+
+// IsKnown reports whether the code is one the plugin is known to emit.
+func (c ErrorCode) IsKnown() bool {
+	_, ok := sentinelByCode[c]
+	return ok
+}
+
+// ErrorDetails exposes Error.details with typed accessors.
+type ErrorDetails map[string]string
+
+// Get returns the raw value for key.
+func (d ErrorDetails) Get(key string) (string, bool) {
+	v, ok := d[key]
+	return v, ok
+}
+
+// Int returns the value for key parsed as a base-10 integer.
+func (d ErrorDetails) Int(key string) (int64, bool) {
+	v, ok := d[key]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	return n, err == nil
+}
+
+// Bool returns the value for key parsed with strconv.ParseBool.
+func (d ErrorDetails) Bool(key string) (bool, bool) {
+	v, ok := d[key]
+	if !ok {
+		return false, false
+	}
+	b, err := strconv.ParseBool(strings.TrimSpace(v))
+	return b, err == nil
+}
+
+// Duration returns the value for key parsed with time.ParseDuration, or as
+// whole seconds when the value is a bare integer.
+func (d ErrorDetails) Duration(key string) (time.Duration, bool) {
+	v, ok := d[key]
+	if !ok {
+		return 0, false
+	}
+	v = strings.TrimSpace(v)
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	dur, err := time.ParseDuration(v)
+	return dur, err == nil
+}
+
+// Time returns the value for key parsed as RFC 3339.
+func (d ErrorDetails) Time(key string) (time.Time, bool) {
+	v, ok := d[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(v))
+	return ts, err == nil
+}
+
 // HTTPError returns status code plus structured error details when available.
 type HTTPError struct {
 	StatusCode int
@@ -55,6 +168,22 @@ func (e *HTTPError) Error() string {
 	return fmt.Sprintf("request failed: status=%d", e.StatusCode)
 }
 
+// Code returns the structured error code, or "" when the response had none.
+func (e *HTTPError) Code() ErrorCode {
+	return ErrorCode(e.ProtoError.GetCode())
+}
+
+// Details returns the structured error details.
+func (e *HTTPError) Details() ErrorDetails {
+	return e.ProtoError.GetDetails()
+}
+
+// Is reports whether target is the sentinel for this error's code.
+func (e *HTTPError) Is(target error) bool {
+	sentinel := e.Code().Sentinel()
+	return sentinel != nil && sentinel == target
+}
+
 // Category returns the error category for this HTTP error.
 func (e *HTTPError) Category() ErrorCategory {
 	switch {
@@ -86,3 +215,55 @@ func CategoryOf(err error) ErrorCategory {
 	}
 	return CategoryNetwork
 }
+
+// RunError is a run-level error reported in InvokeResponse.error or
+// RunStatusResponse.error.
+type RunError struct {
+	ProtoError *steprpcv1.Error
+}
+
+// RunErrorOf wraps a run-level error. It returns nil when pe is nil.
+func RunErrorOf(pe *steprpcv1.Error) error {
+	if pe == nil {
+		return nil
+	}
+	return &RunError{ProtoError: pe}
+}
+
+func (e *RunError) Error() string {
+	return fmt.Sprintf("run error: code=%s message=%s", e.ProtoError.GetCode(), e.ProtoError.GetMessage())
+}
+
+// Code returns the structured error code.
+func (e *RunError) Code() ErrorCode {
+	return ErrorCode(e.ProtoError.GetCode())
+}
+
+// Details returns the structured error details.
+func (e *RunError) Details() ErrorDetails {
+	return e.ProtoError.GetDetails()
+}
+
+// Is reports whether target is the sentinel for this error's code.
+func (e *RunError) Is(target error) bool {
+	sentinel := e.Code().Sentinel()
+	return sentinel != nil && sentinel == target
+}
+
+// RunFailedError is returned by WaitRunTerminal when PollPolicy.FailOnRunFailure
+// is set and the run ends in a state other than succeeded.
+type RunFailedError struct {
+	Status *steprpcv1.RunStatusResponse
+}
+
+func (e *RunFailedError) Error() string {
+	if pe := e.Status.GetError(); pe != nil {
+		return fmt.Sprintf("run %s %s: code=%s message=%s", e.Status.GetRunId(), e.Status.GetState(), pe.GetCode(), pe.GetMessage())
+	}
+	return fmt.Sprintf("run %s %s", e.Status.GetRunId(), e.Status.GetState())
+}
+
+// Unwrap returns the run-level error so errors.Is matches code sentinels.
+func (e *RunFailedError) Unwrap() error {
+	return RunErrorOf(e.Status.GetError())
+}
@@ -1,9 +1,11 @@
 package rpcclient
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
 )
@@ -115,3 +117,96 @@ func TestErrorCategory_String(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPError_Is(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("send: %w", &HTTPError{
+		StatusCode: http.StatusBadRequest,
+		ProtoError: &steprpcv1.Error{Code: "operation_not_allowed", Message: "denied"},
+	})
+	if !errors.Is(err, ErrOperationNotAllowed) {
+		t.Fatalf("errors.Is(err, ErrOperationNotAllowed) = false, want true")
+	}
+	if errors.Is(err, ErrBadRequest) {
+		t.Fatalf("errors.Is(err, ErrBadRequest) = true, want false")
+	}
+	if errors.Is(&HTTPError{StatusCode: http.StatusBadGateway}, ErrBadRequest) {
+		t.Fatalf("errors.Is(no proto error, ErrBadRequest) = true, want false")
+	}
+}
+
+func TestErrorCode_Sentinel(t *testing.T) {
+	t.Parallel()
+
+	codes := []ErrorCode{
+		CodeBadRequest, CodeBadJSON, CodeOperationNotAllowed, CodeOperationNotFound,
+		CodeOperationFailed, CodeRunNotFound, CodeNoPendingRequest, CodeBatchTooLarge,
+		CodeLeaseExpired, CodeLeaseMismatch,
+	}
+	for _, code := range codes {
+		if !code.IsKnown() {
+			t.Fatalf("%s.IsKnown() = false, want true", code)
+		}
+		if got := code.Sentinel(); got == nil || got.Error() != string(code) {
+			t.Fatalf("%s.Sentinel() = %v", code, got)
+		}
+	}
+	if ErrorCode("made_up").Sentinel() != nil {
+		t.Fatalf("unknown code sentinel = non-nil, want nil")
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	d := (&HTTPError{ProtoError: &steprpcv1.Error{Details: map[string]string{
+		"retryAfter": "30",
+		"timeout":    "1m30s",
+		"attempt":    "3",
+		"transient":  "true",
+		"at":         "2026-01-02T03:04:05Z",
+		"operation":  "junit",
+	}}}).Details()
+
+	if v, ok := d.Get("operation"); !ok || v != operationJunit {
+		t.Fatalf("Get(operation) = %q, %v", v, ok)
+	}
+	if v, ok := d.Int("attempt"); !ok || v != 3 {
+		t.Fatalf("Int(attempt) = %d, %v", v, ok)
+	}
+	if v, ok := d.Bool("transient"); !ok || !v {
+		t.Fatalf("Bool(transient) = %v, %v", v, ok)
+	}
+	if v, ok := d.Duration("retryAfter"); !ok || v != 30*time.Second {
+		t.Fatalf("Duration(retryAfter) = %v, %v", v, ok)
+	}
+	if v, ok := d.Duration("timeout"); !ok || v != 90*time.Second {
+		t.Fatalf("Duration(timeout) = %v, %v", v, ok)
+	}
+	if v, ok := d.Time("at"); !ok || v.Year() != 2026 {
+		t.Fatalf("Time(at) = %v, %v", v, ok)
+	}
+	if _, ok := d.Int("operation"); ok {
+		t.Fatalf("Int(operation) ok = true, want false")
+	}
+	if _, ok := d.Get("missing"); ok {
+		t.Fatalf("Get(missing) ok = true, want false")
+	}
+}
+
+func TestRunError(t *testing.T) {
+	t.Parallel()
+
+	if RunErrorOf(nil) != nil {
+		t.Fatalf("RunErrorOf(nil) = non-nil, want nil")
+	}
+	err := RunErrorOf(&steprpcv1.Error{Code: "operation_failed", Message: "boom"})
+	if !errors.Is(err, ErrOperationFailed) {
+		t.Fatalf("errors.Is(err, ErrOperationFailed) = false, want true")
+	}
+	var runErr *RunError
+	if !errors.As(err, &runErr) || runErr.Code() != CodeOperationFailed {
+		t.Fatalf("errors.As(*RunError) = %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package rpcclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// runContextArgKey is the args key the plugin reads target run metadata from.
+const runContextArgKey = "runContext"
+
+// undiscoveredOperationDescription is the catalog description the plugin's
+// OperationRegistry assigns to allowlisted operations it could not discover.
+const undiscoveredOperationDescription = "Allowlisted operation not discovered on this controller"
+
+var (
+	// ErrOperationNotInCatalog is returned by Execute when the operation is not listed in the catalog.
+	ErrOperationNotInCatalog = errors.New("operation not in catalog")
+
+	// ErrOperationUndiscovered is returned by Execute when the operation is allowlisted
+	// but was not discovered on the controller, so its execution lane is unknown.
+	ErrOperationUndiscovered = errors.New("operation allowlisted but not discovered on controller")
+
+	// ErrRunContextRequired is returned by Execute when a CPS bridge operation is
+	// called without args.runContext.
+	ErrRunContextRequired = errors.New("args.runContext is required for CPS bridge operations")
+)
+
+// defaultBridgePollPolicy waits for a bridge worker to pick up and complete a
+// queued CPS request. Bridge workers poll from inside a running build, so
+// latency is measured in seconds rather than milliseconds.
+var defaultBridgePollPolicy = PollPolicy{
+	InitialInterval: time.Second,
+	MaxInterval:     10 * time.Second, //nolint:mnd // bridge poll ceiling
+	MaxDuration:     30 * time.Minute, //nolint:mnd // bridge wait ceiling
+}
+
+// Timings records where time was spent during Execute.
+type Timings struct {
+	// Invoke is the duration of the invoke round trip.
+	Invoke time.Duration
+	// Wait is the time spent polling for a terminal state after invoke.
+	Wait time.Duration
+	// Total is the end-to-end duration, including the catalog lookup.
+	Total time.Duration
+}
+
+// Result is the unified outcome of Execute across both execution lanes.
+type Result struct {
+	RequestID string
+	RunID     string
+	Operation string
+	State     string
+	// Error is the run-level error reported by the plugin, if any.
+	Error *steprpcv1.Error
+	// Lane is the execution lane the operation was routed through.
+	Lane    steprpcv1.OperationExecutionMode
+	Timings Timings
+}
+
+// Succeeded reports whether the run reached the succeeded state.
+func (r *Result) Succeeded() bool {
+	return r != nil && r.State == "succeeded"
+}
+
+// WithBridgePollPolicy returns a copy of the client that uses p when Execute
+// waits for CPS bridge operations.
+func (c *Client) WithBridgePollPolicy(p PollPolicy) *Client {
+	cp := *c
+	cp.bridgePollPolicy = &p
+	return &cp
+}
+
+// Execute invokes an operation and waits for its terminal state, routing by the
+// execution mode advertised in the catalog.
+//
+// Direct operations are invoked and, if the plugin does not answer with a
+// terminal state, polled with the default poll policy. CPS bridge operations
+// require args.runContext (or a client RunContext) and are polled with a policy tuned to bridge latency.
+// A failed run is reported through Result.State and Result.Error, not as an error.
+func (c *Client) Execute(ctx context.Context, op string, args *structpb.Struct) (*Result, error) {
+	start := time.Now()
+
+	catalog, err := c.GetCatalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("execute %s: %w", op, err)
+	}
+	lane, err := executionLane(catalog, op)
+	if err != nil {
+		return nil, fmt.Errorf("execute %s: %w", op, err)
+	}
+
+	policy := PollPolicy{}
+	if lane == steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED {
+		if _, ok := args.GetFields()[runContextArgKey]; !ok && c.runContext == nil {
+			return nil, fmt.Errorf("execute %s: %w", op, ErrRunContextRequired)
+		}
+		policy = defaultBridgePollPolicy
+		if c.bridgePollPolicy != nil {
+			policy = *c.bridgePollPolicy
+		}
+	}
+
+	requestID, err := newRequestID()
+	if err != nil {
+		return nil, fmt.Errorf("execute %s: %w", op, err)
+	}
+
+	invokeStart := time.Now()
+	resp, err := c.invoke(ctx, &steprpcv1.InvokeRequest{
+		RequestId: requestID,
+		Operation: op,
+		Args:      args,
+	}, lane)
+	if err != nil {
+		return nil, fmt.Errorf("execute %s: %w", op, err)
+	}
+
+	result := &Result{
+		RequestID: resp.GetRequestId(),
+		RunID:     resp.GetRunId(),
+		Operation: op,
+		State:     stateName(resp),
+		Error:     resp.GetError(),
+		Lane:      lane,
+	}
+	result.Timings.Invoke = time.Since(invokeStart)
+
+	if !RunStateOf(resp).IsTerminal() {
+		waitStart := time.Now()
+		status, waitErr := c.WaitRunTerminal(ctx, resp.GetRunId(), policy)
+		result.Timings.Wait = time.Since(waitStart)
+		if waitErr != nil {
+			result.Timings.Total = time.Since(start)
+			return result, fmt.Errorf("execute %s: %w", op, waitErr)
+		}
+		result.State = stateName(status)
+		result.Error = status.GetError()
+	}
+
+	result.Timings.Total = time.Since(start)
+	return result, nil
+}
+
+// executionLane resolves the execution mode for op from the catalog.
+func executionLane(catalog *steprpcv1.CatalogResponse, op string) (steprpcv1.OperationExecutionMode, error) {
+	for _, entry := range catalog.GetOperations() {
+		if entry.GetName() != op {
+			continue
+		}
+		if entry.GetDescription() == undiscoveredOperationDescription {
+			return steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED, ErrOperationUndiscovered
+		}
+		switch entry.GetExecutionMode() {
+		case steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT,
+			steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED:
+			return entry.GetExecutionMode(), nil
+		default:
+			return steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED,
+				fmt.Errorf("unsupported execution mode %s", entry.GetExecutionMode())
+		}
+	}
+	return steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED, ErrOperationNotInCatalog
+}
+
+func newRequestID() (string, error) {
+	var buf [12]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", fmt.Errorf("generate request id: %w", err)
+	}
+	return "req-" + hex.EncodeToString(buf[:]), nil
+}
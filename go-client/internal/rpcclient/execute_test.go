@@ -0,0 +1,153 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const executeCatalog = `{"operations":[` +
+	`{"name":"archiveArtifacts","description":"Archive (direct)","executionMode":"OPERATION_EXECUTION_MODE_DIRECT"},` +
+	`{"name":"junit","description":"Publish (CPS context required)","executionMode":"OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"},` +
+	`{"name":"ghost","description":"Allowlisted operation not discovered on this controller","executionMode":"OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"}]}`
+
+func executeServer(t *testing.T, invokeState string, statusCalls *int32) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/step-rpc/v1/catalog", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(executeCatalog))
+	})
+	mux.HandleFunc("/step-rpc/v1/invoke", func(w http.ResponseWriter, r *http.Request) {
+		var in steprpcv1.InvokeRequest
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), &in); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if in.GetRequestId() == "" {
+			t.Fatalf("requestId is empty")
+		}
+		out, _ := protojson.Marshal(&steprpcv1.InvokeResponse{
+			RequestId: in.GetRequestId(),
+			RunId:     "rpc-1",
+			State:     invokeState,
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	})
+	mux.HandleFunc("/step-rpc/v1/runs/", func(w http.ResponseWriter, _ *http.Request) {
+		call := atomic.AddInt32(statusCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		if call < 2 {
+			_, _ = w.Write([]byte(`{"runId":"rpc-1","operation":"junit","state":"queued"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"runId":"rpc-1","operation":"junit","state":"failed","error":{"code":"operation_failed","message":"boom"}}`))
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestExecute_Direct(t *testing.T) {
+	t.Parallel()
+
+	var statusCalls int32
+	ts := executeServer(t, stateSucceeded, &statusCalls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	res, err := c.Execute(context.Background(), "archiveArtifacts", nil)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !res.Succeeded() {
+		t.Fatalf("state = %s, want %s", res.State, stateSucceeded)
+	}
+	if res.Lane != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT {
+		t.Fatalf("lane = %v, want DIRECT", res.Lane)
+	}
+	if got := atomic.LoadInt32(&statusCalls); got != 0 {
+		t.Fatalf("status calls = %d, want 0", got)
+	}
+	if res.Timings.Total < res.Timings.Invoke {
+		t.Fatalf("timings = %+v, total < invoke", res.Timings)
+	}
+}
+
+func TestExecute_CPSBridgeWaitsForTerminal(t *testing.T) {
+	t.Parallel()
+
+	var statusCalls int32
+	ts := executeServer(t, "queued", &statusCalls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithBridgePollPolicy(PollPolicy{InitialInterval: time.Millisecond})
+
+	args, err := structpb.NewStruct(map[string]any{
+		"testResults": "**/*.xml",
+		"runContext":  map[string]any{"runExternalizableId": "job/demo#1"},
+	})
+	if err != nil {
+		t.Fatalf("NewStruct() error = %v", err)
+	}
+
+	res, err := c.Execute(context.Background(), operationJunit, args)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if res.State != "failed" {
+		t.Fatalf("state = %s, want failed", res.State)
+	}
+	if res.Error.GetCode() != "operation_failed" {
+		t.Fatalf("error code = %s, want operation_failed", res.Error.GetCode())
+	}
+	if res.Lane != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED {
+		t.Fatalf("lane = %v, want CPS_BRIDGE_REQUIRED", res.Lane)
+	}
+	if got := atomic.LoadInt32(&statusCalls); got != 2 {
+		t.Fatalf("status calls = %d, want 2", got)
+	}
+}
+
+func TestExecute_RoutingErrors(t *testing.T) {
+	t.Parallel()
+
+	var statusCalls int32
+	ts := executeServer(t, "queued", &statusCalls)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		op   string
+		want error
+	}{
+		{"missing runContext", operationJunit, ErrRunContextRequired},
+		{"undiscovered", "ghost", ErrOperationUndiscovered},
+		{"not in catalog", "unknown", ErrOperationNotInCatalog},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := c.Execute(context.Background(), tt.op, nil)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -11,4 +11,8 @@ type DebugHook struct {
 	// OnResponse is called after the HTTP response is received.
 	// resp may be nil if the request failed at the transport level.
 	OnResponse func(resp *http.Response, body []byte, err error)
+
+	// OnIllegalTransition is called when a run is observed moving between
+	// states the RunState machine does not allow, such as succeeded to running.
+	OnIllegalTransition func(runID string, from, to RunState)
 }
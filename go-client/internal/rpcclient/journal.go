@@ -0,0 +1,339 @@
+package rpcclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrJournalRequired is returned by Resume when the client has no journal.
+var ErrJournalRequired = errors.New("journal is required")
+
+// JournalEntryKind identifies the step of an invocation a JournalEntry records.
+type JournalEntryKind string
+
+const (
+	// JournalIntent is written before an invoke request is sent.
+	JournalIntent JournalEntryKind = "intent"
+	// JournalAccepted is written when the plugin returns a run ID.
+	JournalAccepted JournalEntryKind = "accepted"
+	// JournalTerminal is written when WaitRunTerminal observes a terminal state.
+	JournalTerminal JournalEntryKind = "terminal"
+	// JournalRejected is written when the plugin refuses an invoke with an
+	// error that sending it again cannot fix, such as a 400 or 403.
+	JournalRejected JournalEntryKind = "rejected"
+)
+
+// JournalEntry is one durable record of invocation progress.
+type JournalEntry struct {
+	Kind      JournalEntryKind `json:"kind"`
+	RequestID string           `json:"requestId"`
+	Operation string           `json:"operation,omitempty"`
+	// Request is the protojson InvokeRequest exactly as sent, so Resume can
+	// re-send it with the same request ID. Set on intent entries only.
+	Request json.RawMessage `json:"request,omitempty"`
+	RunID   string          `json:"runId,omitempty"`
+	State   string          `json:"state,omitempty"`
+	// Error is the refusal a rejected entry records.
+	Error string    `json:"error,omitempty"`
+	Time  time.Time `json:"time"`
+}
+
+// Journal durably records invocation progress so Resume can recover in-flight
+// work after a process restart. Implementations must be safe for concurrent use
+// and must not return from Append before the entry is durable.
+type Journal interface {
+	Append(entry JournalEntry) error
+	Entries() ([]JournalEntry, error)
+}
+
+// FileJournal is a Journal backed by an append-only JSON lines file.
+//
+// Intent entries hold the full invoke arguments, unredacted, because Resume must
+// be able to re-send them. The file is created with owner-only permissions.
+type FileJournal struct {
+	path string
+
+	mu sync.Mutex
+	f  *os.File
+}
+
+// OpenFileJournal opens or creates the journal file at path. A truncated final
+// line, left by a crash mid-write, is dropped so new entries start on a fresh line.
+func OpenFileJournal(path string) (*FileJournal, error) {
+	if err := trimPartialLine(path); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // journal path is chosen by the caller
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	return &FileJournal{path: path, f: f}, nil
+}
+
+func trimPartialLine(path string) error {
+	data, err := os.ReadFile(path) //nolint:gosec // journal path is chosen by the caller
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read journal: %w", err)
+	}
+	if len(data) == 0 || data[len(data)-1] == '\n' {
+		return nil
+	}
+	if err := os.Truncate(path, int64(bytes.LastIndexByte(data, '\n')+1)); err != nil {
+		return fmt.Errorf("truncate journal: %w", err)
+	}
+	return nil
+}
+
+// Append writes entry and syncs the file.
+func (j *FileJournal) Append(entry JournalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encode journal entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.f == nil {
+		return fmt.Errorf("journal %s is closed", j.path)
+	}
+	if _, err := j.f.Write(line); err != nil {
+		return fmt.Errorf("write journal: %w", err)
+	}
+	if err := j.f.Sync(); err != nil {
+		return fmt.Errorf("sync journal: %w", err)
+	}
+	return nil
+}
+
+// Entries reads every entry in the file. A truncated final line is ignored.
+func (j *FileJournal) Entries() ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	data, err := os.ReadFile(j.path) //nolint:gosec // journal path is chosen by the caller
+	if err != nil {
+		return nil, fmt.Errorf("read journal: %w", err)
+	}
+	lines := bytes.Split(data, []byte("\n"))
+	var entries []JournalEntry
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			if i == len(lines)-1 {
+				break
+			}
+			return nil, fmt.Errorf("decode journal %s line %d: %w", j.path, i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Close closes the journal file.
+func (j *FileJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.f == nil {
+		return nil
+	}
+	err := j.f.Close()
+	j.f = nil
+	return err
+}
+
+// WithJournal returns a copy of the client that records every Invoke intent,
+// accepted run ID or permanent rejection, and terminal state observed by
+// WaitRunTerminal in j.
+// Invoke requires a request ID while a journal is set.
+func (c *Client) WithJournal(j Journal) *Client {
+	cp := *c
+	cp.journal = j
+	return &cp
+}
+
+// ResumeResult is the outcome of one invocation recovered by Resume.
+type ResumeResult struct {
+	RequestID string
+	RunID     string
+	Operation string
+	// Resent is true when the intent had no recorded response and was sent again.
+	Resent bool
+	Status *steprpcv1.RunStatusResponse
+	Err    error
+}
+
+// Resume recovers invocations that the journal shows as unfinished. Intents
+// that never got a response are re-sent with their original request ID, which
+// the plugin treats as an idempotency key; a re-sent intent the plugin rejects
+// is journaled as rejected and not sent again. Accepted runs that are not terminal
+// are waited on with policy. Results are returned in journal order; the error
+// joins every per-invocation error.
+func (c *Client) Resume(ctx context.Context, policy PollPolicy) ([]ResumeResult, error) {
+	if c.journal == nil {
+		return nil, ErrJournalRequired
+	}
+	entries, err := c.journal.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := unfinishedInvocations(entries)
+	results := make([]ResumeResult, len(pending))
+	var wg sync.WaitGroup
+	for i, inv := range pending {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.resumeOne(ctx, inv, policy)
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("resume %s: %w", r.RequestID, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (c *Client) resumeOne(ctx context.Context, inv journaledInvocation, policy PollPolicy) ResumeResult {
+	res := ResumeResult{RequestID: inv.requestID, RunID: inv.runID, Operation: inv.operation}
+
+	if inv.runID == "" {
+		req := &steprpcv1.InvokeRequest{}
+		if err := protojson.Unmarshal(inv.request, req); err != nil {
+			res.Err = fmt.Errorf("decode journaled request: %w", err)
+			return res
+		}
+		resp, err := c.Invoke(ctx, req)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		res.Resent = true
+		res.RunID = resp.GetRunId()
+		if RunStateOf(resp).IsTerminal() {
+			res.Status = &steprpcv1.RunStatusResponse{
+				RequestId: resp.GetRequestId(),
+				RunId:     resp.GetRunId(),
+				Operation: inv.operation,
+				State:     stateName(resp),
+				RunState:  resp.GetRunState(),
+				Error:     resp.GetError(),
+			}
+			return res
+		}
+	}
+
+	res.Status, res.Err = c.WaitRunTerminal(ctx, res.RunID, policy)
+	return res
+}
+
+type journaledInvocation struct {
+	requestID string
+	operation string
+	request   []byte
+	runID     string
+	state     string
+	rejected  bool
+}
+
+// unfinishedInvocations folds journal entries by request ID and returns the
+// invocations that were neither rejected nor reached a terminal state, ordered
+// by first intent.
+func unfinishedInvocations(entries []JournalEntry) []journaledInvocation {
+	byID := make(map[string]*journaledInvocation)
+	var order []string
+	for _, e := range entries {
+		inv, ok := byID[e.RequestID]
+		if !ok {
+			inv = &journaledInvocation{requestID: e.RequestID}
+			byID[e.RequestID] = inv
+			order = append(order, e.RequestID)
+		}
+		if e.Operation != "" {
+			inv.operation = e.Operation
+		}
+		switch e.Kind {
+		case JournalIntent:
+			inv.request = e.Request
+		case JournalAccepted, JournalTerminal:
+			inv.runID = e.RunID
+			inv.state = e.State
+		case JournalRejected:
+			inv.rejected = true
+		}
+	}
+
+	var out []journaledInvocation
+	for _, id := range order {
+		inv := byID[id]
+		if inv.rejected || IsTerminalState(inv.state) {
+			continue
+		}
+		if inv.runID == "" && len(inv.request) == 0 {
+			continue
+		}
+		out = append(out, *inv)
+	}
+	return out
+}
+
+// journalRejected records that the plugin refused req with err when sending it
+// again cannot succeed, so Resume leaves it alone. It returns err, joined with
+// any journal failure.
+func (c *Client) journalRejected(req *steprpcv1.InvokeRequest, err error) error {
+	if c.journal == nil || !invokeRejected(err) {
+		return err
+	}
+	entry := JournalEntry{Kind: JournalRejected, RequestID: req.GetRequestId(), Operation: req.GetOperation(), Error: err.Error()}
+	if jerr := c.journalAppend(entry); jerr != nil {
+		return errors.Join(err, jerr)
+	}
+	return err
+}
+
+// invokeRejected reports whether err is a 4xx the same invoke will get again.
+// 401 is left out because credentials may be fixed before the next Resume, and
+// 408 and 429 because they clear by themselves.
+func invokeRejected(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	switch httpErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return httpErr.StatusCode >= http.StatusBadRequest && httpErr.StatusCode < http.StatusInternalServerError
+}
+
+func (c *Client) journalAppend(entry JournalEntry) error {
+	if c.journal == nil {
+		return nil
+	}
+	entry.Time = time.Now().UTC()
+	if err := c.journal.Append(entry); err != nil {
+		return fmt.Errorf("journal %s %s: %w", entry.Kind, entry.RequestID, err)
+	}
+	return nil
+}
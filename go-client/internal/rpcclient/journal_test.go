@@ -0,0 +1,327 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// dedupServer mimics the plugin's requestId idempotency: a repeated requestId
+// returns the run created by the first invoke. Runs report queued on their
+// first status poll and succeeded afterwards. Operation "denied" is refused
+// with a 403 and "unauthorized" with a 401.
+type dedupServer struct {
+	mu       sync.Mutex
+	runs     map[string]string // requestId -> runId
+	polls    map[string]int
+	invokes  int
+	requests []string
+}
+
+func newDedupServer(t *testing.T) (*httptest.Server, *dedupServer) {
+	t.Helper()
+	d := &dedupServer{runs: map[string]string{}, polls: map[string]int{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/step-rpc/v1/invoke", func(w http.ResponseWriter, r *http.Request) {
+		var in steprpcv1.InvokeRequest
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), &in); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+			return
+		}
+		d.mu.Lock()
+		d.requests = append(d.requests, in.GetRequestId())
+		switch in.GetOperation() {
+		case "denied", "unauthorized":
+			d.mu.Unlock()
+			status := http.StatusForbidden
+			if in.GetOperation() == "unauthorized" {
+				status = http.StatusUnauthorized
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"error":{"code":"forbidden","message":"not allowed"}}`))
+			return
+		}
+		runID, ok := d.runs[in.GetRequestId()]
+		if !ok {
+			d.invokes++
+			runID = "rpc-" + in.GetRequestId()
+			d.runs[in.GetRequestId()] = runID
+		}
+		d.mu.Unlock()
+		out, _ := protojson.Marshal(&steprpcv1.InvokeResponse{RequestId: in.GetRequestId(), RunId: runID, State: "queued"})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	})
+	mux.HandleFunc("/step-rpc/v1/runs/", func(w http.ResponseWriter, r *http.Request) {
+		runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+		d.mu.Lock()
+		d.polls[runID]++
+		state := "queued"
+		if d.polls[runID] > 1 {
+			state = stateSucceeded
+		}
+		d.mu.Unlock()
+		out, _ := protojson.Marshal(&steprpcv1.RunStatusResponse{
+			RequestId: strings.TrimPrefix(runID, "rpc-"),
+			RunId:     runID,
+			Operation: "junit",
+			State:     state,
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts, d
+}
+
+func openTestJournal(t *testing.T, path string) *FileJournal {
+	t.Helper()
+	j, err := OpenFileJournal(path)
+	if err != nil {
+		t.Fatalf("OpenFileJournal() error = %v", err)
+	}
+	t.Cleanup(func() { _ = j.Close() })
+	return j
+}
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+
+	ts, server := newDedupServer(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	ctx := context.Background()
+	fastPoll := PollPolicy{InitialInterval: time.Millisecond}
+
+	// First process: req-done finishes, req-waiting is accepted but never
+	// waited on, and req-unsent crashes between journaling and sending.
+	before, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	journal := openTestJournal(t, path)
+	before = before.WithJournal(journal)
+
+	done, err := before.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-done", Operation: "junit"})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if _, err := before.WaitRunTerminal(ctx, done.GetRunId(), fastPoll); err != nil {
+		t.Fatalf("WaitRunTerminal() error = %v", err)
+	}
+	if _, err := before.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-waiting", Operation: "junit"}); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	unsent := JournalEntry{Kind: JournalIntent, RequestID: "req-unsent", Operation: "junit", Request: []byte(`{"requestId":"req-unsent","operation":"junit"}`)}
+	if err := journal.Append(unsent); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := journal.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Second process resumes from the same file.
+	after, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	after = after.WithJournal(openTestJournal(t, path))
+
+	results, err := after.Resume(ctx, fastPoll)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("results = %+v, want 2", results)
+	}
+	waiting, resent := results[0], results[1]
+	if waiting.RequestID != "req-waiting" || waiting.Resent || waiting.Status.GetState() != stateSucceeded {
+		t.Fatalf("waiting = %+v, want req-waiting re-attached and succeeded", waiting)
+	}
+	if resent.RequestID != "req-unsent" || !resent.Resent || resent.RunID != "rpc-req-unsent" || resent.Status.GetState() != stateSucceeded {
+		t.Fatalf("resent = %+v, want req-unsent re-sent and succeeded", resent)
+	}
+
+	server.mu.Lock()
+	invokes := server.invokes
+	server.mu.Unlock()
+	if invokes != 3 {
+		t.Fatalf("invokes = %d, want 3", invokes)
+	}
+
+	// Everything is terminal now, so a second resume has nothing to do.
+	again, err := after.Resume(ctx, fastPoll)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if len(again) != 0 {
+		t.Fatalf("second Resume() = %+v, want none", again)
+	}
+}
+
+func TestResume_ResendsSameRequestID(t *testing.T) {
+	t.Parallel()
+
+	ts, server := newDedupServer(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	journal := openTestJournal(t, path)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithJournal(journal)
+
+	// The invoke reached the server but the process died before journaling
+	// the response: the intent is the last entry.
+	if _, err := c.Invoke(context.Background(), &steprpcv1.InvokeRequest{RequestId: "req-1", Operation: "junit"}); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	fresh := filepath.Join(t.TempDir(), "journal.jsonl")
+	replay := openTestJournal(t, fresh)
+	if err := replay.Append(entries[0]); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	results, err := c.WithJournal(replay).Resume(context.Background(), PollPolicy{InitialInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if len(results) != 1 || results[0].RunID != "rpc-req-1" {
+		t.Fatalf("results = %+v, want rpc-req-1", results)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.invokes != 1 {
+		t.Fatalf("invokes = %d, want 1 (deduplicated)", server.invokes)
+	}
+	if len(server.requests) != 2 || server.requests[1] != "req-1" {
+		t.Fatalf("requests = %v, want req-1 re-sent", server.requests)
+	}
+}
+
+func TestResume_SkipsRejectedInvocations(t *testing.T) {
+	t.Parallel()
+
+	ts, server := newDedupServer(t)
+	journal := openTestJournal(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithJournal(journal)
+	ctx := context.Background()
+
+	// A live invoke that is refused is journaled as rejected.
+	if _, err := c.Invoke(ctx, &steprpcv1.InvokeRequest{RequestId: "req-denied", Operation: "denied"}); err == nil {
+		t.Fatalf("Invoke() error = nil, want 403")
+	}
+	// An intent left by a crash is re-sent once, refused, and then journaled.
+	for _, id := range []string{"req-crashed", "req-unauthorized"} {
+		op := "denied"
+		if id == "req-unauthorized" {
+			op = "unauthorized"
+		}
+		intent := JournalEntry{Kind: JournalIntent, RequestID: id, Operation: op, Request: []byte(`{"requestId":"` + id + `","operation":"` + op + `"}`)}
+		if err := journal.Append(intent); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	results, err := c.Resume(ctx, PollPolicy{InitialInterval: time.Millisecond})
+	if err == nil || len(results) != 2 || results[0].RequestID != "req-crashed" || results[0].Err == nil {
+		t.Fatalf("Resume() = %+v, %v; want req-crashed re-sent and refused", results, err)
+	}
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	var rejected []string
+	for _, e := range entries {
+		if e.Kind == JournalRejected {
+			rejected = append(rejected, e.RequestID)
+			if !strings.Contains(e.Error, "forbidden") {
+				t.Fatalf("rejected entry error = %q", e.Error)
+			}
+		}
+	}
+	if len(rejected) != 2 || rejected[0] != "req-denied" || rejected[1] != "req-crashed" {
+		t.Fatalf("rejected entries = %v, want req-denied and req-crashed", rejected)
+	}
+
+	// Only the 401, which new credentials may fix, is tried again.
+	again, err := c.Resume(ctx, PollPolicy{InitialInterval: time.Millisecond})
+	if err == nil || len(again) != 1 || again[0].RequestID != "req-unauthorized" {
+		t.Fatalf("second Resume() = %+v, %v; want only req-unauthorized", again, err)
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.requests) != 4 {
+		t.Fatalf("requests = %v, want rejected invocations sent once", server.requests)
+	}
+}
+
+func TestResume_RequiresJournal(t *testing.T) {
+	t.Parallel()
+
+	c, err := New("http://example.invalid", "", nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := c.Resume(context.Background(), PollPolicy{}); !errors.Is(err, ErrJournalRequired) {
+		t.Fatalf("Resume() error = %v, want ErrJournalRequired", err)
+	}
+}
+
+func TestInvoke_JournalRequiresRequestID(t *testing.T) {
+	t.Parallel()
+
+	c, err := New("http://example.invalid", "", nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithJournal(openTestJournal(t, filepath.Join(t.TempDir(), "journal.jsonl")))
+	if _, err := c.Invoke(context.Background(), &steprpcv1.InvokeRequest{Operation: "junit"}); err == nil {
+		t.Fatalf("Invoke() error = nil, want requestID required")
+	}
+}
+
+func TestFileJournal_IgnoresTruncatedTail(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	data := `{"kind":"intent","requestId":"req-1","time":"2026-01-01T00:00:00Z"}` + "\n" + `{"kind":"accep`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	entries, err := openTestJournal(t, path).Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].RequestID != "req-1" {
+		t.Fatalf("entries = %+v, want only req-1", entries)
+	}
+
+	corrupt := filepath.Join(t.TempDir(), "corrupt.jsonl")
+	if err := os.WriteFile(corrupt, []byte("{not json\n"+data), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := openTestJournal(t, corrupt).Entries(); err == nil {
+		t.Fatalf("Entries() error = nil, want decode error for corrupt line")
+	}
+}
@@ -0,0 +1,245 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DefaultBridgeLeaseTTL is the lease length servers grant when a claim or
+// heartbeat asks for none, and the one KeepBridgeLease assumes for ttl <= 0.
+const DefaultBridgeLeaseTTL = 60 * time.Second
+
+// BridgeHandler executes one claimed bridge request and returns the result to
+// complete it with, or nil for none. ctx carries the lease, so ReportBridgeProgress
+// called with it needs no LeaseId, and is cancelled when the lease is lost; the
+// handler should stop, since the request will be redelivered to another worker.
+type BridgeHandler func(ctx context.Context, req *steprpcv1.BridgePendingResponse) (map[string]any, error)
+
+// BridgeLease identifies a lease held on a claimed bridge request.
+type BridgeLease struct {
+	RunID   string
+	LeaseID string
+}
+
+type bridgeLeaseKey struct{}
+
+// BridgeLeaseFromContext returns the lease a context from KeepBridgeLease, or
+// one derived from it, runs under.
+func BridgeLeaseFromContext(ctx context.Context) (BridgeLease, bool) {
+	lease, ok := ctx.Value(bridgeLeaseKey{}).(BridgeLease)
+	return lease, ok
+}
+
+// ClaimBridgeRequest leases the next pending CPS bridge request for a run to
+// this worker for ttl, or the server default when ttl <= 0. Nothing else is
+// handed the request while the lease is live; if it expires without a
+// heartbeat the server redelivers the request under a new lease. When nothing
+// is pending the error matches ErrNoPendingRequest.
+//
+// A claim retried after a lost response leaves the first lease to expire, so
+// the request is delivered again after ttl rather than lost.
+func (c *Client) ClaimBridgeRequest(ctx context.Context, runExternalizableID string, ttl time.Duration) (*steprpcv1.BridgeClaimResponse, error) {
+	if strings.TrimSpace(runExternalizableID) == "" {
+		return nil, fmt.Errorf("runExternalizableID is required")
+	}
+	if err := c.requireCapability(CapabilityBridgeLeases); err != nil {
+		return nil, err
+	}
+
+	payload, err := protojson.Marshal(&steprpcv1.BridgeClaimRequest{
+		RunExternalizableId: runExternalizableID,
+		WorkerId:            c.bridgeWorkerID,
+		LeaseSeconds:        wholeSeconds(ttl),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal bridge claim request: %w", err)
+	}
+	body, err := c.postJSON(ctx, "/step-rpc/v1/bridge/claim", payload)
+	if err != nil {
+		return nil, fmt.Errorf("send bridge claim request: %w", err)
+	}
+	out := &steprpcv1.BridgeClaimResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("decode bridge claim response: %w", err)
+	}
+	if out.GetLeaseId() == "" || out.GetRequest().GetRunId() == "" {
+		return nil, fmt.Errorf("decode bridge claim response: missing lease or request")
+	}
+	return out, nil
+}
+
+// HeartbeatBridgeLease extends a live lease to ttl from now, or the server
+// default when ttl <= 0. An expired lease fails with ErrLeaseExpired and one
+// superseded by a redelivery with ErrLeaseMismatch.
+func (c *Client) HeartbeatBridgeLease(ctx context.Context, runID, leaseID string, ttl time.Duration) (*steprpcv1.BridgeHeartbeatResponse, error) {
+	if strings.TrimSpace(runID) == "" {
+		return nil, fmt.Errorf("runID is required")
+	}
+	if strings.TrimSpace(leaseID) == "" {
+		return nil, fmt.Errorf("leaseID is required")
+	}
+	if err := c.requireCapability(CapabilityBridgeLeases); err != nil {
+		return nil, err
+	}
+
+	payload, err := protojson.Marshal(&steprpcv1.BridgeHeartbeatRequest{
+		RunId:        runID,
+		LeaseId:      leaseID,
+		LeaseSeconds: wholeSeconds(ttl),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal bridge heartbeat request: %w", err)
+	}
+	body, err := c.postJSON(ctx, "/step-rpc/v1/bridge/heartbeat", payload)
+	if err != nil {
+		return nil, fmt.Errorf("send bridge heartbeat request: %w", err)
+	}
+	out := &steprpcv1.BridgeHeartbeatResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("decode bridge heartbeat response: %w", err)
+	}
+	return out, nil
+}
+
+// KeepBridgeLease heartbeats claim's lease in a background goroutine until stop
+// is called or ctx ends. Each heartbeat asks for ttl and is sent a third of the
+// way to the expiry the server last granted, which may be sooner than ttl when
+// the server caps leases. The returned context is
+// cancelled when the lease is lost: the server rejected a heartbeat as expired,
+// mismatched, or for a run it no longer knows, or heartbeats kept failing
+// until the lease's expiry passed. context.Cause then reports why, matching
+// ErrLeaseExpired, ErrLeaseMismatch, or ErrRunNotFound. It also carries the
+// lease for BridgeLeaseFromContext.
+//
+// stop waits for the goroutine, so no heartbeat is in flight once it returns.
+func (c *Client) KeepBridgeLease(ctx context.Context, claim *steprpcv1.BridgeClaimResponse, ttl time.Duration) (leaseCtx context.Context, stop func()) {
+	if ttl <= 0 {
+		ttl = DefaultBridgeLeaseTTL
+	}
+	runID := claim.GetRequest().GetRunId()
+	leaseID := claim.GetLeaseId()
+	expires := time.Now().Add(ttl)
+	if claim.GetLeaseExpiresAt() != nil {
+		expires = claim.GetLeaseExpiresAt().AsTime()
+	}
+
+	leaseCtx, cancel := context.WithCancelCause(context.WithValue(ctx, bridgeLeaseKey{}, BridgeLease{RunID: runID, LeaseID: leaseID}))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		timer := time.NewTimer(heartbeatDelay(expires))
+		defer timer.Stop()
+		for {
+			select {
+			case <-leaseCtx.Done():
+				return
+			case <-timer.C:
+			}
+
+			out, err := c.HeartbeatBridgeLease(leaseCtx, runID, leaseID, ttl)
+			switch {
+			case err == nil:
+				expires = time.Now().Add(ttl)
+				if out.GetLeaseExpiresAt() != nil {
+					expires = out.GetLeaseExpiresAt().AsTime()
+				}
+			case leaseCtx.Err() != nil:
+				return
+			case leaseLost(err):
+				cancel(fmt.Errorf("bridge lease %s on run %s lost: %w", leaseID, runID, err))
+				return
+			case !time.Now().Before(expires):
+				cancel(fmt.Errorf("bridge lease %s on run %s lost: %w: last heartbeat: %w", leaseID, runID, ErrLeaseExpired, err))
+				return
+			}
+			timer.Reset(heartbeatDelay(expires))
+		}
+	}()
+
+	return leaseCtx, func() {
+		cancel(context.Canceled)
+		<-done
+	}
+}
+
+// ServeBridgeRequest claims the next pending request for a run, runs handler
+// under the lease while KeepBridgeLease heartbeats it, and completes the
+// request with the lease: succeeded with handler's result when it returns no
+// error, failed otherwise. A handler error carrying a *RunError is reported
+// with its code; any other error, or a result structpb.NewStruct cannot
+// convert, as operation_failed.
+//
+// When nothing is pending the error matches ErrNoPendingRequest. When the lease
+// is lost the request is left for redelivery and the lease's cause is returned.
+func (c *Client) ServeBridgeRequest(ctx context.Context, runExternalizableID string, ttl time.Duration, handler BridgeHandler) (*steprpcv1.BridgeCompleteResponse, error) {
+	if handler == nil {
+		return nil, fmt.Errorf("bridge handler is required")
+	}
+	claim, err := c.ClaimBridgeRequest(ctx, runExternalizableID, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseCtx, stop := c.KeepBridgeLease(ctx, claim, ttl)
+	result, handlerErr := handler(leaseCtx, claim.GetRequest())
+	lost := leaseCtx.Err() != nil && ctx.Err() == nil
+	stop()
+	if lost {
+		return nil, context.Cause(leaseCtx)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req := &steprpcv1.BridgeCompleteRequest{
+		RunId:   claim.GetRequest().GetRunId(),
+		State:   string(RunStateSucceeded),
+		LeaseId: claim.GetLeaseId(),
+	}
+	if handlerErr == nil && result != nil {
+		if err := c.requireCapability(CapabilityBridgeResults); err != nil {
+			handlerErr = err
+		} else if req.Result, err = structpb.NewStruct(result); err != nil {
+			handlerErr = fmt.Errorf("convert bridge result: %w", err)
+		}
+	}
+	if handlerErr != nil {
+		req.State = string(RunStateFailed)
+		req.Error = &steprpcv1.Error{Code: string(CodeOperationFailed), Message: handlerErr.Error()}
+		var runErr *RunError
+		if errors.As(handlerErr, &runErr) && runErr.Code() != "" {
+			req.Error.Code = string(runErr.Code())
+		}
+	}
+	return c.CompleteBridgeRequest(ctx, req)
+}
+
+// minHeartbeatDelay keeps KeepBridgeLease from spinning on a lease that is
+// about to lapse.
+const minHeartbeatDelay = 10 * time.Millisecond
+
+// heartbeatDelay is the wait before renewing a lease that expires at expires.
+func heartbeatDelay(expires time.Time) time.Duration {
+	return max(time.Until(expires)/3, minHeartbeatDelay) //nolint:mnd // two heartbeats may fail before the lease lapses
+}
+
+func leaseLost(err error) bool {
+	return errors.Is(err, ErrLeaseExpired) || errors.Is(err, ErrLeaseMismatch) || errors.Is(err, ErrRunNotFound)
+}
+
+// wholeSeconds rounds d up to whole seconds for the contract's second fields;
+// 0 asks for the server default.
+func wholeSeconds(d time.Duration) int32 {
+	if d <= 0 {
+		return 0
+	}
+	return int32(min((d+time.Second-1)/time.Second, math.MaxInt32))
+}
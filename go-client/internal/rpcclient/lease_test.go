@@ -0,0 +1,326 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// leaseServer fakes the claim, heartbeat, progress, and complete endpoints for
+// one run.
+// heartbeat answers each heartbeat; nil grants it.
+type leaseServer struct {
+	t         *testing.T
+	ttl       time.Duration
+	heartbeat func() (status int, body string)
+
+	mu         sync.Mutex
+	claims     []*steprpcv1.BridgeClaimRequest
+	heartbeats []*steprpcv1.BridgeHeartbeatRequest
+	progress   []*steprpcv1.BridgeProgressRequest
+	completes  []*steprpcv1.BridgeCompleteRequest
+}
+
+func (s *leaseServer) start() *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		body := mustReadAll(s.t, r.Body)
+		switch r.URL.Path {
+		case "/step-rpc/v1/bridge/claim":
+			in := &steprpcv1.BridgeClaimRequest{}
+			if err := protojson.Unmarshal(body, in); err != nil {
+				s.t.Errorf("Unmarshal() error = %v", err)
+			}
+			s.claims = append(s.claims, in)
+			out, _ := protojson.Marshal(&steprpcv1.BridgeClaimResponse{
+				Request:        &steprpcv1.BridgePendingResponse{RunId: "rpc-1", Operation: "stash"},
+				LeaseId:        "lease-1",
+				LeaseExpiresAt: timestamppb.New(time.Now().Add(s.ttl)),
+				Attempt:        1,
+			})
+			_, _ = w.Write(out)
+		case "/step-rpc/v1/bridge/heartbeat":
+			in := &steprpcv1.BridgeHeartbeatRequest{}
+			if err := protojson.Unmarshal(body, in); err != nil {
+				s.t.Errorf("Unmarshal() error = %v", err)
+			}
+			s.heartbeats = append(s.heartbeats, in)
+			if s.heartbeat != nil {
+				if status, resp := s.heartbeat(); status != http.StatusOK {
+					w.WriteHeader(status)
+					_, _ = w.Write([]byte(resp))
+					return
+				}
+			}
+			out, _ := protojson.Marshal(&steprpcv1.BridgeHeartbeatResponse{
+				RunId:          in.GetRunId(),
+				LeaseId:        in.GetLeaseId(),
+				LeaseExpiresAt: timestamppb.New(time.Now().Add(s.ttl)),
+			})
+			_, _ = w.Write(out)
+		case "/step-rpc/v1/bridge/progress":
+			in := &steprpcv1.BridgeProgressRequest{}
+			if err := protojson.Unmarshal(body, in); err != nil {
+				s.t.Errorf("Unmarshal() error = %v", err)
+			}
+			s.progress = append(s.progress, in)
+			_, _ = w.Write([]byte(`{"runId":"rpc-1"}`))
+		case "/step-rpc/v1/bridge/complete":
+			in := &steprpcv1.BridgeCompleteRequest{}
+			if err := protojson.Unmarshal(body, in); err != nil {
+				s.t.Errorf("Unmarshal() error = %v", err)
+			}
+			s.completes = append(s.completes, in)
+			_, _ = w.Write([]byte(`{"runId":"rpc-1","state":"` + in.GetState() + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	s.t.Cleanup(ts.Close)
+	return ts
+}
+
+func newLeaseClient(t *testing.T, s *leaseServer) *Client {
+	t.Helper()
+	ts := s.start()
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func TestClaimBridgeRequest(t *testing.T) {
+	t.Parallel()
+
+	s := &leaseServer{t: t, ttl: time.Minute}
+	c := newLeaseClient(t, s).WithBridgeWorkerID("worker-a")
+
+	claim, err := c.ClaimBridgeRequest(context.Background(), "job/demo#1", 1500*time.Millisecond)
+	if err != nil {
+		t.Fatalf("ClaimBridgeRequest() error = %v", err)
+	}
+	if claim.GetLeaseId() != "lease-1" || claim.GetRequest().GetRunId() != "rpc-1" {
+		t.Fatalf("claim = %v", claim)
+	}
+	in := s.claims[0]
+	if in.GetRunExternalizableId() != "job/demo#1" || in.GetWorkerId() != "worker-a" || in.GetLeaseSeconds() != 2 {
+		t.Fatalf("sent %v, want ttl rounded up to 2s", in)
+	}
+
+	if _, err := c.ClaimBridgeRequest(context.Background(), " ", 0); err == nil {
+		t.Fatalf("ClaimBridgeRequest() with empty run error = nil")
+	}
+	if _, err := c.HeartbeatBridgeLease(context.Background(), "rpc-1", "", 0); err == nil {
+		t.Fatalf("HeartbeatBridgeLease() with empty lease error = nil")
+	}
+}
+
+func TestServeBridgeRequest_HeartbeatsAndCompletesWithLease(t *testing.T) {
+	t.Parallel()
+
+	s := &leaseServer{t: t, ttl: 30 * time.Millisecond}
+	c := newLeaseClient(t, s)
+
+	out, err := c.ServeBridgeRequest(context.Background(), "job/demo#1", s.ttl, func(ctx context.Context, req *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+		if req.GetOperation() != "stash" {
+			t.Errorf("handler got %v", req)
+		}
+		if lease, ok := BridgeLeaseFromContext(ctx); !ok || lease != (BridgeLease{RunID: "rpc-1", LeaseID: "lease-1"}) {
+			t.Errorf("BridgeLeaseFromContext() = %v, %v", lease, ok)
+		}
+		if _, err := c.ReportBridgeProgress(ctx, &steprpcv1.BridgeProgressRequest{RunId: req.GetRunId(), Percent: 50}); err != nil {
+			t.Errorf("ReportBridgeProgress() error = %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			t.Errorf("lease context done: %v", context.Cause(ctx))
+		case <-time.After(100 * time.Millisecond):
+		}
+		return map[string]any{"stashed": 3}, nil
+	})
+	if err != nil {
+		t.Fatalf("ServeBridgeRequest() error = %v", err)
+	}
+	if out.GetState() != "succeeded" {
+		t.Fatalf("state = %q", out.GetState())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.heartbeats) < 2 {
+		t.Fatalf("heartbeats = %d, want the lease kept alive while the handler ran", len(s.heartbeats))
+	}
+	if got := s.progress[0]; got.GetLeaseId() != "lease-1" || got.GetPercent() != 50 {
+		t.Fatalf("progress = %v, want the lease taken from the handler's context", got)
+	}
+	got := s.completes[0]
+	if got.GetLeaseId() != "lease-1" || got.GetState() != "succeeded" || got.GetResult().GetFields()["stashed"].GetNumberValue() != 3 {
+		t.Fatalf("completion = %v", got)
+	}
+}
+
+func TestKeepBridgeLease_FollowsGrantedExpiry(t *testing.T) {
+	t.Parallel()
+
+	// The server grants far less than the hour asked for, as the plugin does
+	// when it caps leases; heartbeats must keep up with the granted expiry.
+	s := &leaseServer{t: t, ttl: 30 * time.Millisecond}
+	c := newLeaseClient(t, s)
+	claim, err := c.ClaimBridgeRequest(context.Background(), "job/demo#1", time.Hour)
+	if err != nil {
+		t.Fatalf("ClaimBridgeRequest() error = %v", err)
+	}
+
+	leaseCtx, stop := c.KeepBridgeLease(context.Background(), claim, time.Hour)
+	time.Sleep(100 * time.Millisecond)
+	if err := leaseCtx.Err(); err != nil {
+		t.Fatalf("lease lost: %v", context.Cause(leaseCtx))
+	}
+	stop()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.heartbeats) < 2 {
+		t.Fatalf("heartbeats = %d, want the granted lease renewed before it lapsed", len(s.heartbeats))
+	}
+	if got := s.heartbeats[0].GetLeaseSeconds(); got != 3600 {
+		t.Fatalf("heartbeat leaseSeconds = %d, want the requested ttl", got)
+	}
+}
+
+func TestServeBridgeRequest_HandlerFailure(t *testing.T) {
+	t.Parallel()
+
+	s := &leaseServer{t: t, ttl: time.Minute}
+	c := newLeaseClient(t, s)
+
+	out, err := c.ServeBridgeRequest(context.Background(), "job/demo#1", 0, func(context.Context, *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+		return map[string]any{"partial": true}, errors.New("disk full")
+	})
+	if err != nil {
+		t.Fatalf("ServeBridgeRequest() error = %v", err)
+	}
+	if out.GetState() != "failed" {
+		t.Fatalf("state = %q", out.GetState())
+	}
+	got := s.completes[0]
+	if got.GetError().GetCode() != "operation_failed" || got.GetError().GetMessage() != "disk full" || got.GetLeaseId() != "lease-1" || got.GetResult() != nil {
+		t.Fatalf("completion = %v", got)
+	}
+
+	// A result that cannot be sent fails the request rather than dropping it.
+	if _, err := c.ServeBridgeRequest(context.Background(), "job/demo#1", 0, func(context.Context, *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+		return map[string]any{"ch": make(chan int)}, nil
+	}); err != nil {
+		t.Fatalf("ServeBridgeRequest() error = %v", err)
+	}
+	if got := s.completes[1]; got.GetState() != "failed" || got.GetError().GetCode() != "operation_failed" {
+		t.Fatalf("completion = %v, want an unconvertible result reported as failed", got)
+	}
+}
+
+func TestServeBridgeRequest_LeaseLost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		heartbeat func() (int, string)
+		want      error
+	}{
+		{
+			name: "superseded",
+			heartbeat: func() (int, string) {
+				return http.StatusConflict, `{"error":{"code":"lease_mismatch","message":"lease was redelivered"}}`
+			},
+			want: ErrLeaseMismatch,
+		},
+		{
+			name: "heartbeats failing past expiry",
+			heartbeat: func() (int, string) {
+				return http.StatusServiceUnavailable, ""
+			},
+			want: ErrLeaseExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &leaseServer{t: t, ttl: 30 * time.Millisecond, heartbeat: tt.heartbeat}
+			c := newLeaseClient(t, s)
+
+			_, err := c.ServeBridgeRequest(context.Background(), "job/demo#1", s.ttl, func(ctx context.Context, _ *steprpcv1.BridgePendingResponse) (map[string]any, error) {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(5 * time.Second):
+					return nil, errors.New("lease never lost")
+				}
+			})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("ServeBridgeRequest() error = %v, want %v", err, tt.want)
+			}
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if len(s.completes) != 0 {
+				t.Fatalf("completed %v under a lost lease", s.completes)
+			}
+		})
+	}
+}
+
+func TestKeepBridgeLease_StopEndsHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	s := &leaseServer{t: t, ttl: 15 * time.Millisecond}
+	c := newLeaseClient(t, s)
+	claim, err := c.ClaimBridgeRequest(context.Background(), "job/demo#1", s.ttl)
+	if err != nil {
+		t.Fatalf("ClaimBridgeRequest() error = %v", err)
+	}
+
+	leaseCtx, stop := c.KeepBridgeLease(context.Background(), claim, s.ttl)
+	time.Sleep(40 * time.Millisecond)
+	stop()
+	if !errors.Is(context.Cause(leaseCtx), context.Canceled) {
+		t.Fatalf("cause after stop = %v", context.Cause(leaseCtx))
+	}
+
+	// A heartbeat cancelled by stop may still reach the server; let it land.
+	time.Sleep(10 * time.Millisecond)
+	s.mu.Lock()
+	n := len(s.heartbeats)
+	s.mu.Unlock()
+	time.Sleep(30 * time.Millisecond)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n == 0 || len(s.heartbeats) != n {
+		t.Fatalf("heartbeats = %d then %d, want some before stop and none after", n, len(s.heartbeats))
+	}
+}
+
+func TestCompleteBridgeRequest_LeaseNeedsCapability(t *testing.T) {
+	t.Parallel()
+
+	c, err := New("http://jenkins.invalid", "", nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge"}})
+
+	_, err = c.CompleteBridgeRequest(context.Background(), &steprpcv1.BridgeCompleteRequest{RunId: "rpc-1", State: "succeeded", LeaseId: "lease-1"})
+	if !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("CompleteBridgeRequest() error = %v, want ErrCapabilityUnsupported", err)
+	}
+}
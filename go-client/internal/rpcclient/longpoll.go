@@ -0,0 +1,149 @@
+package rpcclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// DefaultBridgeWait is the long-poll wait NextBridgeRequest uses for wait <= 0.
+// It matches the longest wait the plugin honours.
+const DefaultBridgeWait = 30 * time.Second
+
+// bridgeLongPollGrace is added to the server wait to get the client timeout of
+// a long poll, so a server answering at the end of its wait is not cut off.
+const bridgeLongPollGrace = 5 * time.Second
+
+// defaultBridgeReconnectPolicy paces NextBridgeRequest after failed long polls
+// and against servers that answer without waiting.
+var defaultBridgeReconnectPolicy = PollPolicy{
+	InitialInterval: time.Second,
+	MaxInterval:     30 * time.Second,
+}
+
+// errNothingPending is returned for a 204 or empty pending response.
+var errNothingPending = fmt.Errorf("bridge pending: %w", ErrNoPendingRequest)
+
+// WithBridgeReconnectPolicy returns a copy of the client that uses p in
+// NextBridgeRequest. The intervals back off reconnects after failed long polls,
+// MaxAttempts bounds consecutive failures, and MaxDuration bounds the whole
+// wait.
+func (c *Client) WithBridgeReconnectPolicy(p PollPolicy) *Client {
+	cp := *c
+	cp.bridgeReconnectPolicy = &p
+	return &cp
+}
+
+// WaitBridgePending long-polls for the next pending CPS bridge request: the
+// server holds the call until a request is pending or wait passes. wait is
+// sent as whole seconds, and the call times out bridgeLongPollGrace after it,
+// so an http.Client.Timeout must be longer than that. wait <= 0 asks without
+// waiting, like GetBridgePending. When nothing is pending the error matches
+// ErrNoPendingRequest.
+func (c *Client) WaitBridgePending(ctx context.Context, runExternalizableID string, wait time.Duration) (*steprpcv1.BridgePendingResponse, error) {
+	if strings.TrimSpace(runExternalizableID) == "" {
+		return nil, fmt.Errorf("runExternalizableID is required")
+	}
+	if err := c.requireCapability(CapabilityCPSBridge); err != nil {
+		return nil, err
+	}
+
+	endpoint := "/step-rpc/v1/bridge/pending?runExternalizableId=" + url.QueryEscape(runExternalizableID)
+	if c.bridgeWorkerID != "" {
+		endpoint += "&workerId=" + url.QueryEscape(c.bridgeWorkerID)
+	}
+	if wait > 0 {
+		endpoint += "&waitSeconds=" + strconv.Itoa(int(wholeSeconds(wait)))
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, wait+bridgeLongPollGrace)
+		defer cancel()
+	}
+
+	body, err := c.doRequestWithRetry(ctx, func() (*http.Request, error) {
+		httpReq, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+endpoint, nil)
+		if reqErr != nil {
+			return nil, fmt.Errorf("build bridge pending request: %w", reqErr)
+		}
+		c.authorize(httpReq)
+		return httpReq, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("send bridge pending request: %w", err)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, errNothingPending
+	}
+	out := &steprpcv1.BridgePendingResponse{}
+	if err := responseJSON.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("decode bridge pending response: %w", err)
+	}
+	return out, nil
+}
+
+// NextBridgeRequest blocks until a CPS bridge request is pending for the run
+// and returns it. It long-polls with wait per call (DefaultBridgeWait for
+// wait <= 0) and reconnects at once after a poll the server held. Transport
+// errors, 429, 5xx, and timed-out polls are retried with the backoff of the
+// reconnect policy, as are polls a server that ignores waitSeconds answered
+// early. Other errors are returned.
+func (c *Client) NextBridgeRequest(ctx context.Context, runExternalizableID string, wait time.Duration) (*steprpcv1.BridgePendingResponse, error) {
+	if wait <= 0 {
+		wait = DefaultBridgeWait
+	}
+	policy := defaultBridgeReconnectPolicy
+	if c.bridgeReconnectPolicy != nil {
+		policy = *c.bridgeReconnectPolicy
+	}
+	if policy.InitialInterval <= 0 {
+		policy.InitialInterval = defaultBridgeReconnectPolicy.InitialInterval
+	}
+	if policy.MaxInterval < policy.InitialInterval {
+		policy.MaxInterval = policy.InitialInterval
+	}
+	if policy.MaxDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.MaxDuration)
+		defer cancel()
+	}
+
+	interval := policy.InitialInterval
+	failures := 0
+	for {
+		start := time.Now()
+		pending, err := c.WaitBridgePending(ctx, runExternalizableID, wait)
+		switch {
+		case err == nil:
+			return pending, nil
+		case errors.Is(err, ErrNoPendingRequest):
+			failures = 0
+			interval = policy.InitialInterval
+			if time.Since(start) >= wait/2 {
+				continue
+			}
+		case transientError(ctx, err):
+			failures++
+			if policy.MaxAttempts > 0 && failures >= policy.MaxAttempts {
+				return nil, fmt.Errorf("next bridge request: %d consecutive failures: %w", failures, err)
+			}
+		default:
+			return nil, fmt.Errorf("next bridge request: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("next bridge request: %w", ctx.Err())
+		case <-time.After(pollJitter(interval)):
+		}
+		if failures > 0 {
+			interval = min(interval*2, policy.MaxInterval)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type pendingReply struct {
+	status int
+	body   string
+}
+
+// pendingServer answers bridge pending requests with replies in order,
+// repeating the last one, and records each request's waitSeconds.
+func pendingServer(t *testing.T, replies ...pendingReply) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var waits []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		i := min(len(waits), len(replies)-1)
+		waits = append(waits, r.URL.Query().Get("waitSeconds"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(replies[i].status)
+		_, _ = w.Write([]byte(replies[i].body))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), waits...)
+	}
+}
+
+const pendingBody = `{"requestId":"req-1","runId":"rpc-1","operation":"stash"}`
+
+func TestWaitBridgePending(t *testing.T) {
+	t.Parallel()
+
+	ts, waits := pendingServer(t,
+		pendingReply{http.StatusNoContent, ""},
+		pendingReply{http.StatusOK, ""},
+		pendingReply{http.StatusOK, pendingBody},
+	)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := c.WaitBridgePending(context.Background(), "job/demo#1", 1500*time.Millisecond); !errors.Is(err, ErrNoPendingRequest) {
+		t.Fatalf("WaitBridgePending(204) error = %v, want ErrNoPendingRequest", err)
+	}
+	if _, err := c.GetBridgePending(context.Background(), "job/demo#1"); !errors.Is(err, ErrNoPendingRequest) {
+		t.Fatalf("GetBridgePending(empty 200) error = %v, want ErrNoPendingRequest", err)
+	}
+	resp, err := c.WaitBridgePending(context.Background(), "job/demo#1", time.Second)
+	if err != nil {
+		t.Fatalf("WaitBridgePending() error = %v", err)
+	}
+	if resp.GetRunId() != "rpc-1" {
+		t.Fatalf("runId = %q", resp.GetRunId())
+	}
+
+	if got := waits(); len(got) != 3 || got[0] != "2" || got[1] != "" || got[2] != "1" {
+		t.Fatalf("waitSeconds sent = %q, want [2 \"\" 1]", got)
+	}
+}
+
+func TestNextBridgeRequest_ReconnectsUntilPending(t *testing.T) {
+	t.Parallel()
+
+	ts, waits := pendingServer(t,
+		pendingReply{http.StatusServiceUnavailable, ""},
+		pendingReply{http.StatusNoContent, ""},
+		pendingReply{http.StatusNotFound, `{"error":{"code":"no_pending_request","message":"none"}}`},
+		pendingReply{http.StatusOK, pendingBody},
+	)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithBridgeReconnectPolicy(PollPolicy{InitialInterval: time.Millisecond, MaxAttempts: 3})
+
+	resp, err := c.NextBridgeRequest(context.Background(), "job/demo#1", 0)
+	if err != nil {
+		t.Fatalf("NextBridgeRequest() error = %v", err)
+	}
+	if resp.GetOperation() != "stash" {
+		t.Fatalf("operation = %q", resp.GetOperation())
+	}
+	if got := waits(); len(got) != 4 || got[0] != "30" {
+		t.Fatalf("waitSeconds sent = %q, want 4 polls with the default wait", got)
+	}
+}
+
+func TestNextBridgeRequest_GivesUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		reply pendingReply
+		polls int
+	}{
+		{"consecutive failures", pendingReply{http.StatusBadGateway, ""}, 3},
+		{"non-transient error", pendingReply{http.StatusForbidden, `{"error":{"code":"forbidden","message":"no"}}`}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, waits := pendingServer(t, tt.reply)
+			c, err := New(ts.URL, "", ts.Client())
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			c = c.WithBridgeReconnectPolicy(PollPolicy{InitialInterval: time.Millisecond, MaxAttempts: 3})
+
+			var httpErr *HTTPError
+			if _, err := c.NextBridgeRequest(context.Background(), "job/demo#1", time.Second); !errors.As(err, &httpErr) || httpErr.StatusCode != tt.reply.status {
+				t.Fatalf("NextBridgeRequest() error = %v, want status %d", err, tt.reply.status)
+			}
+			if n := len(waits()); n != tt.polls {
+				t.Fatalf("polls = %d, want %d", n, tt.polls)
+			}
+		})
+	}
+}
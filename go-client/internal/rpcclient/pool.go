@@ -0,0 +1,369 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	// ErrNoRoute is returned when no controller matches a RouteKey.
+	ErrNoRoute = errors.New("no controller matches route")
+
+	// ErrUnknownController is returned when a controller name is not in the pool.
+	ErrUnknownController = errors.New("unknown controller")
+)
+
+// Controller is one Jenkins controller in a Pool.
+type Controller struct {
+	// Name identifies the controller in route keys and results.
+	Name string
+	// Client serves every call. Invokes only ever go to Client.
+	Client *Client
+	// Replicas serve read-only calls (catalog, run status) when Client is
+	// unhealthy. They must front the same controller state, e.g. extra
+	// replicas behind an HA controller or alternate ingress URLs.
+	Replicas []*Client
+	// FolderPrefixes route jobs whose full name starts with any prefix here,
+	// e.g. "team-a/". The longest matching prefix across the pool wins.
+	FolderPrefixes []string
+	// Labels route keys that name a label, e.g. "linux" or "gpu".
+	Labels []string
+}
+
+// RouteKey selects the controller for an invocation. The first non-empty field
+// in order Controller, JobFullName, Label decides.
+type RouteKey struct {
+	Controller  string
+	JobFullName string
+	Label       string
+}
+
+// EndpointHealth is the last known health of one controller endpoint.
+type EndpointHealth struct {
+	Healthy   bool
+	CheckedAt time.Time
+	Err       error
+}
+
+// Pool holds clients for many controllers and routes calls between them.
+// Endpoints that were never checked are treated as healthy.
+type Pool struct {
+	controllers []Controller
+	byName      map[string]int
+
+	mu     sync.Mutex
+	health map[*Client]EndpointHealth
+}
+
+// NewPool builds a pool. Controller names must be unique and non-empty.
+func NewPool(controllers ...Controller) (*Pool, error) {
+	p := &Pool{
+		byName: make(map[string]int, len(controllers)),
+		health: map[*Client]EndpointHealth{},
+	}
+	for _, c := range controllers {
+		if strings.TrimSpace(c.Name) == "" {
+			return nil, fmt.Errorf("controller name is required")
+		}
+		if _, dup := p.byName[c.Name]; dup {
+			return nil, fmt.Errorf("duplicate controller %q", c.Name)
+		}
+		if c.Client == nil {
+			return nil, fmt.Errorf("controller %q: client is required", c.Name)
+		}
+		p.byName[c.Name] = len(p.controllers)
+		p.controllers = append(p.controllers, c)
+	}
+	return p, nil
+}
+
+// Controllers returns the controller names in registration order.
+func (p *Pool) Controllers() []string {
+	names := make([]string, len(p.controllers))
+	for i, c := range p.controllers {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// Route resolves key to a controller. Label routes pick the first healthy
+// controller carrying the label, falling back to the first one if none is healthy.
+func (p *Pool) Route(key RouteKey) (*Controller, error) {
+	switch {
+	case key.Controller != "":
+		return p.controller(key.Controller)
+	case key.JobFullName != "":
+		best, bestLen := -1, -1
+		for i, c := range p.controllers {
+			for _, prefix := range c.FolderPrefixes {
+				if strings.HasPrefix(key.JobFullName, prefix) && len(prefix) > bestLen {
+					best, bestLen = i, len(prefix)
+				}
+			}
+		}
+		if best < 0 {
+			return nil, fmt.Errorf("%w: job %q", ErrNoRoute, key.JobFullName)
+		}
+		return &p.controllers[best], nil
+	case key.Label != "":
+		var fallback *Controller
+		for i := range p.controllers {
+			c := &p.controllers[i]
+			if !slices.Contains(c.Labels, key.Label) {
+				continue
+			}
+			if p.Health(c.Client).Healthy {
+				return c, nil
+			}
+			if fallback == nil {
+				fallback = c
+			}
+		}
+		if fallback == nil {
+			return nil, fmt.Errorf("%w: label %q", ErrNoRoute, key.Label)
+		}
+		return fallback, nil
+	default:
+		return nil, fmt.Errorf("%w: empty route key", ErrNoRoute)
+	}
+}
+
+func (p *Pool) controller(name string) (*Controller, error) {
+	i, ok := p.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownController, name)
+	}
+	return &p.controllers[i], nil
+}
+
+// Invoke routes req by key and sends it to the controller's primary client. It
+// returns the controller name, which later status calls need.
+func (p *Pool) Invoke(ctx context.Context, key RouteKey, req *steprpcv1.InvokeRequest) (*steprpcv1.InvokeResponse, string, error) {
+	c, err := p.Route(key)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := c.Client.Invoke(ctx, req)
+	p.observe(c.Client, err)
+	return resp, c.Name, err
+}
+
+// Execute routes by key and runs Client.Execute on the controller's primary client.
+func (p *Pool) Execute(ctx context.Context, key RouteKey, op string, args *structpb.Struct) (*Result, string, error) {
+	c, err := p.Route(key)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := c.Client.Execute(ctx, op, args)
+	p.observe(c.Client, err)
+	return res, c.Name, err
+}
+
+// GetRunStatus fetches run status from the named controller, failing over to
+// its replicas.
+func (p *Pool) GetRunStatus(ctx context.Context, controller, runID string) (*steprpcv1.RunStatusResponse, error) {
+	c, err := p.controller(controller)
+	if err != nil {
+		return nil, err
+	}
+	return readWithFailover(ctx, p, c, func(cl *Client) (*steprpcv1.RunStatusResponse, error) {
+		return cl.GetRunStatus(ctx, runID)
+	})
+}
+
+// GetCatalog fetches the named controller's catalog, failing over to its replicas.
+func (p *Pool) GetCatalog(ctx context.Context, controller string) (*steprpcv1.CatalogResponse, error) {
+	c, err := p.controller(controller)
+	if err != nil {
+		return nil, err
+	}
+	return readWithFailover(ctx, p, c, func(cl *Client) (*steprpcv1.CatalogResponse, error) {
+		return cl.GetCatalog(ctx)
+	})
+}
+
+// readWithFailover tries healthy endpoints of c first, then unhealthy ones, and
+// moves on only when an endpoint is unreachable or reports a server error.
+func readWithFailover[T any](ctx context.Context, p *Pool, c *Controller, call func(*Client) (T, error)) (T, error) {
+	endpoints := append([]*Client{c.Client}, c.Replicas...)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return p.Health(endpoints[i]).Healthy && !p.Health(endpoints[j]).Healthy
+	})
+
+	var zero T
+	var errs []error
+	for _, cl := range endpoints {
+		out, err := call(cl)
+		p.observe(cl, err)
+		if err == nil {
+			return out, nil
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil || !failoverEligible(err) {
+			break
+		}
+	}
+	return zero, fmt.Errorf("controller %s: %w", c.Name, errors.Join(errs...))
+}
+
+func failoverEligible(err error) bool {
+	if errors.Is(err, ErrCapabilityUnsupported) {
+		return false
+	}
+	switch CategoryOf(err) {
+	case CategoryNetwork, CategoryServerError, CategoryRateLimited:
+		return true
+	default:
+		return false
+	}
+}
+
+// observe records passive health from a call result. Only failures that say
+// something about the endpoint itself mark it unhealthy.
+func (p *Pool) observe(cl *Client, err error) {
+	switch {
+	case err == nil:
+		p.setHealth(cl, EndpointHealth{Healthy: true, CheckedAt: time.Now()})
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+	case failoverEligible(err):
+		p.setHealth(cl, EndpointHealth{Healthy: false, CheckedAt: time.Now(), Err: err})
+	}
+}
+
+func (p *Pool) setHealth(cl *Client, h EndpointHealth) {
+	p.mu.Lock()
+	p.health[cl] = h
+	p.mu.Unlock()
+}
+
+// Health returns the last known health of an endpoint client.
+func (p *Pool) Health(cl *Client) EndpointHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	h, ok := p.health[cl]
+	if !ok {
+		return EndpointHealth{Healthy: true}
+	}
+	return h
+}
+
+// ControllerHealthy reports whether the named controller's primary client is healthy.
+func (p *Pool) ControllerHealthy(name string) bool {
+	c, err := p.controller(name)
+	if err != nil {
+		return false
+	}
+	return p.Health(c.Client).Healthy
+}
+
+// CheckHealth probes every endpoint's health endpoint concurrently and records
+// the results.
+func (p *Pool) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, c := range p.controllers {
+		for _, cl := range append([]*Client{c.Client}, c.Replicas...) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				health, err := cl.GetHealth(ctx)
+				if err == nil && health.GetStatus() != "ok" {
+					err = fmt.Errorf("health status %q", health.GetStatus())
+				}
+				p.setHealth(cl, EndpointHealth{Healthy: err == nil, CheckedAt: time.Now(), Err: err})
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+// MonitorHealth calls CheckHealth every interval until ctx is done.
+func (p *Pool) MonitorHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.CheckHealth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// OperationSupport is one controller's support for an operation.
+type OperationSupport struct {
+	Controller    string
+	ExecutionMode steprpcv1.OperationExecutionMode
+	// Discovered is false for operations allowlisted on the controller but not
+	// found among its installed steps.
+	Discovered bool
+}
+
+// MergedCatalog answers which controllers support an operation, and how.
+type MergedCatalog struct {
+	byOperation map[string][]OperationSupport
+}
+
+// Operations returns every operation name, sorted.
+func (m *MergedCatalog) Operations() []string {
+	ops := make([]string, 0, len(m.byOperation))
+	for op := range m.byOperation {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+// Supports returns the controllers listing op, in pool order.
+func (m *MergedCatalog) Supports(op string) []OperationSupport {
+	return m.byOperation[op]
+}
+
+// ControllersFor returns the controllers that discovered op and run it in mode.
+func (m *MergedCatalog) ControllersFor(op string, mode steprpcv1.OperationExecutionMode) []string {
+	var out []string
+	for _, s := range m.byOperation[op] {
+		if s.Discovered && s.ExecutionMode == mode {
+			out = append(out, s.Controller)
+		}
+	}
+	return out
+}
+
+// MergedCatalog fetches every controller's catalog concurrently and merges
+// them. Controllers whose catalog cannot be fetched are left out, and their
+// errors are joined into the returned error alongside the partial result.
+func (p *Pool) MergedCatalog(ctx context.Context) (*MergedCatalog, error) {
+	catalogs := make([]*steprpcv1.CatalogResponse, len(p.controllers))
+	errs := make([]error, len(p.controllers))
+	var wg sync.WaitGroup
+	for i, c := range p.controllers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			catalogs[i], errs[i] = p.GetCatalog(ctx, c.Name)
+		}()
+	}
+	wg.Wait()
+
+	merged := &MergedCatalog{byOperation: map[string][]OperationSupport{}}
+	for i, catalog := range catalogs {
+		for _, op := range catalog.GetOperations() {
+			merged.byOperation[op.GetName()] = append(merged.byOperation[op.GetName()], OperationSupport{
+				Controller:    p.controllers[i].Name,
+				ExecutionMode: op.GetExecutionMode(),
+				Discovered:    op.GetDescription() != undiscoveredOperationDescription,
+			})
+		}
+	}
+	return merged, errors.Join(errs...)
+}
@@ -0,0 +1,219 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// controllerServer serves a fixed catalog and health document. status is the
+// HTTP status for every request; hits counts requests.
+func controllerServer(t *testing.T, status int, catalog string, hits *int32) *Client {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits != nil {
+			atomic.AddInt32(hits, 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"error":{"code":"unavailable","message":"down"}}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/step-rpc/v1/":
+			_, _ = w.Write([]byte(`{"apiVersion":"v1","service":"jenkins-step-rpc-plugin","status":"ok"}`))
+		case "/step-rpc/v1/catalog":
+			_, _ = w.Write([]byte(catalog))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"run_not_found","message":"missing"}}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func mustPool(t *testing.T, controllers ...Controller) *Pool {
+	t.Helper()
+	p, err := NewPool(controllers...)
+	if err != nil {
+		t.Fatalf("NewPool() error = %v", err)
+	}
+	return p
+}
+
+func TestPool_Route(t *testing.T) {
+	t.Parallel()
+
+	a := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	b := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	c := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	p := mustPool(t,
+		Controller{Name: "a", Client: a, FolderPrefixes: []string{"team/"}, Labels: []string{"linux"}},
+		Controller{Name: "b", Client: b, FolderPrefixes: []string{"team/platform/"}, Labels: []string{"linux", "gpu"}},
+		Controller{Name: "c", Client: c},
+	)
+	p.setHealth(a, EndpointHealth{Healthy: false})
+
+	tests := []struct {
+		key  RouteKey
+		want string
+	}{
+		{RouteKey{Controller: "c", JobFullName: "team/x"}, "c"},
+		{RouteKey{JobFullName: "team/platform/deploy"}, "b"},
+		{RouteKey{JobFullName: "team/web/build"}, "a"},
+		{RouteKey{Label: "linux"}, "b"},
+		{RouteKey{Label: "gpu"}, "b"},
+	}
+	for _, tt := range tests {
+		got, err := p.Route(tt.key)
+		if err != nil {
+			t.Fatalf("Route(%+v) error = %v", tt.key, err)
+		}
+		if got.Name != tt.want {
+			t.Fatalf("Route(%+v) = %s, want %s", tt.key, got.Name, tt.want)
+		}
+	}
+
+	for _, key := range []RouteKey{{}, {JobFullName: "other/job"}, {Label: "arm"}} {
+		if _, err := p.Route(key); !errors.Is(err, ErrNoRoute) {
+			t.Fatalf("Route(%+v) error = %v, want ErrNoRoute", key, err)
+		}
+	}
+	if _, err := p.Route(RouteKey{Controller: "z"}); !errors.Is(err, ErrUnknownController) {
+		t.Fatalf("Route(z) error = %v, want ErrUnknownController", err)
+	}
+}
+
+func TestNewPool_Validates(t *testing.T) {
+	t.Parallel()
+
+	c := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	for _, controllers := range [][]Controller{
+		{{Name: "", Client: c}},
+		{{Name: "a"}},
+		{{Name: "a", Client: c}, {Name: "a", Client: c}},
+	} {
+		if _, err := NewPool(controllers...); err == nil {
+			t.Fatalf("NewPool(%+v) error = nil", controllers)
+		}
+	}
+}
+
+func TestPool_ReadFailover(t *testing.T) {
+	t.Parallel()
+
+	var primaryHits, replicaHits int32
+	primary := controllerServer(t, http.StatusServiceUnavailable, "", &primaryHits)
+	replica := controllerServer(t, http.StatusOK, executeCatalog, &replicaHits)
+	p := mustPool(t, Controller{Name: "a", Client: primary, Replicas: []*Client{replica}})
+	ctx := context.Background()
+
+	catalog, err := p.GetCatalog(ctx, "a")
+	if err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	if len(catalog.GetOperations()) != 3 {
+		t.Fatalf("operations = %d, want 3", len(catalog.GetOperations()))
+	}
+	if p.ControllerHealthy("a") {
+		t.Fatalf("primary still healthy after 503")
+	}
+
+	// The unhealthy primary is now tried last, so the replica answers alone.
+	if _, err := p.GetCatalog(ctx, "a"); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&primaryHits); got != 1 {
+		t.Fatalf("primary hits = %d, want 1", got)
+	}
+
+	// A definitive answer such as run_not_found does not fail over.
+	_, err = p.GetRunStatus(ctx, "a", "rpc-missing")
+	if !errors.Is(err, ErrRunNotFound) {
+		t.Fatalf("GetRunStatus() error = %v, want ErrRunNotFound", err)
+	}
+	if got := atomic.LoadInt32(&primaryHits); got != 1 {
+		t.Fatalf("primary hits = %d, want no failover after run_not_found", got)
+	}
+	if got := atomic.LoadInt32(&replicaHits); got != 3 {
+		t.Fatalf("replica hits = %d, want 3", got)
+	}
+}
+
+func TestPool_InvokeUsesPrimaryOnly(t *testing.T) {
+	t.Parallel()
+
+	var replicaHits int32
+	primary := controllerServer(t, http.StatusServiceUnavailable, "", nil)
+	replica := controllerServer(t, http.StatusOK, executeCatalog, &replicaHits)
+	p := mustPool(t, Controller{Name: "a", Client: primary, Replicas: []*Client{replica}, Labels: []string{"linux"}})
+
+	_, name, err := p.Invoke(context.Background(), RouteKey{Label: "linux"}, &steprpcv1.InvokeRequest{RequestId: "r", Operation: "junit"})
+	if err == nil {
+		t.Fatalf("Invoke() error = nil, want 503")
+	}
+	if name != "a" {
+		t.Fatalf("controller = %q, want a", name)
+	}
+	if got := atomic.LoadInt32(&replicaHits); got != 0 {
+		t.Fatalf("replica hits = %d, want 0", got)
+	}
+}
+
+func TestPool_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	up := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	down := controllerServer(t, http.StatusBadGateway, "", nil)
+	p := mustPool(t, Controller{Name: "up", Client: up}, Controller{Name: "down", Client: down})
+
+	p.CheckHealth(context.Background())
+	if !p.ControllerHealthy("up") {
+		t.Fatalf("up unhealthy: %v", p.Health(up).Err)
+	}
+	h := p.Health(down)
+	if h.Healthy || h.Err == nil || h.CheckedAt.IsZero() {
+		t.Fatalf("down health = %+v, want unhealthy with error", h)
+	}
+}
+
+func TestPool_MergedCatalog(t *testing.T) {
+	t.Parallel()
+
+	a := controllerServer(t, http.StatusOK, executeCatalog, nil)
+	b := controllerServer(t, http.StatusOK, `{"operations":[`+
+		`{"name":"junit","description":"Publish","executionMode":"OPERATION_EXECUTION_MODE_DIRECT"},`+
+		`{"name":"stash","description":"Stash","executionMode":"OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"}]}`, nil)
+	broken := controllerServer(t, http.StatusInternalServerError, "", nil)
+	p := mustPool(t, Controller{Name: "a", Client: a}, Controller{Name: "b", Client: b}, Controller{Name: "broken", Client: broken})
+
+	merged, err := p.MergedCatalog(context.Background())
+	if err == nil {
+		t.Fatalf("MergedCatalog() error = nil, want broken controller error")
+	}
+	if got, want := merged.Operations(), []string{"archiveArtifacts", "ghost", "junit", "stash"}; !slices.Equal(got, want) {
+		t.Fatalf("Operations() = %v, want %v", got, want)
+	}
+	if got := merged.Supports("junit"); len(got) != 2 || got[0].Controller != "a" || got[1].Controller != "b" {
+		t.Fatalf("Supports(junit) = %+v", got)
+	}
+	cps := steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED
+	if got := merged.ControllersFor("junit", cps); !slices.Equal(got, []string{"a"}) {
+		t.Fatalf("ControllersFor(junit, CPS) = %v, want [a]", got)
+	}
+	if got := merged.ControllersFor("ghost", cps); len(got) != 0 {
+		t.Fatalf("ControllersFor(ghost, CPS) = %v, want none for undiscovered op", got)
+	}
+}
@@ -0,0 +1,281 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/faultinject"
+)
+
+// propertyCases is the number of random cases each property test checks. The
+// cases are seeded, so a failure names the seed that reproduces it.
+const propertyCases = 200
+
+// stallTimeout bounds cases that stall, and stallSlack is how late a call may
+// return after its context is done.
+const (
+	stallTimeout = 30 * time.Millisecond
+	stallSlack   = 2 * time.Second
+)
+
+var retryOutcomes = []int{0, http.StatusOK, http.StatusBadRequest, http.StatusNotFound, http.StatusConflict,
+	http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+	http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+func TestDoWithRetry_Properties(t *testing.T) {
+	t.Parallel()
+
+	for seed := range uint64(propertyCases) {
+		rng := rand.New(rand.NewPCG(seed, seed))
+		policy := &RetryPolicy{MaxAttempts: rng.IntN(6), InitialBackoff: time.Microsecond, MaxBackoff: 10 * time.Microsecond}
+		outcomes := make([]int, 8)
+		for i := range outcomes {
+			outcomes[i] = retryOutcomes[rng.IntN(len(retryOutcomes))]
+		}
+
+		var calls int
+		var lastErr error
+		body, err := doWithRetry(t.Context(), policy, func() (int, []byte, error) {
+			status := outcomes[calls]
+			calls++
+			if status == http.StatusOK {
+				return status, []byte("ok"), nil
+			}
+			lastErr = fmt.Errorf("call %d: status %d", calls, status)
+			return status, nil, lastErr
+		})
+
+		maxCalls := max(policy.MaxAttempts, 1)
+		if calls < 1 || calls > maxCalls {
+			t.Fatalf("seed %d: calls = %d, want 1..%d", seed, calls, maxCalls)
+		}
+		// Every call but the last failed retryably.
+		for i, status := range outcomes[:calls-1] {
+			if !defaultRetryClassifier(status, nil) {
+				t.Fatalf("seed %d: call %d with status %d was retried (outcomes %v)", seed, i+1, status, outcomes[:calls])
+			}
+		}
+		last := outcomes[calls-1]
+		switch {
+		case last == http.StatusOK:
+			if err != nil || string(body) != "ok" {
+				t.Fatalf("seed %d: body = %q, error = %v, want ok", seed, body, err)
+			}
+		case !errors.Is(err, lastErr):
+			t.Fatalf("seed %d: error = %v, want last error %v", seed, err, lastErr)
+		case defaultRetryClassifier(last, nil) && calls != maxCalls:
+			t.Fatalf("seed %d: stopped after %d retryable calls, want %d", seed, calls, maxCalls)
+		}
+	}
+}
+
+func TestDoWithRetry_ContextCanceledDuringBackoff(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	policy := &RetryPolicy{MaxAttempts: 100, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	var calls int
+	_, err := doWithRetry(ctx, policy, func() (int, []byte, error) {
+		calls++
+		cancel()
+		return http.StatusServiceUnavailable, nil, errors.New("unavailable")
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Fatalf("doWithRetry() = %v after %d calls, want context.Canceled after 1", err, calls)
+	}
+}
+
+// faultCase is a random fault rule and retry budget.
+type faultCase struct {
+	rule        faultinject.Rule
+	maxAttempts int
+}
+
+var faultKinds = []faultinject.Kind{
+	faultinject.Latency, faultinject.ResetBeforeWrite, faultinject.ResetAfterWrite,
+	faultinject.TruncateBody, faultinject.MalformedBody, faultinject.TooManyRequests,
+	faultinject.ServerError, faultinject.Stall,
+}
+
+func randomFaultCase(rng *rand.Rand, path string) faultCase {
+	fault := faultinject.Fault{
+		Kind:       faultKinds[rng.IntN(len(faultKinds))],
+		Delay:      time.Millisecond,
+		RetryAfter: time.Second,
+		Status:     []int{500, 502, 503, 504}[rng.IntN(4)],
+		Burst:      1 + rng.IntN(4),
+	}
+	rule := faultinject.Rule{Path: path, Fault: fault}
+	if rng.IntN(2) == 0 {
+		rule.Probability = rng.Float64()
+	} else {
+		for call := 1; call <= 6; call++ {
+			if rng.IntN(2) == 0 {
+				rule.Calls = append(rule.Calls, call)
+			}
+		}
+	}
+	return faultCase{rule: rule, maxAttempts: 1 + rng.IntN(5)}
+}
+
+// retryableFault reports whether the client's default classifier retries
+// the fault. Latency is transparent and never ends a call.
+func retryableFault(f faultinject.Fault) bool {
+	switch f.Kind {
+	case faultinject.TooManyRequests:
+		return true
+	case faultinject.ServerError:
+		return defaultRetryClassifier(f.Status, nil)
+	default:
+		return false
+	}
+}
+
+// checkInjections asserts that only the final request of a call may have hit
+// a non-retryable fault, since the client must not retry or poll past one.
+func checkInjections(t *testing.T, seed uint64, ft *faultinject.Transport, rule faultinject.Rule) {
+	t.Helper()
+	requests := ft.Requests()
+	for _, in := range ft.Injections() {
+		if in.Kind == faultinject.Latency || retryableFault(rule.Fault) || in.Call == requests {
+			continue
+		}
+		t.Fatalf("seed %d: request %d hit non-retryable %s but %d requests were sent", seed, in.Call, in.Kind, requests)
+	}
+}
+
+func faultClient(t *testing.T, ts *httptest.Server, ft *faultinject.Transport, maxAttempts int) *Client {
+	t.Helper()
+	c, err := New(ts.URL, "", &http.Client{Transport: ft})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c.WithRetryPolicy(&RetryPolicy{MaxAttempts: maxAttempts, InitialBackoff: time.Microsecond, MaxBackoff: 100 * time.Microsecond})
+}
+
+func TestGetRunStatus_RetryPropertiesUnderFaults(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+		_, _ = fmt.Fprintf(w, `{"requestId":"r-1","runId":%q,"state":"running"}`, runID)
+	}))
+	defer ts.Close()
+
+	for seed := range uint64(propertyCases) {
+		rng := rand.New(rand.NewPCG(seed, seed))
+		tc := randomFaultCase(rng, "/step-rpc/v1/runs/*")
+		ft := faultinject.New(ts.Client().Transport, tc.rule).WithSeed(seed)
+		c := faultClient(t, ts, ft, tc.maxAttempts)
+
+		ctx, cancel := context.WithTimeout(t.Context(), stallTimeout)
+		start := time.Now()
+		status, err := c.GetRunStatus(ctx, "rpc-1")
+		elapsed := time.Since(start)
+		cancel()
+
+		if got := ft.Requests(); got < 1 || got > tc.maxAttempts {
+			t.Fatalf("seed %d: requests = %d, want 1..%d", seed, got, tc.maxAttempts)
+		}
+		checkInjections(t, seed, ft, tc.rule)
+		if elapsed > stallTimeout+stallSlack {
+			t.Fatalf("seed %d: GetRunStatus() returned after %v, past its %v deadline", seed, elapsed, stallTimeout)
+		}
+
+		injections := ft.Injections()
+		lastFaulted := len(injections) > 0 && injections[len(injections)-1].Call == ft.Requests() &&
+			injections[len(injections)-1].Kind != faultinject.Latency
+		switch {
+		case !lastFaulted:
+			if err != nil || status.GetRunId() != "rpc-1" {
+				t.Fatalf("seed %d: GetRunStatus() = %v, %v after an unfaulted request", seed, status, err)
+			}
+		case err == nil:
+			t.Fatalf("seed %d: GetRunStatus() error = nil after %s", seed, tc.rule.Fault.Kind)
+		case tc.rule.Fault.Kind == faultinject.Stall && !errors.Is(err, context.DeadlineExceeded):
+			t.Fatalf("seed %d: error = %v, want context.DeadlineExceeded", seed, err)
+		case retryableFault(tc.rule.Fault) && ft.Requests() != tc.maxAttempts:
+			t.Fatalf("seed %d: gave up after %d of %d attempts: %v", seed, ft.Requests(), tc.maxAttempts, err)
+		}
+	}
+}
+
+func TestWaitRunTerminal_PropertiesUnderFaults(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	polls := map[string]int{}
+	// Run rpc-<n> reports running for its first n polls, then succeeded.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+		var running int
+		_, _ = fmt.Sscanf(strings.SplitN(runID, "-", 3)[1], "%d", &running)
+		mu.Lock()
+		polls[runID]++
+		n := polls[runID]
+		mu.Unlock()
+		state := "running"
+		if n > running {
+			state = "succeeded"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"requestId":"r-1","runId":%q,"state":%q}`, runID, state)
+	}))
+	defer ts.Close()
+
+	for seed := range uint64(propertyCases) {
+		rng := rand.New(rand.NewPCG(seed, seed))
+		tc := randomFaultCase(rng, "/step-rpc/v1/runs/*")
+		running := rng.IntN(4)
+		runID := fmt.Sprintf("rpc-%d-%d", running, seed)
+		poll := PollPolicy{InitialInterval: time.Microsecond, MaxInterval: time.Millisecond, MaxAttempts: rng.IntN(5), MaxDuration: stallTimeout}
+		ft := faultinject.New(ts.Client().Transport, tc.rule).WithSeed(seed)
+		c := faultClient(t, ts, ft, tc.maxAttempts)
+
+		start := time.Now()
+		status, err := c.WaitRunTerminal(t.Context(), runID, poll)
+		elapsed := time.Since(start)
+
+		mu.Lock()
+		served := polls[runID]
+		mu.Unlock()
+		if served > running+1 {
+			t.Fatalf("seed %d: server answered %d polls, want at most %d", seed, served, running+1)
+		}
+		if poll.MaxAttempts > 0 && ft.Requests() > poll.MaxAttempts*tc.maxAttempts {
+			t.Fatalf("seed %d: requests = %d, want at most %d polls of %d attempts", seed, ft.Requests(), poll.MaxAttempts, tc.maxAttempts)
+		}
+		if elapsed > poll.MaxDuration+stallSlack {
+			t.Fatalf("seed %d: WaitRunTerminal() returned after %v, past MaxDuration %v", seed, elapsed, poll.MaxDuration)
+		}
+		checkInjections(t, seed, ft, tc.rule)
+
+		switch {
+		case err == nil:
+			if !RunStateOf(status).IsSuccess() || served != running+1 {
+				t.Fatalf("seed %d: WaitRunTerminal() = %v after %d polls, want succeeded after %d", seed, status, served, running+1)
+			}
+		case tc.rule.Fault.Kind == faultinject.Stall && errors.Is(err, context.DeadlineExceeded):
+		case strings.Contains(err.Error(), "max attempts"):
+			if poll.MaxAttempts == 0 || served > poll.MaxAttempts {
+				t.Fatalf("seed %d: %v with MaxAttempts %d after %d polls", seed, err, poll.MaxAttempts, served)
+			}
+		case errors.Is(err, context.DeadlineExceeded):
+			// MaxDuration ran out while retrying or sleeping between polls.
+		default:
+			injections := ft.Injections()
+			if len(injections) == 0 || injections[len(injections)-1].Call != ft.Requests() {
+				t.Fatalf("seed %d: WaitRunTerminal() error = %v without a fault on the last request", seed, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package rpcclient
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ErrInvalidRunContext is returned when a RunContext does not satisfy the rules
+// the plugin's InRunOperationExecutor enforces on args.runContext.
+var ErrInvalidRunContext = errors.New("invalid run context")
+
+// RunContext identifies the Jenkins build an operation executes against.
+//
+// The plugin accepts either RunExternalizableID or JobFullName plus BuildNumber
+// to locate the build, and always requires NodeName and Workspace.
+type RunContext struct {
+	RunExternalizableID string
+	JobFullName         string
+	BuildNumber         int
+	NodeName            string
+	Workspace           string
+}
+
+// NewRunContextForRun builds a RunContext addressed by run externalizable ID (e.g. "folder/job#42").
+func NewRunContextForRun(runExternalizableID, nodeName, workspace string) RunContext {
+	return RunContext{
+		RunExternalizableID: runExternalizableID,
+		NodeName:            nodeName,
+		Workspace:           workspace,
+	}
+}
+
+// NewRunContextForBuild builds a RunContext addressed by job full name and build number.
+func NewRunContextForBuild(jobFullName string, buildNumber int, nodeName, workspace string) RunContext {
+	return RunContext{
+		JobFullName: jobFullName,
+		BuildNumber: buildNumber,
+		NodeName:    nodeName,
+		Workspace:   workspace,
+	}
+}
+
+// RunContextFromEnv derives a RunContext from the standard Jenkins build
+// environment (JOB_NAME, BUILD_NUMBER, NODE_NAME, WORKSPACE). When BUILD_TAG is
+// set it must agree with JOB_NAME and BUILD_NUMBER.
+func RunContextFromEnv() (RunContext, error) {
+	return runContextFromLookup(os.LookupEnv)
+}
+
+func runContextFromLookup(lookup func(string) (string, bool)) (RunContext, error) {
+	get := func(key string) string {
+		v, _ := lookup(key)
+		return strings.TrimSpace(v)
+	}
+
+	jobName := get("JOB_NAME")
+	rawNumber := get("BUILD_NUMBER")
+	if jobName == "" || rawNumber == "" {
+		return RunContext{}, fmt.Errorf("%w: JOB_NAME and BUILD_NUMBER must be set", ErrInvalidRunContext)
+	}
+	buildNumber, err := strconv.Atoi(rawNumber)
+	if err != nil {
+		return RunContext{}, fmt.Errorf("%w: BUILD_NUMBER %q is not an integer", ErrInvalidRunContext, rawNumber)
+	}
+
+	// Jenkins derives BUILD_TAG as jenkins-${JOB_NAME}-${BUILD_NUMBER} with '/' replaced by '-'.
+	if tag := get("BUILD_TAG"); tag != "" {
+		want := "jenkins-" + strings.ReplaceAll(jobName, "/", "-") + "-" + rawNumber
+		if tag != want {
+			return RunContext{}, fmt.Errorf("%w: BUILD_TAG %q does not match JOB_NAME/BUILD_NUMBER", ErrInvalidRunContext, tag)
+		}
+	}
+
+	rc := NewRunContextForBuild(jobName, buildNumber, get("NODE_NAME"), get("WORKSPACE"))
+	if err := rc.Validate(); err != nil {
+		return RunContext{}, err
+	}
+	return rc, nil
+}
+
+// Validate reports whether the plugin would accept this RunContext.
+func (rc RunContext) Validate() error {
+	if strings.TrimSpace(rc.RunExternalizableID) == "" &&
+		(strings.TrimSpace(rc.JobFullName) == "" || rc.BuildNumber <= 0) {
+		return fmt.Errorf("%w: either runExternalizableId or jobFullName/buildNumber is required", ErrInvalidRunContext)
+	}
+	if strings.TrimSpace(rc.NodeName) == "" || strings.TrimSpace(rc.Workspace) == "" {
+		return fmt.Errorf("%w: nodeName and workspace are required", ErrInvalidRunContext)
+	}
+	return nil
+}
+
+// Struct encodes the RunContext in the args.runContext wire shape.
+func (rc RunContext) Struct() *structpb.Struct {
+	fields := map[string]*structpb.Value{
+		"nodeName":  structpb.NewStringValue(rc.NodeName),
+		"workspace": structpb.NewStringValue(rc.Workspace),
+	}
+	if rc.RunExternalizableID != "" {
+		fields["runExternalizableId"] = structpb.NewStringValue(rc.RunExternalizableID)
+	} else {
+		fields["jobFullName"] = structpb.NewStringValue(rc.JobFullName)
+		// The plugin parses buildNumber with toString().toIntOrNull(); a JSON
+		// number arrives as a double ("42.0"), so send the decimal string.
+		fields["buildNumber"] = structpb.NewStringValue(strconv.Itoa(rc.BuildNumber))
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+// InjectRunContext returns a copy of args with runContext set to rc. An existing
+// args.runContext is left untouched.
+func InjectRunContext(args *structpb.Struct, rc RunContext) (*structpb.Struct, error) {
+	if _, ok := args.GetFields()[runContextArgKey]; ok {
+		return args, nil
+	}
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+	out := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(args.GetFields())+1)}
+	for k, v := range args.GetFields() {
+		out.Fields[k] = v
+	}
+	out.Fields[runContextArgKey] = structpb.NewStructValue(rc.Struct())
+	return out, nil
+}
+
+// WithRunContext returns a copy of the client that injects rc into
+// InvokeRequest.args whenever args.runContext is absent.
+func (c *Client) WithRunContext(rc RunContext) *Client {
+	cp := *c
+	cp.runContext = &rc
+	return &cp
+}
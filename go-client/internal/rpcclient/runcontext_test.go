@@ -0,0 +1,143 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRunContext_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rc      RunContext
+		wantErr bool
+	}{
+		{"externalizable id", NewRunContextForRun("folder/job#7", "built-in", "/ws"), false},
+		{"job and build", NewRunContextForBuild("folder/job", 7, "agent-1", "/ws"), false},
+		{"externalizable id without node", NewRunContextForRun("folder/job#7", "", "/ws"), true},
+		{"job without build number", NewRunContextForBuild("folder/job", 0, "agent-1", "/ws"), true},
+		{"job without workspace", NewRunContextForBuild("folder/job", 7, "agent-1", ""), true},
+		{"empty", RunContext{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.rc.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRunContext) {
+				t.Fatalf("Validate() error = %v, want ErrInvalidRunContext", err)
+			}
+		})
+	}
+}
+
+func TestRunContextFromLookup(t *testing.T) {
+	t.Parallel()
+
+	base := map[string]string{
+		"JOB_NAME":     "team-a/deploy",
+		"BUILD_NUMBER": "42",
+		"BUILD_TAG":    "jenkins-team-a-deploy-42",
+		"NODE_NAME":    "agent-1",
+		"WORKSPACE":    "/home/jenkins/ws",
+	}
+	lookup := func(env map[string]string) func(string) (string, bool) {
+		return func(k string) (string, bool) {
+			v, ok := env[k]
+			return v, ok
+		}
+	}
+
+	rc, err := runContextFromLookup(lookup(base))
+	if err != nil {
+		t.Fatalf("runContextFromLookup() error = %v", err)
+	}
+	want := NewRunContextForBuild("team-a/deploy", 42, "agent-1", "/home/jenkins/ws")
+	if rc != want {
+		t.Fatalf("run context = %+v, want %+v", rc, want)
+	}
+
+	for _, mutate := range []func(map[string]string){
+		func(env map[string]string) { env["BUILD_TAG"] = "jenkins-other-1" },
+		func(env map[string]string) { env["BUILD_NUMBER"] = "abc" },
+		func(env map[string]string) { delete(env, "WORKSPACE") },
+		func(env map[string]string) { delete(env, "JOB_NAME") },
+	} {
+		env := make(map[string]string, len(base))
+		for k, v := range base {
+			env[k] = v
+		}
+		mutate(env)
+		if _, err := runContextFromLookup(lookup(env)); !errors.Is(err, ErrInvalidRunContext) {
+			t.Fatalf("runContextFromLookup(%v) error = %v, want ErrInvalidRunContext", env, err)
+		}
+	}
+}
+
+func TestInjectRunContext(t *testing.T) {
+	t.Parallel()
+
+	args, err := structpb.NewStruct(map[string]any{"artifacts": "*.jar"})
+	if err != nil {
+		t.Fatalf("NewStruct() error = %v", err)
+	}
+
+	out, err := InjectRunContext(args, NewRunContextForBuild("job", 3, "built-in", "/ws"))
+	if err != nil {
+		t.Fatalf("InjectRunContext() error = %v", err)
+	}
+	if _, ok := args.GetFields()[runContextArgKey]; ok {
+		t.Fatalf("InjectRunContext() mutated input args")
+	}
+	rc := out.GetFields()[runContextArgKey].GetStructValue().GetFields()
+	if rc["buildNumber"].GetStringValue() != "3" || rc["jobFullName"].GetStringValue() != "job" {
+		t.Fatalf("runContext = %v", rc)
+	}
+
+	if _, err := InjectRunContext(args, RunContext{}); !errors.Is(err, ErrInvalidRunContext) {
+		t.Fatalf("InjectRunContext(empty) error = %v, want ErrInvalidRunContext", err)
+	}
+}
+
+func TestInvoke_InjectsRunContext(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in steprpcv1.InvokeRequest
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), &in); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		rc := in.GetArgs().GetFields()[runContextArgKey].GetStructValue().GetFields()
+		if rc["runExternalizableId"].GetStringValue() != "job#1" {
+			t.Fatalf("runContext = %v", rc)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"requestId":"r-1","runId":"rpc-1","state":"succeeded"}`))
+	}))
+	defer ts.Close()
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c = c.WithRunContext(NewRunContextForRun("job#1", "built-in", "/ws"))
+
+	req := &steprpcv1.InvokeRequest{RequestId: "r-1", Operation: "archiveArtifacts"}
+	if _, err := c.Invoke(context.Background(), req); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if req.GetArgs() != nil {
+		t.Fatalf("Invoke() mutated caller request args")
+	}
+}
@@ -0,0 +1,147 @@
+package rpcclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// defaultLogPollPolicy paces StreamRunLog while a run produces no output.
+// MaxAttempts bounds consecutive failed fetches.
+var defaultLogPollPolicy = PollPolicy{
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     5 * time.Second,
+	MaxAttempts:     5, //nolint:mnd // consecutive transient failures before giving up
+}
+
+// WithLogPollPolicy returns a copy of the client that uses p in StreamRunLog.
+// The intervals pace polls while the log is idle, MaxAttempts bounds
+// consecutive failed fetches, and MaxDuration bounds the whole stream.
+func (c *Client) WithLogPollPolicy(p PollPolicy) *Client {
+	cp := *c
+	cp.logPollPolicy = &p
+	return &cp
+}
+
+// GetRunResult fetches a run's result payload. The result is empty until the
+// run is terminal.
+func (c *Client) GetRunResult(ctx context.Context, runID string) (*steprpcv1.RunResultResponse, error) {
+	if strings.TrimSpace(runID) == "" {
+		return nil, fmt.Errorf("runID is required")
+	}
+	if err := c.requireCapability(CapabilityRunOutput); err != nil {
+		return nil, err
+	}
+
+	out := &steprpcv1.RunResultResponse{}
+	if err := c.getProto(ctx, "/step-rpc/v1/runs/"+url.PathEscape(runID)+"/result", out, "run result"); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRunLog fetches the part of a run's console log starting at offset bytes.
+func (c *Client) GetRunLog(ctx context.Context, runID string, offset int64) (*steprpcv1.RunLogResponse, error) {
+	if strings.TrimSpace(runID) == "" {
+		return nil, fmt.Errorf("runID is required")
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+	if err := c.requireCapability(CapabilityRunOutput); err != nil {
+		return nil, err
+	}
+
+	out := &steprpcv1.RunLogResponse{}
+	endpoint := "/step-rpc/v1/runs/" + url.PathEscape(runID) + "/log?offset=" + strconv.FormatInt(offset, 10)
+	if err := c.getProto(ctx, endpoint, out, "run log"); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreamRunLog copies a run's console log to w and follows it until the run is
+// terminal and the whole log has been written.
+//
+// Transport errors, 429, and 5xx responses are retried from the last offset
+// written, so w sees each byte once. Other errors, including ones from w, end
+// the stream.
+func (c *Client) StreamRunLog(ctx context.Context, runID string, w io.Writer) error {
+	if strings.TrimSpace(runID) == "" {
+		return fmt.Errorf("runID is required")
+	}
+	if err := c.requireCapability(CapabilityRunOutput); err != nil {
+		return err
+	}
+
+	policy := defaultLogPollPolicy
+	if c.logPollPolicy != nil {
+		policy = *c.logPollPolicy
+	}
+	if policy.InitialInterval <= 0 {
+		policy.InitialInterval = defaultLogPollPolicy.InitialInterval
+	}
+	if policy.MaxInterval < policy.InitialInterval {
+		policy.MaxInterval = policy.InitialInterval
+	}
+	if policy.MaxDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.MaxDuration)
+		defer cancel()
+	}
+
+	var offset int64
+	interval := policy.InitialInterval
+	failures := 0
+	for {
+		chunk, err := c.GetRunLog(ctx, runID, offset)
+		switch {
+		case err == nil:
+			failures = 0
+			if _, werr := io.WriteString(w, chunk.GetText()); werr != nil {
+				return fmt.Errorf("stream run log: write: %w", werr)
+			}
+			offset = max(offset, chunk.GetNextOffset())
+			if chunk.GetComplete() {
+				return nil
+			}
+			if chunk.GetText() != "" {
+				interval = policy.InitialInterval
+				continue
+			}
+		case transientError(ctx, err):
+			failures++
+			if policy.MaxAttempts > 0 && failures >= policy.MaxAttempts {
+				return fmt.Errorf("stream run log: %d consecutive failures at offset %d: %w", failures, offset, err)
+			}
+		default:
+			return fmt.Errorf("stream run log: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stream run log: %w", ctx.Err())
+		case <-time.After(pollJitter(interval)):
+		}
+		interval = min(interval*2, policy.MaxInterval)
+	}
+}
+
+// transientError reports whether a failed fetch is worth repeating.
+func transientError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch CategoryOf(err) {
+	case CategoryNetwork, CategoryRateLimited, CategoryServerError:
+		return true
+	default:
+		return false
+	}
+}
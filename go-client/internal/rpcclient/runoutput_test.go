@@ -0,0 +1,164 @@
+package rpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+func TestGetRunResult(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/step-rpc/v1/runs/rpc-1/result" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runId":"rpc-1","state":"succeeded","result":{"tests":{"total":12,"failed":1},"artifacts":["target/app.jar"]}}`))
+	}))
+	defer ts.Close()
+
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	out, err := c.GetRunResult(context.Background(), "rpc-1")
+	if err != nil {
+		t.Fatalf("GetRunResult() error = %v", err)
+	}
+	tests := out.GetResult().GetFields()["tests"].GetStructValue().GetFields()
+	if tests["total"].GetNumberValue() != 12 || tests["failed"].GetNumberValue() != 1 {
+		t.Fatalf("result = %v", out.GetResult())
+	}
+	if got := out.GetResult().GetFields()["artifacts"].GetListValue().GetValues()[0].GetStringValue(); got != "target/app.jar" {
+		t.Fatalf("artifacts[0] = %q", got)
+	}
+
+	hc := *c
+	hc.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: "v1", Capabilities: []string{"invoke", "runs"}})
+	if _, err := hc.GetRunResult(context.Background(), "rpc-1"); !errors.Is(err, ErrCapabilityUnsupported) {
+		t.Fatalf("GetRunResult() without run_output error = %v, want ErrCapabilityUnsupported", err)
+	}
+}
+
+// logServer serves a growing log that reveals step more bytes with each
+// successful response. The requests numbered in fail answer with that status,
+// or drop the connection for status 0.
+type logServer struct {
+	log  string
+	step int
+	fail map[int]int
+
+	mu      sync.Mutex
+	offsets []string
+	served  int
+}
+
+func (s *logServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := len(s.offsets)
+	s.offsets = append(s.offsets, r.URL.Query().Get("offset"))
+
+	if status, ok := s.fail[n]; ok {
+		if status == 0 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+		w.WriteHeader(status)
+		return
+	}
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	s.served++
+	available := min(len(s.log), s.served*s.step)
+	end := max(offset, available)
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprintf(w, `{"runId":"rpc-1","offset":"%d","text":%q,"nextOffset":"%d","complete":%t}`,
+		offset, s.log[offset:end], end, end == len(s.log))
+}
+
+func fastLogPolicy(maxAttempts int) PollPolicy {
+	return PollPolicy{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond, MaxAttempts: maxAttempts}
+}
+
+func TestStreamRunLog_FollowsAndResumes(t *testing.T) {
+	t.Parallel()
+
+	srv := &logServer{
+		log:  "line 1\nline 2\nline 3\n",
+		step: 7,
+		fail: map[int]int{0: http.StatusServiceUnavailable, 2: 0, 3: http.StatusBadGateway},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var out strings.Builder
+	if err := c.WithLogPollPolicy(fastLogPolicy(3)).StreamRunLog(context.Background(), "rpc-1", &out); err != nil {
+		t.Fatalf("StreamRunLog() error = %v", err)
+	}
+	if out.String() != srv.log {
+		t.Fatalf("log = %q, want %q", out.String(), srv.log)
+	}
+	// 503, 7 bytes, dropped connection and 502 (both resumed at 7), then the rest.
+	if got := strings.Join(srv.offsets, ","); got != "0,0,7,7,7,14" {
+		t.Fatalf("offsets = %s", got)
+	}
+}
+
+func TestStreamRunLog_Errors(t *testing.T) {
+	t.Parallel()
+
+	failing := &logServer{log: "x", step: 1, fail: map[int]int{0: 500, 1: 500, 2: 500, 3: 500}}
+	ts := httptest.NewServer(failing)
+	defer ts.Close()
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	err = c.WithLogPollPolicy(fastLogPolicy(3)).StreamRunLog(context.Background(), "rpc-1", &strings.Builder{})
+	if CategoryOf(err) != CategoryServerError || len(failing.offsets) != 3 {
+		t.Fatalf("StreamRunLog() error = %v after %d requests, want server error after 3", err, len(failing.offsets))
+	}
+
+	missing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":"run_not_found","message":"no run"}}`))
+	}))
+	defer missing.Close()
+	c, err = New(missing.URL, "", missing.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if err := c.StreamRunLog(context.Background(), "rpc-1", &strings.Builder{}); !errors.Is(err, ErrRunNotFound) {
+		t.Fatalf("StreamRunLog() error = %v, want ErrRunNotFound", err)
+	}
+
+	idle := &logServer{log: "never", step: 0}
+	ts = httptest.NewServer(idle)
+	defer ts.Close()
+	c, err = New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.WithLogPollPolicy(fastLogPolicy(0)).StreamRunLog(ctx, "rpc-1", &strings.Builder{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("StreamRunLog(idle) error = %v, want deadline exceeded", err)
+	}
+}
@@ -0,0 +1,139 @@
+package rpcclient
+
+import (
+	"strings"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+)
+
+// RunState is a run's lifecycle state. The values are the legacy state strings
+// servers send alongside the RunState enum.
+//
+// Allowed transitions:
+//
+//	queued  -> running, succeeded, failed, cancelled
+//	running -> succeeded, failed, cancelled
+//
+// succeeded, failed, and cancelled are terminal. RunStateUnknown stands for a
+// missing or unrecognized state.
+type RunState string
+
+// Run states.
+const (
+	RunStateUnknown   RunState = ""
+	RunStateQueued    RunState = "queued"
+	RunStateRunning   RunState = "running"
+	RunStateSucceeded RunState = "succeeded"
+	RunStateFailed    RunState = "failed"
+	RunStateCancelled RunState = "cancelled"
+)
+
+var runStateByProto = map[steprpcv1.RunState]RunState{
+	steprpcv1.RunState_RUN_STATE_QUEUED:    RunStateQueued,
+	steprpcv1.RunState_RUN_STATE_RUNNING:   RunStateRunning,
+	steprpcv1.RunState_RUN_STATE_SUCCEEDED: RunStateSucceeded,
+	steprpcv1.RunState_RUN_STATE_FAILED:    RunStateFailed,
+	steprpcv1.RunState_RUN_STATE_CANCELLED: RunStateCancelled,
+}
+
+// ParseRunState converts a legacy state string, ignoring case and surrounding
+// space. Unrecognized strings are RunStateUnknown.
+func ParseRunState(state string) RunState {
+	s := RunState(strings.ToLower(strings.TrimSpace(state)))
+	switch s {
+	case RunStateQueued, RunStateRunning, RunStateSucceeded, RunStateFailed, RunStateCancelled:
+		return s
+	default:
+		return RunStateUnknown
+	}
+}
+
+// RunStateFromProto converts the contract enum. UNSPECIFIED and values this
+// client does not know are RunStateUnknown.
+func RunStateFromProto(state steprpcv1.RunState) RunState {
+	return runStateByProto[state]
+}
+
+// RunStateMessage is implemented by the responses that carry a run state.
+type RunStateMessage interface {
+	GetRunState() steprpcv1.RunState
+	GetState() string
+}
+
+// RunStateOf returns the state of msg, preferring the run_state enum and
+// falling back to the legacy string for servers that do not send it.
+func RunStateOf(msg RunStateMessage) RunState {
+	if s := RunStateFromProto(msg.GetRunState()); s != RunStateUnknown {
+		return s
+	}
+	return ParseRunState(msg.GetState())
+}
+
+// Proto returns the contract enum for s.
+func (s RunState) Proto() steprpcv1.RunState {
+	for p, state := range runStateByProto {
+		if state == s {
+			return p
+		}
+	}
+	return steprpcv1.RunState_RUN_STATE_UNSPECIFIED
+}
+
+// IsTerminal reports whether s is succeeded, failed, or cancelled.
+func (s RunState) IsTerminal() bool {
+	switch s {
+	case RunStateSucceeded, RunStateFailed, RunStateCancelled:
+		return true
+	case RunStateUnknown, RunStateQueued, RunStateRunning:
+		return false
+	default:
+		return false
+	}
+}
+
+// IsSuccess reports whether s is succeeded.
+func (s RunState) IsSuccess() bool {
+	return s == RunStateSucceeded
+}
+
+// CanTransition reports whether the state machine allows a run in s to be
+// observed next in state to. Staying in the same state is allowed, as is any
+// transition involving RunStateUnknown, which cannot be judged.
+func (s RunState) CanTransition(to RunState) bool {
+	if s == to || s == RunStateUnknown || to == RunStateUnknown {
+		return true
+	}
+	switch s {
+	case RunStateQueued:
+		return to == RunStateRunning || to.IsTerminal()
+	case RunStateRunning:
+		return to.IsTerminal()
+	case RunStateSucceeded, RunStateFailed, RunStateCancelled:
+		return false
+	default:
+		return true
+	}
+}
+
+// ObserveTransition reports whether the state machine allows runID to move
+// from one observed state to the next, and calls the debug hook's
+// OnIllegalTransition when it does not. WaitRunTerminal calls it for every
+// status it observes; callers that poll on their own can too.
+func (c *Client) ObserveTransition(runID string, from, to RunState) bool {
+	if from.CanTransition(to) {
+		return true
+	}
+	if c.debugHook != nil && c.debugHook.OnIllegalTransition != nil {
+		c.debugHook.OnIllegalTransition(runID, from, to)
+	}
+	return false
+}
+
+// stateName is msg's legacy state string, derived from run_state when the
+// server sent only the enum.
+func stateName(msg RunStateMessage) string {
+	if s := msg.GetState(); s != "" {
+		return s
+	}
+	return string(RunStateFromProto(msg.GetRunState()))
+}
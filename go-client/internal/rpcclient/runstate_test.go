@@ -0,0 +1,181 @@
+package rpcclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRunState(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]RunState{
+		"queued":        RunStateQueued,
+		" Running ":     RunStateRunning,
+		"SUCCEEDED":     RunStateSucceeded,
+		"failed":        RunStateFailed,
+		"cancelled":     RunStateCancelled,
+		"":              RunStateUnknown,
+		"paused-by-bot": RunStateUnknown,
+	} {
+		if got := ParseRunState(in); got != want {
+			t.Errorf("ParseRunState(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	for _, s := range []RunState{RunStateQueued, RunStateRunning, RunStateSucceeded, RunStateFailed, RunStateCancelled} {
+		if got := RunStateFromProto(s.Proto()); got != s {
+			t.Errorf("RunStateFromProto(%s.Proto()) = %q", s, got)
+		}
+	}
+	if RunStateUnknown.Proto() != steprpcv1.RunState_RUN_STATE_UNSPECIFIED || RunStateFromProto(steprpcv1.RunState(42)) != RunStateUnknown {
+		t.Fatalf("unknown states do not map to UNSPECIFIED")
+	}
+
+	if !RunStateSucceeded.IsSuccess() || RunStateFailed.IsSuccess() {
+		t.Fatalf("IsSuccess() wrong")
+	}
+	if !RunStateCancelled.IsTerminal() || RunStateRunning.IsTerminal() || RunStateUnknown.IsTerminal() {
+		t.Fatalf("IsTerminal() wrong")
+	}
+
+	// The enum wins over the legacy string; the string is the fallback.
+	both := &steprpcv1.RunStatusResponse{State: "running", RunState: steprpcv1.RunState_RUN_STATE_FAILED}
+	legacy := &steprpcv1.InvokeResponse{State: "Queued"}
+	if RunStateOf(both) != RunStateFailed || RunStateOf(legacy) != RunStateQueued {
+		t.Fatalf("RunStateOf() = %q, %q", RunStateOf(both), RunStateOf(legacy))
+	}
+}
+
+func TestRunState_CanTransition(t *testing.T) {
+	t.Parallel()
+
+	all := []RunState{RunStateQueued, RunStateRunning, RunStateSucceeded, RunStateFailed, RunStateCancelled}
+	allowed := map[[2]RunState]bool{
+		{RunStateQueued, RunStateRunning}:      true,
+		{RunStateQueued, RunStateSucceeded}:    true,
+		{RunStateQueued, RunStateFailed}:       true,
+		{RunStateQueued, RunStateCancelled}:    true,
+		{RunStateRunning, RunStateSucceeded}:   true,
+		{RunStateRunning, RunStateFailed}:      true,
+		{RunStateRunning, RunStateCancelled}:   true,
+		{RunStateSucceeded, RunStateSucceeded}: true,
+	}
+	for _, from := range all {
+		for _, to := range all {
+			want := allowed[[2]RunState{from, to}] || from == to
+			if got := from.CanTransition(to); got != want {
+				t.Errorf("%s.CanTransition(%s) = %v, want %v", from, to, got, want)
+			}
+		}
+		if !from.CanTransition(RunStateUnknown) || !RunStateUnknown.CanTransition(from) {
+			t.Errorf("transitions with unknown from %s should not be judged", from)
+		}
+	}
+}
+
+// sequenceServer answers run status polls with bodies in order, repeating the
+// last one.
+func sequenceServer(t *testing.T, bodies ...string) *httptest.Server {
+	t.Helper()
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		i := min(int(atomic.AddInt32(&calls, 1))-1, len(bodies)-1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(bodies[i]))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestWaitRunTerminal_ReportsIllegalTransitions(t *testing.T) {
+	t.Parallel()
+
+	ts := sequenceServer(t,
+		`{"runId":"rpc-1","state":"running","runState":"RUN_STATE_RUNNING"}`,
+		`{"runId":"rpc-1","state":"queued"}`,
+		`{"runId":"rpc-1","runState":"RUN_STATE_SUCCEEDED"}`,
+	)
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	var mu sync.Mutex
+	var seen []string
+	c = c.WithDebugHook(&DebugHook{OnIllegalTransition: func(runID string, from, to RunState) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, fmt.Sprintf("%s:%s->%s", runID, from, to))
+	}})
+
+	status, err := c.WaitRunTerminal(context.Background(), "rpc-1", PollPolicy{InitialInterval: time.Millisecond, FailOnRunFailure: true})
+	if err != nil {
+		t.Fatalf("WaitRunTerminal() error = %v, want enum-only succeeded to count as success", err)
+	}
+	if RunStateOf(status) != RunStateSucceeded {
+		t.Fatalf("state = %q", RunStateOf(status))
+	}
+	if fmt.Sprint(seen) != "[rpc-1:running->queued]" {
+		t.Fatalf("illegal transitions = %v", seen)
+	}
+}
+
+func TestCompleteBridgeRequest_StateForms(t *testing.T) {
+	t.Parallel()
+
+	got := make(chan *steprpcv1.BridgeCompleteRequest, 3)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		in := &steprpcv1.BridgeCompleteRequest{}
+		if err := protojson.Unmarshal(mustReadAll(t, r.Body), in); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+		}
+		got <- in
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"runId":"rpc-1","state":"failed","runState":"RUN_STATE_FAILED"}`))
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// Without a handshake the enum is not sent; it is derived into state.
+	enumOnly := &steprpcv1.BridgeCompleteRequest{RunId: "rpc-1", RunState: steprpcv1.RunState_RUN_STATE_CANCELLED}
+	if _, err := c.CompleteBridgeRequest(context.Background(), enumOnly); err != nil {
+		t.Fatalf("CompleteBridgeRequest() error = %v", err)
+	}
+	if in := <-got; in.GetState() != "cancelled" || in.GetRunState() != steprpcv1.RunState_RUN_STATE_UNSPECIFIED {
+		t.Fatalf("sent %v, want state only", in)
+	}
+	if enumOnly.GetState() != "" {
+		t.Fatalf("CompleteBridgeRequest() modified the caller's request")
+	}
+
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge"}})
+	if _, err := c.CompleteBridgeRequest(context.Background(), enumOnly); err != nil {
+		t.Fatalf("CompleteBridgeRequest() error = %v", err)
+	}
+	if in := <-got; in.GetRunState() != steprpcv1.RunState_RUN_STATE_UNSPECIFIED {
+		t.Fatalf("sent run_state %v to a server without %s", in.GetRunState(), CapabilityRunState)
+	}
+
+	c.capabilities = newCapabilities(&steprpcv1.HealthResponse{ApiVersion: SupportedAPIVersion, Capabilities: []string{"bridge", "run_state"}})
+	legacy := &steprpcv1.BridgeCompleteRequest{RunId: "rpc-1", State: "failed"}
+	if _, err := c.CompleteBridgeRequest(context.Background(), legacy); err != nil {
+		t.Fatalf("CompleteBridgeRequest() error = %v", err)
+	}
+	if in := <-got; in.GetState() != "failed" || in.GetRunState() != steprpcv1.RunState_RUN_STATE_FAILED {
+		t.Fatalf("sent %v, want both forms", in)
+	}
+	if legacy.GetRunState() != steprpcv1.RunState_RUN_STATE_UNSPECIFIED {
+		t.Fatalf("CompleteBridgeRequest() modified the caller's request")
+	}
+}
@@ -0,0 +1,105 @@
+package rpcclient
+
+import (
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunTimeline is a run's lifecycle as reported in RunStatusResponse. Times the
+// server did not report are zero.
+type RunTimeline struct {
+	RunID       string
+	Operation   string
+	State       RunState
+	Lane        steprpcv1.OperationExecutionMode
+	Attempts    int
+	WorkerID    string
+	CreatedAt   time.Time
+	StartedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt time.Time
+}
+
+// NewRunTimeline extracts the timeline of status.
+func NewRunTimeline(status *steprpcv1.RunStatusResponse) RunTimeline {
+	return RunTimeline{
+		RunID:       status.GetRunId(),
+		Operation:   status.GetOperation(),
+		State:       RunStateOf(status),
+		Lane:        status.GetExecutionMode(),
+		Attempts:    int(status.GetAttempts()),
+		WorkerID:    status.GetWorkerId(),
+		CreatedAt:   timestampTime(status.GetCreatedAt()),
+		StartedAt:   timestampTime(status.GetStartedAt()),
+		UpdatedAt:   timestampTime(status.GetUpdatedAt()),
+		CompletedAt: timestampTime(status.GetCompletedAt()),
+	}
+}
+
+// Terminal reports whether the run has reached a terminal state.
+func (t RunTimeline) Terminal() bool {
+	return t.State.IsTerminal()
+}
+
+// QueueDuration is the time from creation to the start of execution, or to now
+// while the run is still queued. A run that completed without starting, such as
+// one cancelled while queued, was queued until it completed.
+func (t RunTimeline) QueueDuration(now time.Time) time.Duration {
+	end := t.StartedAt
+	if end.IsZero() {
+		end = t.CompletedAt
+	}
+	return span(t.CreatedAt, end, now)
+}
+
+// ExecDuration is the time from the start of execution to completion, or to
+// now while the run executes. It is zero before execution starts.
+func (t RunTimeline) ExecDuration(now time.Time) time.Duration {
+	return span(t.StartedAt, t.CompletedAt, now)
+}
+
+// TotalDuration is the time from creation to completion, or to now while the
+// run is not terminal.
+func (t RunTimeline) TotalDuration(now time.Time) time.Duration {
+	return span(t.CreatedAt, t.CompletedAt, now)
+}
+
+// TimeInState is how long the run has been in its current state: since the
+// last update, falling back to the start and creation times.
+func (t RunTimeline) TimeInState(now time.Time) time.Duration {
+	last := t.UpdatedAt
+	if last.IsZero() {
+		last = t.StartedAt
+	}
+	if last.IsZero() {
+		last = t.CreatedAt
+	}
+	return span(last, time.Time{}, now)
+}
+
+// Stuck reports whether a non-terminal run has been in its state for longer
+// than threshold, such as a bridge request no worker picks up.
+func (t RunTimeline) Stuck(now time.Time, threshold time.Duration) bool {
+	return !t.Terminal() && t.TimeInState(now) > threshold
+}
+
+// span is end - start, using now when end is unset. It is zero when start is
+// unset or the result would be negative.
+func span(start, end, now time.Time) time.Duration {
+	if start.IsZero() {
+		return 0
+	}
+	if end.IsZero() {
+		end = now
+	}
+	return max(end.Sub(start), 0)
+}
+
+func timestampTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,73 @@
+package rpcclient
+
+import (
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRunTimeline(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) string { return base.Add(d).Format(time.RFC3339Nano) }
+	decode := func(body string) RunTimeline {
+		t.Helper()
+		status := &steprpcv1.RunStatusResponse{}
+		if err := protojson.Unmarshal([]byte(body), status); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		return NewRunTimeline(status)
+	}
+	now := base.Add(time.Hour)
+
+	done := decode(`{"runId":"rpc-1","operation":"junit","state":"succeeded",` +
+		`"createdAt":"` + at(0) + `","startedAt":"` + at(90*time.Second) + `",` +
+		`"updatedAt":"` + at(2*time.Minute) + `","completedAt":"` + at(2*time.Minute) + `",` +
+		`"attempts":2,"executionMode":"OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED","workerId":"sidecar-1"}`)
+	if done.Attempts != 2 || done.WorkerID != "sidecar-1" || done.Lane != steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED {
+		t.Fatalf("timeline = %+v", done)
+	}
+	if got := done.QueueDuration(now); got != 90*time.Second {
+		t.Fatalf("QueueDuration() = %s, want 1m30s", got)
+	}
+	if got := done.ExecDuration(now); got != 30*time.Second {
+		t.Fatalf("ExecDuration() = %s, want 30s", got)
+	}
+	if got := done.TotalDuration(now); got != 2*time.Minute {
+		t.Fatalf("TotalDuration() = %s, want 2m", got)
+	}
+	if done.Stuck(now, time.Minute) {
+		t.Fatalf("Stuck() = true for a terminal run")
+	}
+
+	queued := decode(`{"runId":"rpc-2","state":"queued","createdAt":"` + at(0) + `","updatedAt":"` + at(0) + `"}`)
+	if got := queued.QueueDuration(now); got != time.Hour {
+		t.Fatalf("QueueDuration(queued) = %s, want 1h", got)
+	}
+	if got := queued.ExecDuration(now); got != 0 {
+		t.Fatalf("ExecDuration(queued) = %s, want 0", got)
+	}
+	if !queued.Stuck(now, 10*time.Minute) || queued.Stuck(now, 2*time.Hour) {
+		t.Fatalf("Stuck() wrong for a run queued 1h")
+	}
+
+	cancelled := decode(`{"runId":"rpc-4","state":"cancelled","createdAt":"` + at(0) + `","completedAt":"` + at(5*time.Minute) + `"}`)
+	if got := cancelled.QueueDuration(now); got != 5*time.Minute {
+		t.Fatalf("QueueDuration(cancelled while queued) = %s, want 5m", got)
+	}
+	if got := cancelled.ExecDuration(now); got != 0 {
+		t.Fatalf("ExecDuration(cancelled while queued) = %s, want 0", got)
+	}
+
+	// Servers that predate the lifecycle fields only send createdAt.
+	legacy := decode(`{"runId":"rpc-3","state":"queued","createdAt":"` + at(50*time.Minute) + `"}`)
+	if got := legacy.TimeInState(now); got != 10*time.Minute {
+		t.Fatalf("TimeInState(legacy) = %s, want 10m", got)
+	}
+	if got := (RunTimeline{}).TotalDuration(now); got != 0 {
+		t.Fatalf("TotalDuration(empty) = %s, want 0", got)
+	}
+}
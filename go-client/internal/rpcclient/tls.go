@@ -0,0 +1,217 @@
+package rpcclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// spkiPinPrefix is the optional HPKP-style prefix accepted on SPKI pins.
+const spkiPinPrefix = "sha256/"
+
+// ErrPinMismatch is returned from the TLS handshake when no certificate in the
+// server's chain matches a configured SPKI pin.
+var ErrPinMismatch = errors.New("server certificate does not match any SPKI pin")
+
+// TLSOptions configures server verification and client certificates.
+type TLSOptions struct {
+	// CAFile and CAPEM are PEM bundles trusted in addition to the system roots.
+	CAFile string
+	CAPEM  []byte
+	// CertFile and KeyFile hold the client certificate for mTLS. They are
+	// re-read on the next handshake after either file changes, so rotated
+	// certificates are picked up without restarting.
+	CertFile string
+	KeyFile  string
+	// MinVersion is the minimum TLS version, e.g. tls.VersionTLS13. Zero means TLS 1.2.
+	MinVersion uint16
+	// ServerName overrides the name verified against the server certificate.
+	ServerName string
+	// SPKIPins are base64 SHA-256 digests of SubjectPublicKeyInfo, optionally
+	// prefixed with "sha256/". When set, the verified chain must contain a
+	// certificate matching one of them. See SPKIPin.
+	SPKIPins []string
+	// InsecureSkipVerify disables chain and name verification. SPKI pins are
+	// still enforced.
+	InsecureSkipVerify bool
+}
+
+// SPKIPin returns the pin for cert in the form SPKIPins accepts.
+func SPKIPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return spkiPinPrefix + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// NewTLSConfig builds a *tls.Config from opts.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         opts.ServerName,
+		InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // explicit caller opt-in
+	}
+	if opts.MinVersion != 0 {
+		if opts.MinVersion < tls.VersionTLS12 {
+			return nil, fmt.Errorf("tls min version %s is not supported", tls.VersionName(opts.MinVersion))
+		}
+		cfg.MinVersion = opts.MinVersion
+	}
+
+	if opts.CAFile != "" || len(opts.CAPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if opts.CAFile != "" {
+			pem, err := os.ReadFile(opts.CAFile) //nolint:gosec // path comes from caller config
+			if err != nil {
+				return nil, fmt.Errorf("read CA file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no PEM certificates in %s", opts.CAFile)
+			}
+		}
+		if len(opts.CAPEM) > 0 && !pool.AppendCertsFromPEM(opts.CAPEM) {
+			return nil, fmt.Errorf("no PEM certificates in CA bytes")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, fmt.Errorf("client certificate and key files must be set together")
+	}
+	if opts.CertFile != "" {
+		reloader := &certReloader{certFile: opts.CertFile, keyFile: opts.KeyFile}
+		if _, err := reloader.certificate(); err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return reloader.certificate()
+		}
+	}
+
+	if len(opts.SPKIPins) > 0 {
+		pins, err := decodePins(opts.SPKIPins)
+		if err != nil {
+			return nil, err
+		}
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyPins(cs, pins)
+		}
+	}
+	return cfg, nil
+}
+
+// WithTLS returns a copy of the client whose transport uses opts. The current
+// transport is cloned when it is an *http.Transport, so proxy and timeout
+// settings carry over; otherwise http.DefaultTransport is cloned.
+func (c *Client) WithTLS(opts TLSOptions) (*Client, error) {
+	tlsConfig, err := NewTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	base, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	cp := *c
+	cp.httpClient = &httpClient
+	return &cp, nil
+}
+
+func decodePins(pins []string) ([][]byte, error) {
+	out := make([][]byte, 0, len(pins))
+	for _, pin := range pins {
+		sum, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, spkiPinPrefix))
+		if err != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("invalid SPKI pin %q: want base64 SHA-256", pin)
+		}
+		out = append(out, sum)
+	}
+	return out, nil
+}
+
+// verifyPins checks the verified chains when available, falling back to the
+// presented certificates when verification is skipped.
+func verifyPins(cs tls.ConnectionState, pins [][]byte) error {
+	candidates := cs.PeerCertificates
+	if len(cs.VerifiedChains) > 0 {
+		candidates = nil
+		for _, chain := range cs.VerifiedChains {
+			candidates = append(candidates, chain...)
+		}
+	}
+	for _, cert := range candidates {
+		sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		for _, pin := range pins {
+			if bytes.Equal(sum[:], pin) {
+				return nil
+			}
+		}
+	}
+	return ErrPinMismatch
+}
+
+// certReloader serves a client certificate from disk, reloading it when the
+// certificate or key file's modification time or size changes. A failed reload
+// keeps serving the last good certificate.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certSig fileSig
+	keySig  fileSig
+}
+
+type fileSig struct {
+	modTime time.Time
+	size    int64
+}
+
+func (s fileSig) equal(o fileSig) bool {
+	return s.modTime.Equal(o.modTime) && s.size == o.size
+}
+
+func statSig(path string) (fileSig, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileSig{}, err
+	}
+	return fileSig{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+func (r *certReloader) certificate() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	certSig, certErr := statSig(r.certFile)
+	keySig, keyErr := statSig(r.keyFile)
+	if r.cert != nil && (certErr != nil || keyErr != nil || (certSig.equal(r.certSig) && keySig.equal(r.keySig))) {
+		return r.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		if r.cert != nil {
+			return r.cert, nil
+		}
+		return nil, fmt.Errorf("load client certificate: %w", err)
+	}
+	r.cert, r.certSig, r.keySig = &cert, certSig, keySig
+	return r.cert, nil
+}
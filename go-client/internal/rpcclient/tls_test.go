@@ -0,0 +1,292 @@
+package rpcclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testCA issues leaf certificates for TLS tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	return key
+}
+
+// issue signs a leaf certificate for cn and returns it with its PEM cert and key.
+func (ca *testCA) issue(t *testing.T, cn string, usage x509.ExtKeyUsage, dnsNames []string, ips []net.IP) (tls.Certificate, []byte, []byte) {
+	t.Helper()
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() error = %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("X509KeyPair() error = %v", err)
+	}
+	return pair, certPEM, keyPEM
+}
+
+// tlsServer starts a catalog server using cfg and a certificate from ca for
+// jenkins.internal and 127.0.0.1. Keep-alives are off so every request
+// handshakes; the last client certificate CN is stored in clientCN.
+func tlsServer(t *testing.T, ca *testCA, cfg *tls.Config, clientCN *atomic.Value) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientCN != nil && r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			clientCN.Store(r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operations":[]}`))
+	}))
+	if cfg == nil {
+		cfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	serverCert, _, _ := ca.issue(t, "jenkins", x509.ExtKeyUsageServerAuth, []string{"jenkins.internal"}, []net.IP{net.IPv4(127, 0, 0, 1)})
+	cfg.Certificates = []tls.Certificate{serverCert}
+	ts.TLS = cfg
+	ts.Config.SetKeepAlivesEnabled(false)
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newTLSClient(t *testing.T, url string, opts TLSOptions) *Client {
+	t.Helper()
+	c, err := New(url, "", &http.Client{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	c, err = c.WithTLS(opts)
+	if err != nil {
+		t.Fatalf("WithTLS() error = %v", err)
+	}
+	return c
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+}
+
+func TestWithTLS_MutualTLSReloadsClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	var clientCN atomic.Value
+	ts := tlsServer(t, ca, &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}, &clientCN)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem")
+	now := time.Now()
+	_, certPEM, keyPEM := ca.issue(t, "agent-1", x509.ExtKeyUsageClientAuth, nil, nil)
+	writeFile(t, certFile, certPEM, now)
+	writeFile(t, keyFile, keyPEM, now)
+
+	c := newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem, CertFile: certFile, KeyFile: keyFile})
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	if got := clientCN.Load(); got != "agent-1" {
+		t.Fatalf("client CN = %v, want agent-1", got)
+	}
+
+	_, certPEM, keyPEM = ca.issue(t, "agent-2", x509.ExtKeyUsageClientAuth, nil, nil)
+	writeFile(t, certFile, certPEM, now.Add(time.Minute))
+	writeFile(t, keyFile, keyPEM, now.Add(time.Minute))
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() after rotation error = %v", err)
+	}
+	if got := clientCN.Load(); got != "agent-2" {
+		t.Fatalf("client CN = %v, want rotated agent-2", got)
+	}
+
+	// A broken rotation keeps serving the last good certificate.
+	writeFile(t, keyFile, []byte("not a key"), now.Add(2*time.Minute))
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() after bad rotation error = %v", err)
+	}
+	if got := clientCN.Load(); got != "agent-2" {
+		t.Fatalf("client CN = %v, want agent-2 kept", got)
+	}
+
+	// Without a client certificate the server rejects the handshake.
+	anon := newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem})
+	if _, err := anon.GetCatalog(context.Background()); err == nil {
+		t.Fatalf("GetCatalog() without client cert error = nil, want handshake failure")
+	}
+}
+
+func TestWithTLS_CAFile(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	ts := tlsServer(t, ca, nil, nil)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writeFile(t, caFile, ca.pem, time.Now())
+
+	if _, err := newTLSClient(t, ts.URL, TLSOptions{CAFile: caFile}).GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	if _, err := newTLSClient(t, ts.URL, TLSOptions{}).GetCatalog(context.Background()); err == nil {
+		t.Fatalf("GetCatalog() without CA error = nil, want unknown authority")
+	}
+}
+
+func TestWithTLS_SPKIPins(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	ts := tlsServer(t, ca, nil, nil)
+
+	// Pinning the issuing CA matches through the verified chain.
+	c := newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem, SPKIPins: []string{SPKIPin(ca.cert)}})
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+
+	other := newTestCA(t)
+	c = newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem, SPKIPins: []string{SPKIPin(other.cert)}})
+	if _, err := c.GetCatalog(context.Background()); !errors.Is(err, ErrPinMismatch) {
+		t.Fatalf("GetCatalog() error = %v, want ErrPinMismatch", err)
+	}
+
+	// Pins are still enforced when chain verification is skipped.
+	c = newTLSClient(t, ts.URL, TLSOptions{InsecureSkipVerify: true, SPKIPins: []string{SPKIPin(other.cert)}})
+	if _, err := c.GetCatalog(context.Background()); !errors.Is(err, ErrPinMismatch) {
+		t.Fatalf("GetCatalog() insecure error = %v, want ErrPinMismatch", err)
+	}
+}
+
+func TestWithTLS_ServerNameOverride(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	ts := tlsServer(t, ca, nil, nil)
+	_, port, err := net.SplitHostPort(ts.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("SplitHostPort() error = %v", err)
+	}
+	url := "https://localhost:" + port
+
+	// The certificate names jenkins.internal and 127.0.0.1 but not localhost.
+	if _, err := newTLSClient(t, url, TLSOptions{CAPEM: ca.pem}).GetCatalog(context.Background()); err == nil {
+		t.Fatalf("GetCatalog() error = nil, want name mismatch")
+	}
+	c := newTLSClient(t, url, TLSOptions{CAPEM: ca.pem, ServerName: "jenkins.internal"})
+	if _, err := c.GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() with ServerName error = %v", err)
+	}
+}
+
+func TestWithTLS_MinVersion(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	ts := tlsServer(t, ca, &tls.Config{MinVersion: tls.VersionTLS12, MaxVersion: tls.VersionTLS12}, nil)
+
+	if _, err := newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem}).GetCatalog(context.Background()); err != nil {
+		t.Fatalf("GetCatalog() error = %v", err)
+	}
+	c := newTLSClient(t, ts.URL, TLSOptions{CAPEM: ca.pem, MinVersion: tls.VersionTLS13})
+	if _, err := c.GetCatalog(context.Background()); err == nil {
+		t.Fatalf("GetCatalog() error = nil, want protocol version failure")
+	}
+}
+
+func TestNewTLSConfig_RejectsInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts TLSOptions
+	}{
+		{"old min version", TLSOptions{MinVersion: tls.VersionTLS11}},
+		{"bad pin", TLSOptions{SPKIPins: []string{"sha256/not-base64!"}}},
+		{"short pin", TLSOptions{SPKIPins: []string{"c2hvcnQ="}}},
+		{"cert without key", TLSOptions{CertFile: "client.pem"}},
+		{"missing cert", TLSOptions{CertFile: "/nonexistent/c.pem", KeyFile: "/nonexistent/k.pem"}},
+		{"bad CA bytes", TLSOptions{CAPEM: []byte("nope")}},
+		{"missing CA file", TLSOptions{CAFile: "/nonexistent/ca.pem"}},
+	}
+	for _, tt := range tests {
+		if _, err := NewTLSConfig(tt.opts); err == nil {
+			t.Fatalf("%s: NewTLSConfig() error = nil, want error", tt.name)
+		}
+	}
+}
@@ -5,11 +5,15 @@
 package jenkinsrpc
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
 
 	"github.com/albertocavalcante/jenkins-rpc/go-client/internal/rpcclient"
 
 	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // Client is the HTTP client for the Jenkins Step RPC plugin API.
@@ -27,6 +31,150 @@ type DebugHook = rpcclient.DebugHook
 // HTTPError represents a non-2xx HTTP response with optional structured error details.
 type HTTPError = rpcclient.HTTPError
 
+// Result is the unified outcome of Execute across both execution lanes.
+type Result = rpcclient.Result
+
+// Timings records where time was spent during Execute.
+type Timings = rpcclient.Timings
+
+// RunContext identifies the Jenkins build an operation executes against.
+type RunContext = rpcclient.RunContext
+
+// ErrorCode is a machine-readable error code carried in Error.code.
+type ErrorCode = rpcclient.ErrorCode
+
+// ErrorDetails exposes Error.details with typed accessors.
+type ErrorDetails = rpcclient.ErrorDetails
+
+// RunError is a run-level error reported in InvokeResponse or RunStatusResponse.
+type RunError = rpcclient.RunError
+
+// RunFailedError is returned by WaitRunTerminal when PollPolicy.FailOnRunFailure is set.
+type RunFailedError = rpcclient.RunFailedError
+
+// Journal durably records invocation progress so Resume can recover in-flight work.
+type Journal = rpcclient.Journal
+
+// JournalEntry is one durable record of invocation progress.
+type JournalEntry = rpcclient.JournalEntry
+
+// JournalEntryKind identifies the step of an invocation a JournalEntry records.
+type JournalEntryKind = rpcclient.JournalEntryKind
+
+// FileJournal is a Journal backed by an append-only JSON lines file.
+type FileJournal = rpcclient.FileJournal
+
+// ResumeResult is the outcome of one invocation recovered by Resume.
+type ResumeResult = rpcclient.ResumeResult
+
+// BatchResult is the outcome of one InvokeBatch request.
+type BatchResult = rpcclient.BatchResult
+
+// MaxBatchSize is the most requests InvokeBatch sends in one round trip.
+const MaxBatchSize = rpcclient.MaxBatchSize
+
+// BridgeHandler executes one claimed bridge request for ServeBridgeRequest and
+// returns its result.
+type BridgeHandler = rpcclient.BridgeHandler
+
+// BridgeLease identifies a lease held on a claimed bridge request.
+type BridgeLease = rpcclient.BridgeLease
+
+// DefaultBridgeLeaseTTL is the lease length servers grant when asked for none.
+const DefaultBridgeLeaseTTL = rpcclient.DefaultBridgeLeaseTTL
+
+// DefaultBridgeWait is the long-poll wait NextBridgeRequest uses by default.
+const DefaultBridgeWait = rpcclient.DefaultBridgeWait
+
+// RunTimeline is a run's lifecycle as reported in RunStatusResponse.
+type RunTimeline = rpcclient.RunTimeline
+
+// RunState is a run's lifecycle state; see rpcclient.RunState for the state
+// machine.
+type RunState = rpcclient.RunState
+
+// RunStateMessage is implemented by the responses that carry a run state.
+type RunStateMessage = rpcclient.RunStateMessage
+
+// Run states.
+const (
+	RunStateUnknown   = rpcclient.RunStateUnknown
+	RunStateQueued    = rpcclient.RunStateQueued
+	RunStateRunning   = rpcclient.RunStateRunning
+	RunStateSucceeded = rpcclient.RunStateSucceeded
+	RunStateFailed    = rpcclient.RunStateFailed
+	RunStateCancelled = rpcclient.RunStateCancelled
+)
+
+// Pool holds clients for many controllers and routes calls between them.
+type Pool = rpcclient.Pool
+
+// Controller is one Jenkins controller in a Pool.
+type Controller = rpcclient.Controller
+
+// RouteKey selects the controller for an invocation.
+type RouteKey = rpcclient.RouteKey
+
+// EndpointHealth is the last known health of one controller endpoint.
+type EndpointHealth = rpcclient.EndpointHealth
+
+// MergedCatalog answers which controllers support an operation, and how.
+type MergedCatalog = rpcclient.MergedCatalog
+
+// OperationSupport is one controller's support for an operation.
+type OperationSupport = rpcclient.OperationSupport
+
+// InvokePolicy authorizes invocations before they are sent; see package policy.
+type InvokePolicy = rpcclient.InvokePolicy
+
+// RunNotifier lets WaitRunTerminal finish on pushed events; see package webhook.
+type RunNotifier = rpcclient.RunNotifier
+
+// TLSOptions configures server verification, client certificates, and SPKI pins.
+type TLSOptions = rpcclient.TLSOptions
+
+const (
+	JournalIntent   = rpcclient.JournalIntent
+	JournalAccepted = rpcclient.JournalAccepted
+	JournalTerminal = rpcclient.JournalTerminal
+)
+
+const (
+	CodeBadRequest          = rpcclient.CodeBadRequest
+	CodeBadJSON             = rpcclient.CodeBadJSON
+	CodeOperationNotAllowed = rpcclient.CodeOperationNotAllowed
+	CodeOperationNotFound   = rpcclient.CodeOperationNotFound
+	CodeOperationFailed     = rpcclient.CodeOperationFailed
+	CodeRunNotFound         = rpcclient.CodeRunNotFound
+	CodeNoPendingRequest    = rpcclient.CodeNoPendingRequest
+	CodeBatchTooLarge       = rpcclient.CodeBatchTooLarge
+	CodeLeaseExpired        = rpcclient.CodeLeaseExpired
+	CodeLeaseMismatch       = rpcclient.CodeLeaseMismatch
+)
+
+// Capability names a server feature advertised in HealthResponse.capabilities.
+type Capability = rpcclient.Capability
+
+// Capabilities is the feature set negotiated with a server during Handshake.
+type Capabilities = rpcclient.Capabilities
+
+// SupportedAPIVersion is the HealthResponse.api_version this client speaks.
+const SupportedAPIVersion = rpcclient.SupportedAPIVersion
+
+const (
+	CapabilityInvoke          = rpcclient.CapabilityInvoke
+	CapabilityRunStatus       = rpcclient.CapabilityRunStatus
+	CapabilityCatalog         = rpcclient.CapabilityCatalog
+	CapabilityCPSBridge       = rpcclient.CapabilityCPSBridge
+	CapabilityWebhooks        = rpcclient.CapabilityWebhooks
+	CapabilityBatchInvoke     = rpcclient.CapabilityBatchInvoke
+	CapabilityRunOutput       = rpcclient.CapabilityRunOutput
+	CapabilityBridgeLeases    = rpcclient.CapabilityBridgeLeases
+	CapabilityBridgeResults   = rpcclient.CapabilityBridgeResults
+	CapabilityBridgeDiscovery = rpcclient.CapabilityBridgeDiscovery
+	CapabilityRunState        = rpcclient.CapabilityRunState
+)
+
 // ErrorCategory classifies HTTP errors into broad operational categories.
 type ErrorCategory = rpcclient.ErrorCategory
 
@@ -40,11 +188,79 @@ const (
 	CategoryServerError = rpcclient.CategoryServerError
 )
 
+var (
+	ErrOperationNotInCatalog = rpcclient.ErrOperationNotInCatalog
+	ErrOperationUndiscovered = rpcclient.ErrOperationUndiscovered
+	ErrRunContextRequired    = rpcclient.ErrRunContextRequired
+	ErrInvalidRunContext     = rpcclient.ErrInvalidRunContext
+
+	ErrBadRequest          = rpcclient.ErrBadRequest
+	ErrBadJSON             = rpcclient.ErrBadJSON
+	ErrOperationNotAllowed = rpcclient.ErrOperationNotAllowed
+	ErrOperationNotFound   = rpcclient.ErrOperationNotFound
+	ErrOperationFailed     = rpcclient.ErrOperationFailed
+	ErrRunNotFound         = rpcclient.ErrRunNotFound
+	ErrNoPendingRequest    = rpcclient.ErrNoPendingRequest
+	ErrBatchTooLarge       = rpcclient.ErrBatchTooLarge
+	ErrLeaseExpired        = rpcclient.ErrLeaseExpired
+	ErrLeaseMismatch       = rpcclient.ErrLeaseMismatch
+
+	ErrIncompatibleAPIVersion = rpcclient.ErrIncompatibleAPIVersion
+	ErrCapabilityUnsupported  = rpcclient.ErrCapabilityUnsupported
+
+	ErrJournalRequired = rpcclient.ErrJournalRequired
+
+	ErrNoRoute           = rpcclient.ErrNoRoute
+	ErrUnknownController = rpcclient.ErrUnknownController
+
+	ErrPinMismatch = rpcclient.ErrPinMismatch
+)
+
 // New creates a new client for the Jenkins Step RPC plugin.
 func New(baseURL, token string, httpClient *http.Client) (*Client, error) {
 	return rpcclient.New(baseURL, token, httpClient)
 }
 
+// NewPool builds a pool. Controller names must be unique and non-empty.
+func NewPool(controllers ...Controller) (*Pool, error) {
+	return rpcclient.NewPool(controllers...)
+}
+
+// NewTLSConfig builds a *tls.Config from opts.
+func NewTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	return rpcclient.NewTLSConfig(opts)
+}
+
+// SPKIPin returns the SPKI pin for cert in the form TLSOptions.SPKIPins accepts.
+func SPKIPin(cert *x509.Certificate) string {
+	return rpcclient.SPKIPin(cert)
+}
+
+// OpenFileJournal opens or creates a JSON lines journal file at path.
+func OpenFileJournal(path string) (*FileJournal, error) {
+	return rpcclient.OpenFileJournal(path)
+}
+
+// NewRunContextForRun builds a RunContext addressed by run externalizable ID.
+func NewRunContextForRun(runExternalizableID, nodeName, workspace string) RunContext {
+	return rpcclient.NewRunContextForRun(runExternalizableID, nodeName, workspace)
+}
+
+// NewRunContextForBuild builds a RunContext addressed by job full name and build number.
+func NewRunContextForBuild(jobFullName string, buildNumber int, nodeName, workspace string) RunContext {
+	return rpcclient.NewRunContextForBuild(jobFullName, buildNumber, nodeName, workspace)
+}
+
+// RunContextFromEnv derives a RunContext from the standard Jenkins build environment.
+func RunContextFromEnv() (RunContext, error) {
+	return rpcclient.RunContextFromEnv()
+}
+
+// InjectRunContext returns a copy of args with runContext set to rc unless already present.
+func InjectRunContext(args *structpb.Struct, rc RunContext) (*structpb.Struct, error) {
+	return rpcclient.InjectRunContext(args, rc)
+}
+
 // DirectOperations returns catalog operations executable in the direct controller lane.
 func DirectOperations(catalog *steprpcv1.CatalogResponse) []string {
 	return rpcclient.DirectOperations(catalog)
@@ -55,6 +271,43 @@ func CPSBridgeOperations(catalog *steprpcv1.CatalogResponse) []string {
 	return rpcclient.CPSBridgeOperations(catalog)
 }
 
+// RunErrorOf wraps a run-level error. It returns nil when pe is nil.
+func RunErrorOf(pe *steprpcv1.Error) error {
+	return rpcclient.RunErrorOf(pe)
+}
+
+// NewRunTimeline extracts the timeline of status.
+func NewRunTimeline(status *steprpcv1.RunStatusResponse) RunTimeline {
+	return rpcclient.NewRunTimeline(status)
+}
+
+// ParseRunState converts a legacy state string, ignoring case and surrounding
+// space. Unrecognized strings are RunStateUnknown.
+func ParseRunState(state string) RunState {
+	return rpcclient.ParseRunState(state)
+}
+
+// RunStateFromProto converts the contract enum.
+func RunStateFromProto(state steprpcv1.RunState) RunState {
+	return rpcclient.RunStateFromProto(state)
+}
+
+// RunStateOf returns the state of msg, preferring the run_state enum and
+// falling back to the legacy string.
+func RunStateOf(msg RunStateMessage) RunState {
+	return rpcclient.RunStateOf(msg)
+}
+
+// IsTerminalState reports whether state is succeeded, failed, or cancelled.
+func IsTerminalState(state string) bool {
+	return rpcclient.IsTerminalState(state)
+}
+
+// BridgeLeaseFromContext returns the lease a BridgeHandler's context runs under.
+func BridgeLeaseFromContext(ctx context.Context) (BridgeLease, bool) {
+	return rpcclient.BridgeLeaseFromContext(ctx)
+}
+
 // CategoryOf extracts the ErrorCategory from an error chain.
 func CategoryOf(err error) ErrorCategory {
 	return rpcclient.CategoryOf(err)
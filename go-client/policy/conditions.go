@@ -0,0 +1,212 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Condition tests the argument at a dotted Path, e.g. "runContext.jobFullName"
+// or "files.0". Exactly one operator must be set. When the value is a list the
+// operator holds if it holds for any element. A missing path only satisfies
+// Exists: false; Not inverts the result, so a missing value satisfies any
+// negated operator.
+type Condition struct {
+	Path     string   `yaml:"path"`
+	Equals   *string  `yaml:"equals"`
+	Prefix   *string  `yaml:"prefix"`
+	Contains *string  `yaml:"contains"`
+	Matches  string   `yaml:"matches"`
+	In       []string `yaml:"in"`
+	Exists   *bool    `yaml:"exists"`
+	Not      bool     `yaml:"not"`
+
+	re *regexp.Regexp
+}
+
+func (c *Condition) compile() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+	set := 0
+	for _, isSet := range []bool{c.Equals != nil, c.Prefix != nil, c.Contains != nil, c.Matches != "", c.In != nil, c.Exists != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("path %s: set exactly one of equals, prefix, contains, matches, in, exists", c.Path)
+	}
+	if c.Matches != "" {
+		re, err := regexp.Compile(c.Matches)
+		if err != nil {
+			return fmt.Errorf("path %s: %w", c.Path, err)
+		}
+		c.re = re
+	}
+	return nil
+}
+
+func (c *Condition) holds(args map[string]any) bool {
+	v, found := lookup(args, c.Path)
+	return c.test(v, found) != c.Not
+}
+
+func (c *Condition) test(v any, found bool) bool {
+	if c.Exists != nil {
+		return found == *c.Exists
+	}
+	if !found {
+		return false
+	}
+	if list, ok := v.([]any); ok {
+		for _, item := range list {
+			if c.testScalar(stringify(item)) {
+				return true
+			}
+		}
+		return false
+	}
+	return c.testScalar(stringify(v))
+}
+
+func (c *Condition) testScalar(s string) bool {
+	switch {
+	case c.Equals != nil:
+		return s == *c.Equals
+	case c.Prefix != nil:
+		return strings.HasPrefix(s, *c.Prefix)
+	case c.Contains != nil:
+		return strings.Contains(s, *c.Contains)
+	case c.re != nil:
+		return c.re.MatchString(s)
+	case c.In != nil:
+		for _, want := range c.In {
+			if s == want {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// lookup walks a dotted path through nested objects; numeric segments index lists.
+func lookup(args map[string]any, path string) (any, bool) {
+	var cur any = args
+	for _, seg := range strings.Split(path, ".") {
+		switch node := cur.(type) {
+		case map[string]any:
+			next, ok := node[seg]
+			if !ok {
+				return nil, false
+			}
+			cur = next
+		case []any:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			cur = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func stringify(v any) string {
+	switch tv := v.(type) {
+	case string:
+		return tv
+	case float64:
+		return strconv.FormatFloat(tv, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(tv)
+	}
+}
+
+// Window is a weekly time window. Days are "mon" through "sun" (all days when
+// empty); From is inclusive and To exclusive, as "15:04" (whole day when both
+// are empty). A From after To spans midnight, and Days then name the day the
+// window opens. Timezone is an IANA name; empty means UTC.
+type Window struct {
+	Days     []string `yaml:"days"`
+	From     string   `yaml:"from"`
+	To       string   `yaml:"to"`
+	Timezone string   `yaml:"timezone"`
+
+	days     map[time.Weekday]bool
+	from, to time.Duration
+	loc      *time.Location
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+const day = 24 * time.Hour
+
+func (w *Window) compile() error {
+	w.days = map[time.Weekday]bool{}
+	for _, d := range w.Days {
+		wd, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return fmt.Errorf("window: unknown day %q", d)
+		}
+		w.days[wd] = true
+	}
+	var err error
+	if w.from, err = clock(w.From, 0); err != nil {
+		return fmt.Errorf("window from: %w", err)
+	}
+	if w.to, err = clock(w.To, day); err != nil {
+		return fmt.Errorf("window to: %w", err)
+	}
+	w.loc = time.UTC
+	if w.Timezone != "" {
+		if w.loc, err = time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("window timezone: %w", err)
+		}
+	}
+	return nil
+}
+
+func clock(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w *Window) contains(t time.Time) bool {
+	local := t.In(w.loc)
+	sinceMidnight := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second
+	today := local.Weekday()
+
+	if w.from <= w.to {
+		return w.onDay(today) && sinceMidnight >= w.from && sinceMidnight < w.to
+	}
+	// Overnight: the evening part belongs to today, the morning part to the
+	// day before.
+	if sinceMidnight >= w.from {
+		return w.onDay(today)
+	}
+	return sinceMidnight < w.to && w.onDay((today+6)%7)
+}
+
+func (w *Window) onDay(d time.Weekday) bool {
+	return len(w.days) == 0 || w.days[d]
+}
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestCase is one call and its expected decision. A test file is a YAML list:
+//
+//   - name: parent paths are rejected
+//     operation: archiveArtifacts
+//     args: {artifacts: "../secrets/*"}
+//     expect: deny
+//     rule: no-parent-paths
+//   - name: team-a builds its own jobs
+//     operation: build
+//     caller: {subject: alice, tenant: team-a}
+//     args: {runContext: {jobFullName: team-a/app}}
+//     time: 2026-03-02T10:00:00Z
+//     expect: allow
+type TestCase struct {
+	Name      string         `yaml:"name"`
+	Operation string         `yaml:"operation"`
+	Mode      string         `yaml:"mode"`
+	Args      map[string]any `yaml:"args"`
+	Caller    Caller         `yaml:"caller"`
+	Time      time.Time      `yaml:"time"`
+	// Expect is Allow or Deny.
+	Expect string `yaml:"expect"`
+	// Rule, when set, must be the deciding rule.
+	Rule string `yaml:"rule"`
+}
+
+// TestResult is the outcome of one TestCase. Err is nil when it passed.
+type TestResult struct {
+	Case     TestCase
+	Decision Decision
+	Err      error
+}
+
+// LoadTests reads a test case file.
+func LoadTests(path string) ([]TestCase, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from caller config
+	if err != nil {
+		return nil, fmt.Errorf("read policy tests: %w", err)
+	}
+	return ParseTests(data)
+}
+
+// ParseTests decodes a YAML or JSON list of test cases.
+func ParseTests(data []byte) ([]TestCase, error) {
+	var cases []TestCase
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cases); err != nil {
+		return nil, fmt.Errorf("decode policy tests: %w", err)
+	}
+	for i, c := range cases {
+		if c.Expect != Allow && c.Expect != Deny {
+			return nil, fmt.Errorf("tests[%d] (%s): expect must be allow or deny, got %q", i, c.Name, c.Expect)
+		}
+		if c.Mode != "" {
+			if _, err := parseMode(c.Mode); err != nil {
+				return nil, fmt.Errorf("tests[%d] (%s): %w", i, c.Name, err)
+			}
+		}
+	}
+	return cases, nil
+}
+
+// RunTests evaluates every case. A case without a time uses a fixed Monday
+// noon UTC so results do not depend on when the tests run.
+func (p *Policy) RunTests(cases []TestCase) []TestResult {
+	results := make([]TestResult, 0, len(cases))
+	for _, c := range cases {
+		in := Input{Operation: c.Operation, Args: c.Args, Caller: c.Caller, Time: c.Time}
+		if c.Mode != "" {
+			in.Mode, _ = parseMode(c.Mode)
+		}
+		if in.Time.IsZero() {
+			in.Time = defaultTestTime
+		}
+		d := p.Evaluate(in)
+		res := TestResult{Case: c, Decision: d}
+		got := Deny
+		if d.Allowed {
+			got = Allow
+		}
+		switch {
+		case got != c.Expect:
+			res.Err = fmt.Errorf("got %s (rule %q, reason %q), want %s", got, d.Rule, d.Reason, c.Expect)
+		case c.Rule != "" && d.Rule != c.Rule:
+			res.Err = fmt.Errorf("decided by rule %q, want %q", d.Rule, c.Rule)
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+var defaultTestTime = time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
@@ -0,0 +1,332 @@
+// Package policy authorizes invocations by operation, execution mode, argument
+// values, caller identity, and time of day before they reach a controller.
+//
+// A Policy is a list of allow and deny rules. Any matching deny rule denies the
+// call; otherwise a matching allow rule, or a Default of allow, permits it:
+//
+//	default: allow
+//	rules:
+//	  - name: no-parent-paths
+//	    effect: deny
+//	    reason: artifacts must not contain '..'
+//	    operations: [archiveArtifacts]
+//	    when:
+//	      - {path: artifacts, contains: ".."}
+//	  - name: team-a-jobs-only
+//	    effect: deny
+//	    reason: team-a may only target its own jobs
+//	    tenants: [team-a]
+//	    when:
+//	      - {path: runContext.jobFullName, prefix: team-a/, not: true}
+//	  - name: no-deploys-on-weekends
+//	    effect: deny
+//	    operations: ["deploy*"]
+//	    during: [{days: [sat, sun]}]
+package policy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrDenied matches every *DenyError.
+var ErrDenied = errors.New("denied by policy")
+
+// Effects.
+const (
+	Allow = "allow"
+	Deny  = "deny"
+)
+
+// Policy is a rule set. Load and Parse validate it; a Policy built in code must
+// pass Validate, which also compiles its conditions and windows, before use.
+type Policy struct {
+	// Default is Allow or Deny and applies when no rule matches. Empty means Deny.
+	Default string `yaml:"default"`
+	Rules   []Rule `yaml:"rules"`
+}
+
+// Rule allows or denies calls matching all of its criteria. Empty criteria
+// match everything.
+type Rule struct {
+	Name   string `yaml:"name"`
+	Effect string `yaml:"effect"`
+	// Reason is reported to the caller when the rule denies a call.
+	Reason string `yaml:"reason"`
+	// Operations are path.Match patterns, e.g. "deploy*".
+	Operations []string `yaml:"operations"`
+	// ExecutionModes are "direct" or "cpsBridgeRequired".
+	ExecutionModes []string `yaml:"executionModes"`
+	// Subjects and Tenants are path.Match patterns against the Caller.
+	Subjects []string `yaml:"subjects"`
+	Tenants  []string `yaml:"tenants"`
+	// When holds argument conditions; all must hold.
+	When []Condition `yaml:"when"`
+	// During restricts the rule to any of these windows; Outside to times
+	// outside all of them.
+	During  []Window `yaml:"during"`
+	Outside []Window `yaml:"outside"`
+}
+
+// Caller identifies who is invoking.
+type Caller struct {
+	Subject string `yaml:"subject"`
+	Tenant  string `yaml:"tenant"`
+}
+
+// Input is one call to authorize.
+type Input struct {
+	Operation string
+	// Mode is the operation's execution mode, or UNSPECIFIED when unknown. An
+	// unknown mode matches any executionModes filter on deny rules and none on
+	// allow rules, so a missing catalog lookup never widens access.
+	Mode   steprpcv1.OperationExecutionMode
+	Args   map[string]any
+	Caller Caller
+	Time   time.Time
+}
+
+// Decision is the outcome of Evaluate.
+type Decision struct {
+	Allowed bool
+	// Rule is the deciding rule's name; empty when Default decided.
+	Rule   string
+	Reason string
+}
+
+// DenyError reports a denied call. It matches ErrDenied.
+type DenyError struct {
+	Operation string
+	Rule      string
+	Reason    string
+}
+
+func (e *DenyError) Error() string {
+	msg := fmt.Sprintf("policy denied %s", e.Operation)
+	if e.Rule != "" {
+		msg += " by rule " + e.Rule
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// Is reports whether target is ErrDenied.
+func (e *DenyError) Is(target error) bool {
+	return target == ErrDenied
+}
+
+// Details returns the denial as string details for structured error payloads.
+func (e *DenyError) Details() map[string]string {
+	return map[string]string{"operation": e.Operation, "rule": e.Rule, "reason": e.Reason}
+}
+
+// Load reads a policy file.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from caller config
+	if err != nil {
+		return nil, fmt.Errorf("read policy: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a YAML or JSON policy and validates it. Unknown keys are rejected.
+func Parse(data []byte) (*Policy, error) {
+	p := &Policy{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(p); err != nil {
+			return nil, fmt.Errorf("decode policy: %w", err)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks effects, patterns, modes, conditions, and windows.
+func (p *Policy) Validate() error {
+	switch p.Default {
+	case "", Allow, Deny:
+	default:
+		return fmt.Errorf("default: must be allow or deny, got %q", p.Default)
+	}
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		where := fmt.Sprintf("rules[%d]", i)
+		if r.Name != "" {
+			where += " (" + r.Name + ")"
+		}
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("%s: %w", where, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if r.Effect != Allow && r.Effect != Deny {
+		return fmt.Errorf("effect must be allow or deny, got %q", r.Effect)
+	}
+	for _, patterns := range [][]string{r.Operations, r.Subjects, r.Tenants} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("bad pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	for _, m := range r.ExecutionModes {
+		if _, err := parseMode(m); err != nil {
+			return err
+		}
+	}
+	for i := range r.When {
+		if err := r.When[i].compile(); err != nil {
+			return fmt.Errorf("when[%d]: %w", i, err)
+		}
+	}
+	for _, windows := range [][]Window{r.During, r.Outside} {
+		for i := range windows {
+			if err := windows[i].compile(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate decides in. Deny rules take precedence over allow rules regardless
+// of order.
+func (p *Policy) Evaluate(in Input) Decision {
+	if in.Time.IsZero() {
+		in.Time = time.Now()
+	}
+	var allowedBy *Rule
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if !r.matches(in) {
+			continue
+		}
+		if r.Effect == Deny {
+			return Decision{Rule: r.Name, Reason: r.Reason}
+		}
+		if allowedBy == nil {
+			allowedBy = r
+		}
+	}
+	if allowedBy != nil {
+		return Decision{Allowed: true, Rule: allowedBy.Name}
+	}
+	if p.Default == Allow {
+		return Decision{Allowed: true}
+	}
+	return Decision{Reason: "no rule allows this call"}
+}
+
+// Check evaluates in and returns a *DenyError when the call is denied.
+func (p *Policy) Check(in Input) error {
+	d := p.Evaluate(in)
+	if d.Allowed {
+		return nil
+	}
+	return &DenyError{Operation: in.Operation, Rule: d.Rule, Reason: d.Reason}
+}
+
+// CheckInvoke lets a Policy guard a client's Invoke calls; see
+// Client.WithInvokePolicy. The caller comes from WithCaller.
+func (p *Policy) CheckInvoke(ctx context.Context, req *steprpcv1.InvokeRequest, mode steprpcv1.OperationExecutionMode) error {
+	caller, _ := CallerFrom(ctx)
+	return p.Check(Input{
+		Operation: req.GetOperation(),
+		Mode:      mode,
+		Args:      req.GetArgs().AsMap(),
+		Caller:    caller,
+	})
+}
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying caller for CheckInvoke.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller stored by WithCaller.
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+func (r *Rule) matches(in Input) bool {
+	if !matchAny(r.Operations, in.Operation) ||
+		!matchAny(r.Subjects, in.Caller.Subject) ||
+		!matchAny(r.Tenants, in.Caller.Tenant) ||
+		!r.matchesMode(in.Mode) {
+		return false
+	}
+	for i := range r.When {
+		if !r.When[i].holds(in.Args) {
+			return false
+		}
+	}
+	if len(r.During) > 0 && !slices.ContainsFunc(r.During, func(w Window) bool { return w.contains(in.Time) }) {
+		return false
+	}
+	if slices.ContainsFunc(r.Outside, func(w Window) bool { return w.contains(in.Time) }) {
+		return false
+	}
+	return true
+}
+
+func (r *Rule) matchesMode(mode steprpcv1.OperationExecutionMode) bool {
+	if len(r.ExecutionModes) == 0 {
+		return true
+	}
+	if mode == steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_UNSPECIFIED {
+		return r.Effect == Deny
+	}
+	for _, m := range r.ExecutionModes {
+		if parsed, _ := parseMode(m); parsed == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, s string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseMode(s string) (steprpcv1.OperationExecutionMode, error) {
+	switch strings.ToLower(s) {
+	case "direct":
+		return steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT, nil
+	case "cpsbridgerequired", "cps_bridge_required":
+		return steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED, nil
+	}
+	if v, ok := steprpcv1.OperationExecutionMode_value[s]; ok && v != 0 {
+		return steprpcv1.OperationExecutionMode(v), nil
+	}
+	return 0, fmt.Errorf("unknown execution mode %q", s)
+}
@@ -0,0 +1,276 @@
+package policy_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/policy"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const samplePolicy = `
+default: allow
+rules:
+  - name: no-parent-paths
+    effect: deny
+    reason: artifacts must not contain '..'
+    operations: [archiveArtifacts]
+    when:
+      - {path: artifacts, contains: ".."}
+  - name: team-a-jobs-only
+    effect: deny
+    reason: team-a may only target its own jobs
+    tenants: [team-a]
+    when:
+      - {path: runContext.jobFullName, prefix: team-a/, not: true}
+  - name: no-weekend-deploys
+    effect: deny
+    operations: ["deploy*"]
+    during: [{days: [sat, sun]}]
+  - name: bridge-night-freeze
+    effect: deny
+    executionModes: [cpsBridgeRequired]
+    during: [{from: "22:00", to: "06:00", timezone: America/New_York}]
+`
+
+var monday = time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+func mustParse(t *testing.T, src string) *policy.Policy {
+	t.Helper()
+	p, err := policy.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return p
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	p := mustParse(t, samplePolicy)
+	bridge := steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED
+	direct := steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT
+	saturday := time.Date(2026, time.March, 7, 12, 0, 0, 0, time.UTC)
+	// New York is UTC-5 in early March: 23:30 Monday, 05:00 and 07:00 Tuesday.
+	nyLate := time.Date(2026, time.March, 3, 4, 30, 0, 0, time.UTC)
+	nyEarly := time.Date(2026, time.March, 3, 10, 0, 0, 0, time.UTC)
+	nyMorning := time.Date(2026, time.March, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		in       policy.Input
+		wantRule string
+		allowed  bool
+	}{
+		{"plain call", policy.Input{Operation: "echo", Time: monday}, "", true},
+		{"parent path", policy.Input{Operation: "archiveArtifacts", Args: map[string]any{"artifacts": "../x"}, Time: monday}, "no-parent-paths", false},
+		{"parent path in list", policy.Input{Operation: "archiveArtifacts", Args: map[string]any{"artifacts": []any{"ok", "a/../b"}}, Time: monday}, "no-parent-paths", false},
+		{"clean path", policy.Input{Operation: "archiveArtifacts", Args: map[string]any{"artifacts": "out/*.jar"}, Time: monday}, "", true},
+		{
+			"team-a own job",
+			policy.Input{Operation: "build", Caller: policy.Caller{Tenant: "team-a"}, Args: map[string]any{"runContext": map[string]any{"jobFullName": "team-a/app"}}, Time: monday},
+			"", true,
+		},
+		{
+			"team-a other job",
+			policy.Input{Operation: "build", Caller: policy.Caller{Tenant: "team-a"}, Args: map[string]any{"runContext": map[string]any{"jobFullName": "team-b/app"}}, Time: monday},
+			"team-a-jobs-only", false,
+		},
+		{"team-a without job", policy.Input{Operation: "build", Caller: policy.Caller{Tenant: "team-a"}, Time: monday}, "team-a-jobs-only", false},
+		{"team-b any job", policy.Input{Operation: "build", Caller: policy.Caller{Tenant: "team-b"}, Time: monday}, "", true},
+		{"deploy on weekday", policy.Input{Operation: "deployProd", Time: monday}, "", true},
+		{"deploy on weekend", policy.Input{Operation: "deployProd", Time: saturday}, "no-weekend-deploys", false},
+		{"bridge late evening", policy.Input{Operation: "sh", Mode: bridge, Time: nyLate}, "bridge-night-freeze", false},
+		{"bridge early morning", policy.Input{Operation: "sh", Mode: bridge, Time: nyEarly}, "bridge-night-freeze", false},
+		{"bridge after window", policy.Input{Operation: "sh", Mode: bridge, Time: nyMorning}, "", true},
+		{"direct at night", policy.Input{Operation: "sh", Mode: direct, Time: nyLate}, "", true},
+		{"unknown mode at night", policy.Input{Operation: "sh", Time: nyLate}, "bridge-night-freeze", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := p.Evaluate(tt.in)
+			if d.Allowed != tt.allowed || d.Rule != tt.wantRule {
+				t.Fatalf("Evaluate() = %+v, want allowed=%v rule=%q", d, tt.allowed, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestEvaluateDenyOverridesAllow(t *testing.T) {
+	t.Parallel()
+
+	p := mustParse(t, `
+rules:
+  - {name: ops-allowed, effect: allow, subjects: ["ops-*"]}
+  - {name: no-prod, effect: deny, reason: prod is frozen, when: [{path: env, equals: prod}]}
+  - {name: bridge-allowed, effect: allow, executionModes: [cpsBridgeRequired]}
+`)
+	d := p.Evaluate(policy.Input{Operation: "sh", Caller: policy.Caller{Subject: "ops-bot"}, Args: map[string]any{"env": "prod"}})
+	if d.Allowed || d.Rule != "no-prod" || d.Reason != "prod is frozen" {
+		t.Fatalf("Evaluate(prod) = %+v", d)
+	}
+	d = p.Evaluate(policy.Input{Operation: "sh", Caller: policy.Caller{Subject: "ops-bot"}})
+	if !d.Allowed || d.Rule != "ops-allowed" {
+		t.Fatalf("Evaluate(ops) = %+v", d)
+	}
+	// An unknown mode never satisfies an allow rule's mode filter.
+	d = p.Evaluate(policy.Input{Operation: "sh", Caller: policy.Caller{Subject: "dev"}})
+	if d.Allowed || d.Rule != "" {
+		t.Fatalf("Evaluate(default deny) = %+v", d)
+	}
+	d = p.Evaluate(policy.Input{
+		Operation: "sh",
+		Mode:      steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED,
+	})
+	if !d.Allowed || d.Rule != "bridge-allowed" {
+		t.Fatalf("Evaluate(bridge) = %+v", d)
+	}
+}
+
+func TestConditions(t *testing.T) {
+	t.Parallel()
+
+	args := map[string]any{
+		"count": float64(3),
+		"files": []any{"a.txt", "b.log"},
+		"nested": map[string]any{
+			"list": []any{map[string]any{"name": "first"}},
+		},
+	}
+	tests := []struct {
+		cond string
+		want bool
+	}{
+		{`{path: count, equals: "3"}`, true},
+		{`{path: files, matches: '\.log$'}`, true},
+		{`{path: files.0, equals: a.txt}`, true},
+		{`{path: files.5, exists: false}`, true},
+		{`{path: nested.list.0.name, in: [first, second]}`, true},
+		{`{path: nested.list.0.name, in: [second]}`, false},
+		{`{path: missing, prefix: x}`, false},
+		{`{path: missing, prefix: x, not: true}`, true},
+		{`{path: count, exists: true}`, true},
+	}
+	for _, tt := range tests {
+		p := mustParse(t, "rules: [{name: r, effect: allow, when: ["+tt.cond+"]}]")
+		if got := p.Evaluate(policy.Input{Operation: "op", Args: args}).Allowed; got != tt.want {
+			t.Errorf("condition %s = %v, want %v", tt.cond, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"default: maybe":                                           "default",
+		"rules: [{effect: permit}]":                                "effect",
+		"rules: [{effect: deny, operations: ['[']}]":               "bad pattern",
+		"rules: [{effect: deny, executionModes: [sometimes]}]":     "execution mode",
+		"rules: [{effect: deny, when: [{path: a}]}]":               "exactly one",
+		"rules: [{effect: deny, when: [{equals: a}]}]":             "path is required",
+		"rules: [{effect: deny, when: [{path: a, matches: '('}]}]": "path a",
+		"rules: [{effect: deny, during: [{days: [someday]}]}]":     "unknown day",
+		"rules: [{effect: deny, during: [{from: '25:00'}]}]":       "window from",
+		"rules: [{effect: deny, during: [{timezone: Mars/Base}]}]": "timezone",
+		"rules: [{effect: deny, surprise: true}]":                  "surprise",
+	}
+	for src, want := range tests {
+		if _, err := policy.Parse([]byte(src)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Parse(%q) error = %v, want mention of %q", src, err, want)
+		}
+	}
+
+	p, err := policy.Parse(nil)
+	if err != nil {
+		t.Fatalf("Parse(nil) error = %v", err)
+	}
+	if p.Evaluate(policy.Input{Operation: "echo"}).Allowed {
+		t.Fatal("empty policy allowed a call")
+	}
+}
+
+func TestCheckInvoke(t *testing.T) {
+	t.Parallel()
+
+	p := mustParse(t, samplePolicy)
+	args, err := structpb.NewStruct(map[string]any{"runContext": map[string]any{"jobFullName": "team-b/app"}})
+	if err != nil {
+		t.Fatalf("NewStruct() error = %v", err)
+	}
+	req := &steprpcv1.InvokeRequest{Operation: "build", Args: args}
+	mode := steprpcv1.OperationExecutionMode_OPERATION_EXECUTION_MODE_DIRECT
+
+	if err := p.CheckInvoke(context.Background(), req, mode); err != nil {
+		t.Fatalf("CheckInvoke(no caller) error = %v", err)
+	}
+
+	ctx := policy.WithCaller(context.Background(), policy.Caller{Subject: "alice", Tenant: "team-a"})
+	err = p.CheckInvoke(ctx, req, mode)
+	if !errors.Is(err, policy.ErrDenied) {
+		t.Fatalf("CheckInvoke() error = %v, want ErrDenied", err)
+	}
+	var denied *policy.DenyError
+	if !errors.As(err, &denied) || denied.Rule != "team-a-jobs-only" || denied.Operation != "build" {
+		t.Fatalf("CheckInvoke() error = %#v", err)
+	}
+	if got := denied.Details()["reason"]; got != "team-a may only target its own jobs" {
+		t.Fatalf("Details()[reason] = %q", got)
+	}
+}
+
+func TestRunTests(t *testing.T) {
+	t.Parallel()
+
+	p := mustParse(t, samplePolicy)
+	cases, err := policy.ParseTests([]byte(`
+- name: parent paths are rejected
+  operation: archiveArtifacts
+  args: {artifacts: "../secrets/*"}
+  expect: deny
+  rule: no-parent-paths
+- name: team-a builds its own jobs
+  operation: build
+  caller: {subject: alice, tenant: team-a}
+  args: {runContext: {jobFullName: team-a/app}}
+  expect: allow
+- name: weekend deploy wrongly expected
+  operation: deployProd
+  time: 2026-03-07T10:00:00Z
+  expect: allow
+- name: wrong deciding rule
+  operation: archiveArtifacts
+  args: {artifacts: "../x"}
+  expect: deny
+  rule: team-a-jobs-only
+`))
+	if err != nil {
+		t.Fatalf("ParseTests() error = %v", err)
+	}
+
+	results := p.RunTests(cases)
+	var failed []string
+	for _, res := range results {
+		if res.Err != nil {
+			failed = append(failed, res.Case.Name)
+		}
+	}
+	want := []string{"weekend deploy wrongly expected", "wrong deciding rule"}
+	if strings.Join(failed, ",") != strings.Join(want, ",") {
+		t.Fatalf("failed cases = %q, want %q", failed, want)
+	}
+	if !strings.Contains(results[2].Err.Error(), "no-weekend-deploys") {
+		t.Fatalf("failure message = %v", results[2].Err)
+	}
+
+	for _, src := range []string{"- {name: x, expect: maybe}", "- {name: x, expect: allow, mode: sometimes}"} {
+		if _, err := policy.ParseTests([]byte(src)); err == nil {
+			t.Errorf("ParseTests(%q) error = nil", src)
+		}
+	}
+}
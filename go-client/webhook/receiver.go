@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DefaultRetention is how long a Receiver remembers delivery IDs and terminal
+// statuses. Redeliveries arriving later are handled again.
+const DefaultRetention = time.Hour
+
+// maxBodyBytes bounds delivery bodies.
+const maxBodyBytes = 1 << 20
+
+// Event is a verified delivery.
+type Event struct {
+	DeliveryID string
+	Timestamp  time.Time
+	Status     *steprpcv1.RunStatusResponse
+}
+
+// Handler processes an event. An error makes the Receiver answer 500 and
+// forget the delivery, so the server's redelivery is handled again.
+type Handler func(ctx context.Context, ev Event) error
+
+// Receiver is an http.Handler for callback deliveries. It verifies signatures
+// and timestamps, decodes bodies into RunStatusResponse, drops redeliveries,
+// and dispatches each new event to every registered Handler. It is also a
+// RunNotifier for Client.WithRunNotifier.
+//
+// Responses: 204 handled, 200 already handled, 409 still being handled, 401
+// bad signature or timestamp, 400 bad body, 500 handler error.
+type Receiver struct {
+	secrets   []string
+	tolerance time.Duration
+	retention time.Duration
+	now       func() time.Time
+	state     *receiverState
+}
+
+// receiverState is shared by a Receiver and its With* copies.
+type receiverState struct {
+	mu         sync.Mutex
+	handlers   []Handler
+	deliveries map[string]delivery
+	terminal   map[string]terminalStatus
+	waiters    map[string]map[chan *steprpcv1.RunStatusResponse]struct{}
+}
+
+type delivery struct {
+	at   time.Time
+	done bool
+}
+
+type terminalStatus struct {
+	at     time.Time
+	status *steprpcv1.RunStatusResponse
+}
+
+// NewReceiver returns a Receiver accepting signatures made with any of
+// secrets. List the new secret first while rotating.
+func NewReceiver(secrets ...string) *Receiver {
+	return &Receiver{
+		secrets:   secrets,
+		tolerance: DefaultTolerance,
+		retention: DefaultRetention,
+		now:       time.Now,
+		state: &receiverState{
+			deliveries: map[string]delivery{},
+			terminal:   map[string]terminalStatus{},
+			waiters:    map[string]map[chan *steprpcv1.RunStatusResponse]struct{}{},
+		},
+	}
+}
+
+// WithTolerance returns a copy that accepts timestamps up to d from its clock.
+func (r *Receiver) WithTolerance(d time.Duration) *Receiver {
+	cp := *r
+	cp.tolerance = d
+	return &cp
+}
+
+// WithRetention returns a copy that remembers deliveries and terminal statuses
+// for d.
+func (r *Receiver) WithRetention(d time.Duration) *Receiver {
+	cp := *r
+	cp.retention = d
+	return &cp
+}
+
+// Handle registers h for every new event.
+func (r *Receiver) Handle(h Handler) {
+	r.state.mu.Lock()
+	defer r.state.mu.Unlock()
+	r.state.handlers = append(r.state.handlers, h)
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "read body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sent, err := Verify(req.Header, body, r.secrets, r.now(), r.tolerance)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	status := &steprpcv1.RunStatusResponse{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, status); err != nil {
+		http.Error(w, "decode run status: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if status.GetRunId() == "" {
+		http.Error(w, "runId is required", http.StatusBadRequest)
+		return
+	}
+
+	ev := Event{DeliveryID: req.Header.Get(HeaderDelivery), Timestamp: sent, Status: status}
+	handlers, prior, seen := r.begin(ev)
+	if seen {
+		if prior.done {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			http.Error(w, "delivery in progress", http.StatusConflict)
+		}
+		return
+	}
+	for _, h := range handlers {
+		if err := h(req.Context(), ev); err != nil {
+			r.finish(ev.DeliveryID, false)
+			http.Error(w, "handler: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	r.finish(ev.DeliveryID, true)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// begin claims ev's delivery ID and, for a terminal status, wakes waiters. It
+// returns the handlers to run, or the earlier delivery when ev is a repeat.
+func (r *Receiver) begin(ev Event) ([]Handler, delivery, bool) {
+	s := r.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := r.now()
+	r.pruneLocked(now)
+	if prior, ok := s.deliveries[ev.DeliveryID]; ok {
+		return nil, prior, true
+	}
+	s.deliveries[ev.DeliveryID] = delivery{at: now}
+
+	if runID := ev.Status.GetRunId(); jenkinsrpc.RunStateOf(ev.Status).IsTerminal() {
+		s.terminal[runID] = terminalStatus{at: now, status: ev.Status}
+		for ch := range s.waiters[runID] {
+			ch <- ev.Status
+		}
+		delete(s.waiters, runID)
+	}
+	return append([]Handler(nil), s.handlers...), delivery{}, false
+}
+
+// finish marks a delivery handled, or forgets it so a redelivery runs again.
+func (r *Receiver) finish(deliveryID string, ok bool) {
+	s := r.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !ok {
+		delete(s.deliveries, deliveryID)
+		return
+	}
+	d := s.deliveries[deliveryID]
+	d.done = true
+	s.deliveries[deliveryID] = d
+}
+
+func (r *Receiver) pruneLocked(now time.Time) {
+	cutoff := now.Add(-r.retention)
+	for id, d := range r.state.deliveries {
+		if d.done && d.at.Before(cutoff) {
+			delete(r.state.deliveries, id)
+		}
+	}
+	for runID, t := range r.state.terminal {
+		if t.at.Before(cutoff) {
+			delete(r.state.terminal, runID)
+		}
+	}
+}
+
+// NotifyTerminal implements RunNotifier. A terminal status received within the
+// retention period, even before the call, is delivered immediately.
+func (r *Receiver) NotifyTerminal(runID string) (<-chan *steprpcv1.RunStatusResponse, func()) {
+	s := r.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan *steprpcv1.RunStatusResponse, 1)
+	if t, ok := s.terminal[runID]; ok && !t.at.Before(r.now().Add(-r.retention)) {
+		ch <- t.status
+		return ch, func() {}
+	}
+	if s.waiters[runID] == nil {
+		s.waiters[runID] = map[chan *steprpcv1.RunStatusResponse]struct{}{}
+	}
+	s.waiters[runID][ch] = struct{}{}
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.waiters[runID], ch)
+		if len(s.waiters[runID]) == 0 {
+			delete(s.waiters, runID)
+		}
+	}
+}
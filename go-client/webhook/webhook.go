@@ -0,0 +1,131 @@
+// Package webhook signs and receives the run-completion callbacks a server
+// sends to InvokeRequest.callback_url. The signature scheme is documented on
+// InvokeRequest in contracts.proto.
+//
+// A Receiver is an http.Handler for those callbacks. Passing it to
+// Client.WithRunNotifier lets WaitRunTerminal return as soon as the callback
+// arrives instead of at the next poll. Callbacks need a client that has
+// completed a Handshake with a server advertising the webhooks capability:
+//
+//	recv := webhook.NewReceiver(secret)
+//	go http.ListenAndServe(":9000", recv)
+//	client, _ = client.Handshake(ctx)
+//	client = client.WithRunNotifier(recv)
+//	resp, _ := client.Invoke(ctx, &steprpcv1.InvokeRequest{
+//		RequestId:      id,
+//		Operation:      "archiveArtifacts",
+//		CallbackUrl:    "https://ci-bot.example.com:9000/",
+//		CallbackSecret: secret,
+//	})
+//	status, err := client.WaitRunTerminal(ctx, resp.GetRunId(), policy)
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Delivery headers.
+const (
+	HeaderDelivery  = "X-StepRpc-Delivery"
+	HeaderTimestamp = "X-StepRpc-Timestamp"
+	HeaderSignature = "X-StepRpc-Signature"
+)
+
+// DefaultTolerance is how far a delivery's timestamp may be from the
+// receiver's clock.
+const DefaultTolerance = 5 * time.Minute
+
+const signaturePrefix = "v1="
+
+var (
+	// ErrMissingSignature is returned by Verify when a delivery header is absent.
+	ErrMissingSignature = errors.New("missing webhook signature headers")
+
+	// ErrBadSignature is returned by Verify when no signature matches any secret.
+	ErrBadSignature = errors.New("webhook signature mismatch")
+
+	// ErrStaleTimestamp is returned by Verify when the timestamp is outside the
+	// tolerance.
+	ErrStaleTimestamp = errors.New("webhook timestamp outside tolerance")
+)
+
+// Sign returns the X-StepRpc-Signature value for body sent as deliveryID at
+// timestamp.
+func Sign(secret, deliveryID string, timestamp time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(mac(secret, deliveryID, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+func mac(secret, deliveryID, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte(deliveryID + "." + timestamp + "."))
+	_, _ = h.Write(body)
+	return h.Sum(nil)
+}
+
+// Verify checks a delivery's timestamp against now and its signatures against
+// each secret, and returns the timestamp. Several secrets allow rotation.
+func Verify(header http.Header, body []byte, secrets []string, now time.Time, tolerance time.Duration) (time.Time, error) {
+	delivery, rawTS, sigs := header.Get(HeaderDelivery), header.Get(HeaderTimestamp), header.Get(HeaderSignature)
+	if delivery == "" || rawTS == "" || sigs == "" {
+		return time.Time{}, ErrMissingSignature
+	}
+	unix, err := strconv.ParseInt(rawTS, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: bad timestamp %q", ErrBadSignature, rawTS)
+	}
+	sent := time.Unix(unix, 0)
+	if d := now.Sub(sent); d > tolerance || d < -tolerance {
+		return time.Time{}, fmt.Errorf("%w: sent %s", ErrStaleTimestamp, sent.UTC().Format(time.RFC3339))
+	}
+	for _, sig := range strings.Split(sigs, ",") {
+		hexSig, ok := strings.CutPrefix(strings.TrimSpace(sig), signaturePrefix)
+		if !ok {
+			continue
+		}
+		got, err := hex.DecodeString(hexSig)
+		if err != nil {
+			continue
+		}
+		for _, secret := range secrets {
+			if hmac.Equal(got, mac(secret, delivery, rawTS, body)) {
+				return sent, nil
+			}
+		}
+	}
+	return time.Time{}, ErrBadSignature
+}
+
+// NewRequest builds a signed delivery of status to callbackURL, timestamped
+// now. Redeliveries of the same event must reuse deliveryID.
+func NewRequest(ctx context.Context, callbackURL, secret, deliveryID string, status *steprpcv1.RunStatusResponse) (*http.Request, error) {
+	if deliveryID == "" {
+		return nil, fmt.Errorf("deliveryID is required")
+	}
+	body, err := protojson.Marshal(status)
+	if err != nil {
+		return nil, fmt.Errorf("marshal run status: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create webhook request: %w", err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(secret, deliveryID, now, body))
+	return req, nil
+}
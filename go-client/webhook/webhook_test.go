@@ -0,0 +1,228 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/webhook"
+)
+
+const secret = "hook-secret"
+
+func signedHeader(secret, delivery string, ts time.Time, body []byte) http.Header {
+	h := http.Header{}
+	h.Set(webhook.HeaderDelivery, delivery)
+	h.Set(webhook.HeaderTimestamp, strconv.FormatInt(ts.Unix(), 10))
+	h.Set(webhook.HeaderSignature, webhook.Sign(secret, delivery, ts, body))
+	return h
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_800_000_000, 0)
+	body := []byte(`{"runId":"rpc-1","state":"succeeded"}`)
+	secrets := []string{secret}
+
+	h := signedHeader(secret, "d-1", now, body)
+	sent, err := webhook.Verify(h, body, secrets, now.Add(time.Minute), webhook.DefaultTolerance)
+	if err != nil || !sent.Equal(now) {
+		t.Fatalf("Verify() = %v, %v", sent, err)
+	}
+
+	// Rotation: the sender lists old and new signatures, the receiver knows one.
+	rotated := signedHeader("old-secret", "d-1", now, body)
+	rotated.Set(webhook.HeaderSignature, rotated.Get(webhook.HeaderSignature)+", "+h.Get(webhook.HeaderSignature))
+	if _, err := webhook.Verify(rotated, body, secrets, now, webhook.DefaultTolerance); err != nil {
+		t.Fatalf("Verify(rotated) error = %v", err)
+	}
+	if _, err := webhook.Verify(h, body, []string{"new-secret", secret}, now, webhook.DefaultTolerance); err != nil {
+		t.Fatalf("Verify(two secrets) error = %v", err)
+	}
+
+	otherID := h.Clone()
+	otherID.Set(webhook.HeaderDelivery, "d-2")
+	missing := h.Clone()
+	missing.Del(webhook.HeaderTimestamp)
+	badTS := h.Clone()
+	badTS.Set(webhook.HeaderTimestamp, "yesterday")
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+		now    time.Time
+		want   error
+	}{
+		{"tampered body", h, []byte(`{"runId":"rpc-1","state":"failed"}`), now, webhook.ErrBadSignature},
+		{"replayed under another delivery ID", otherID, body, now, webhook.ErrBadSignature},
+		{"wrong secret", signedHeader("nope", "d-1", now, body), body, now, webhook.ErrBadSignature},
+		{"missing timestamp", missing, body, now, webhook.ErrMissingSignature},
+		{"malformed timestamp", badTS, body, now, webhook.ErrBadSignature},
+		{"too old", h, body, now.Add(6 * time.Minute), webhook.ErrStaleTimestamp},
+		{"from the future", h, body, now.Add(-6 * time.Minute), webhook.ErrStaleTimestamp},
+	}
+	for _, tt := range tests {
+		if _, err := webhook.Verify(tt.header, tt.body, secrets, tt.now, webhook.DefaultTolerance); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Verify() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+// deliver posts a signed status to url and returns the response code.
+func deliver(t *testing.T, url, secret, delivery string, status *steprpcv1.RunStatusResponse) int {
+	t.Helper()
+	req, err := webhook.NewRequest(context.Background(), url, secret, delivery, status)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode
+}
+
+func TestReceiver(t *testing.T) {
+	t.Parallel()
+
+	recv := webhook.NewReceiver(secret)
+	var calls, failures int32
+	var got webhook.Event
+	recv.Handle(func(_ context.Context, ev webhook.Event) error {
+		if ev.Status.GetRunId() == "rpc-flaky" && atomic.AddInt32(&failures, 1) == 1 {
+			return errors.New("database down")
+		}
+		atomic.AddInt32(&calls, 1)
+		got = ev
+		return nil
+	})
+	ts := httptest.NewServer(recv)
+	defer ts.Close()
+
+	status := &steprpcv1.RunStatusResponse{RequestId: "r-1", RunId: "rpc-1", Operation: "echo", State: "succeeded"}
+	if code := deliver(t, ts.URL, secret, "d-1", status); code != http.StatusNoContent {
+		t.Fatalf("first delivery = %d, want 204", code)
+	}
+	if got.DeliveryID != "d-1" || got.Status.GetRunId() != "rpc-1" || got.Status.GetState() != "succeeded" {
+		t.Fatalf("event = %+v", got)
+	}
+	if code := deliver(t, ts.URL, secret, "d-1", status); code != http.StatusOK {
+		t.Fatalf("redelivery = %d, want 200", code)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("handler calls = %d, want redelivery dropped", n)
+	}
+
+	flaky := &steprpcv1.RunStatusResponse{RunId: "rpc-flaky", State: "failed"}
+	if code := deliver(t, ts.URL, secret, "d-2", flaky); code != http.StatusInternalServerError {
+		t.Fatalf("failing handler = %d, want 500", code)
+	}
+	if code := deliver(t, ts.URL, secret, "d-2", flaky); code != http.StatusNoContent {
+		t.Fatalf("redelivery after failure = %d, want 204", code)
+	}
+
+	if code := deliver(t, ts.URL, "wrong", "d-3", status); code != http.StatusUnauthorized {
+		t.Fatalf("bad signature = %d, want 401", code)
+	}
+
+	body := []byte(`not json`)
+	req, _ := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(string(body)))
+	req.Header = signedHeader(secret, "d-4", time.Now(), body)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("bad body = %d, want 400", resp.StatusCode)
+	}
+
+	resp, err = http.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("GET = %d, want 405", resp.StatusCode)
+	}
+}
+
+// runningPlugin reports every run as running and counts status polls.
+func runningPlugin(t *testing.T, polls *int32) *jenkinsrpc.Client {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		runID := strings.TrimPrefix(r.URL.Path, "/step-rpc/v1/runs/")
+		_, _ = io.WriteString(w, `{"runId":"`+runID+`","state":"running"}`)
+	}))
+	t.Cleanup(ts.Close)
+	c, err := jenkinsrpc.New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func TestWaitRunTerminalCompletesFromWebhook(t *testing.T) {
+	t.Parallel()
+
+	recv := webhook.NewReceiver(secret)
+	hooks := httptest.NewServer(recv)
+	defer hooks.Close()
+
+	var polls int32
+	client := runningPlugin(t, &polls).WithRunNotifier(recv)
+	policy := jenkinsrpc.PollPolicy{InitialInterval: time.Hour, MaxDuration: 10 * time.Second, FailOnRunFailure: true}
+
+	req, err := webhook.NewRequest(context.Background(), hooks.URL, secret, "d-1", &steprpcv1.RunStatusResponse{RunId: "rpc-1", State: "failed"})
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	start := time.Now()
+	status, err := client.WaitRunTerminal(context.Background(), "rpc-1", policy)
+	var failed *jenkinsrpc.RunFailedError
+	if !errors.As(err, &failed) || status.GetState() != "failed" {
+		t.Fatalf("WaitRunTerminal() = %v, %v, want failed run from webhook", status, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("WaitRunTerminal() took %s, want webhook to beat the poll", elapsed)
+	}
+	if n := atomic.LoadInt32(&polls); n != 1 {
+		t.Fatalf("polls = %d, want 1", n)
+	}
+
+	// An event that arrived before the wait started is remembered.
+	deliver(t, hooks.URL, secret, "d-2", &steprpcv1.RunStatusResponse{RunId: "rpc-2", State: "succeeded"})
+	status, err = client.WaitRunTerminal(context.Background(), "rpc-2", policy)
+	if err != nil || status.GetState() != "succeeded" {
+		t.Fatalf("WaitRunTerminal(early event) = %v, %v", status, err)
+	}
+
+	// Non-terminal events are dispatched but do not end a wait.
+	deliver(t, hooks.URL, secret, "d-3", &steprpcv1.RunStatusResponse{RunId: "rpc-3", State: "running"})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := client.WaitRunTerminal(ctx, "rpc-3", policy); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitRunTerminal(running) error = %v, want deadline exceeded", err)
+	}
+}
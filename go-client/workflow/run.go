@@ -0,0 +1,363 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// cancelTimeout bounds the CancelRun call made for an in-flight run after its
+// step context is done.
+const cancelTimeout = 10 * time.Second
+
+// ErrWorkflowFailed is returned by Run when a step without ContinueOnFailure fails.
+var ErrWorkflowFailed = errors.New("workflow failed")
+
+// Executor runs single operations. *jenkinsrpc.Client satisfies it.
+type Executor interface {
+	Execute(ctx context.Context, op string, args *structpb.Struct) (*jenkinsrpc.Result, error)
+	CancelRun(ctx context.Context, runID string, reason string) (*steprpcv1.BridgeCompleteResponse, error)
+	GetRunStatus(ctx context.Context, runID string) (*steprpcv1.RunStatusResponse, error)
+}
+
+// StepStatus is the outcome of a step.
+type StepStatus string
+
+const (
+	StepSucceeded StepStatus = "succeeded"
+	StepFailed    StepStatus = "failed"
+	// StepSkipped means a dependency did not succeed, so the step never ran.
+	StepSkipped StepStatus = "skipped"
+	// StepCancelled means the workflow context ended before the step finished.
+	StepCancelled StepStatus = "cancelled"
+)
+
+// StepReport is the outcome of one step.
+type StepReport struct {
+	ID        string
+	Operation string
+	Status    StepStatus
+	Attempts  int
+	// Result is the last attempt's result, if the operation was invoked.
+	Result   *jenkinsrpc.Result
+	Err      error
+	Started  time.Time
+	Finished time.Time
+}
+
+// Report is the outcome of a workflow run. Steps are in definition order.
+type Report struct {
+	Name     string
+	Steps    []StepReport
+	Duration time.Duration
+}
+
+// Step returns the report for the step with id, or nil.
+func (r *Report) Step(id string) *StepReport {
+	for i := range r.Steps {
+		if r.Steps[i].ID == id {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// Run executes def as a DAG using exec. A step starts once all of its
+// dependencies finished; it is skipped when a dependency did not succeed,
+// unless that dependency has ContinueOnFailure. Canceling ctx stops scheduling
+// and cancels CPS runs still in flight.
+//
+// The report is always returned. The error wraps ErrWorkflowFailed when a step
+// without ContinueOnFailure fails, or ctx.Err() when the workflow is canceled.
+func Run(ctx context.Context, exec Executor, def *Definition) (*Report, error) {
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+
+	r := &runner{
+		exec:      exec,
+		def:       def,
+		ancestors: def.ancestors(),
+		index:     make(map[string]int, len(def.Steps)),
+		done:      make([]chan struct{}, len(def.Steps)),
+		reports:   make([]StepReport, len(def.Steps)),
+	}
+	if def.MaxParallel > 0 {
+		r.slots = make(chan struct{}, def.MaxParallel)
+	}
+	for i, s := range def.Steps {
+		r.index[s.ID] = i
+		r.done[i] = make(chan struct{})
+		r.reports[i] = StepReport{ID: s.ID, Operation: s.Operation}
+	}
+
+	var wg sync.WaitGroup
+	for i := range def.Steps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(r.done[i])
+			r.runStep(ctx, i)
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Name: def.Name, Steps: r.reports, Duration: time.Since(start)}
+	if err := ctx.Err(); err != nil {
+		return report, fmt.Errorf("workflow %s: %w", def.Name, err)
+	}
+	var failed []string
+	for i, s := range report.Steps {
+		if s.Status == StepFailed && !def.Steps[i].ContinueOnFailure {
+			failed = append(failed, s.ID)
+		}
+	}
+	if len(failed) > 0 {
+		return report, fmt.Errorf("workflow %s: %w: steps %s", def.Name, ErrWorkflowFailed, strings.Join(failed, ", "))
+	}
+	return report, nil
+}
+
+type runner struct {
+	exec      Executor
+	def       *Definition
+	ancestors []map[string]bool
+	index     map[string]int
+	slots     chan struct{}
+
+	// done[i] is closed once reports[i] is final. reports[i] is written only by
+	// step i's goroutine, and read by others only after done[i] is closed.
+	done    []chan struct{}
+	reports []StepReport
+}
+
+func (r *runner) runStep(ctx context.Context, i int) {
+	step := r.def.Steps[i]
+	report := &r.reports[i]
+
+	for _, dep := range step.DependsOn {
+		j := r.index[dep]
+		select {
+		case <-r.done[j]:
+		case <-ctx.Done():
+			report.Status, report.Err = StepCancelled, ctx.Err()
+			return
+		}
+		depReport, depStep := r.reports[j], r.def.Steps[j]
+		if depReport.Status == StepSucceeded || (depReport.Status == StepFailed && depStep.ContinueOnFailure) {
+			continue
+		}
+		report.Status = StepSkipped
+		report.Err = fmt.Errorf("dependency %s %s", dep, depReport.Status)
+		return
+	}
+
+	if r.slots != nil {
+		select {
+		case r.slots <- struct{}{}:
+			defer func() { <-r.slots }()
+		case <-ctx.Done():
+			report.Status, report.Err = StepCancelled, ctx.Err()
+			return
+		}
+	}
+
+	report.Started = time.Now()
+	defer func() { report.Finished = time.Now() }()
+
+	args, err := r.renderArgs(i)
+	if err != nil {
+		report.Status, report.Err = StepFailed, err
+		return
+	}
+
+	attempts := max(step.Retry.MaxAttempts, 1)
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 && step.Retry.Backoff > 0 {
+			select {
+			case <-time.After(step.Retry.Backoff):
+			case <-ctx.Done():
+				report.Status, report.Err = StepCancelled, ctx.Err()
+				return
+			}
+		}
+		report.Attempts = attempt
+		report.Result, report.Err = r.attempt(ctx, step, args)
+		switch {
+		case ctx.Err() != nil:
+			report.Status, report.Err = StepCancelled, ctx.Err()
+			return
+		case report.Err == nil && report.Result.Succeeded():
+			report.Status = StepSucceeded
+			return
+		case report.Err == nil:
+			// The run itself failed; a fresh run may succeed.
+			report.Err = fmt.Errorf("run %s %s", report.Result.RunID, report.Result.State)
+			if report.Result.Error != nil {
+				report.Err = fmt.Errorf("%w: %w", report.Err, jenkinsrpc.RunErrorOf(report.Result.Error))
+			}
+		case !retryable(report.Err):
+			report.Status = StepFailed
+			return
+		}
+		report.Status = StepFailed
+	}
+}
+
+// retryable reports whether another attempt can help after a step attempt
+// failed with err before its run reached a terminal state.
+func retryable(err error) bool {
+	var cancelErr *cancelError
+	if errors.As(err, &cancelErr) {
+		// The timed-out run may still be executing; another attempt could
+		// perform the operation twice.
+		return false
+	}
+	switch jenkinsrpc.CategoryOf(err) {
+	case jenkinsrpc.CategoryRateLimited, jenkinsrpc.CategoryServerError:
+		return true
+	case jenkinsrpc.CategoryNetwork:
+		// CategoryOf also reports client-side errors, such as an operation
+		// missing from the catalog, as Network. Only transport failures and
+		// attempt timeouts are transient.
+		var netErr net.Error
+		return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+	default:
+		return false
+	}
+}
+
+// cancelError reports that the run of a timed-out attempt could not be
+// canceled, or that it was completed elsewhere with an outcome that could not
+// be read.
+type cancelError struct {
+	runID string
+	err   error
+}
+
+func (e *cancelError) Error() string {
+	return fmt.Sprintf("cancel run %s: %v", e.runID, e.err)
+}
+
+func (e *cancelError) Unwrap() error {
+	return e.err
+}
+
+// attempt runs one attempt of step, canceling the run if the attempt's
+// context ends while the run is still in flight.
+func (r *runner) attempt(ctx context.Context, step Step, args *structpb.Struct) (*jenkinsrpc.Result, error) {
+	attemptCtx := ctx
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+		defer cancel()
+	}
+
+	res, err := r.exec.Execute(attemptCtx, step.Operation, args)
+	if err != nil && attemptCtx.Err() != nil && res != nil && res.RunID != "" && !jenkinsrpc.IsTerminalState(res.State) {
+		cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cancelTimeout)
+		defer cancel()
+		reason := fmt.Sprintf("workflow %s step %s: %v", r.def.Name, step.ID, attemptCtx.Err())
+		_, cancelErr := r.exec.CancelRun(cancelCtx, res.RunID, reason)
+		switch {
+		case cancelErr == nil:
+			res.State = "cancelled"
+		case errors.Is(cancelErr, jenkinsrpc.ErrRunNotFound):
+			// The run was completed before the cancel landed, possibly
+			// successfully; its own outcome decides the attempt.
+			status, statusErr := r.exec.GetRunStatus(cancelCtx, res.RunID)
+			if statusErr == nil && jenkinsrpc.RunStateOf(status).IsTerminal() {
+				res.State, res.Error = string(jenkinsrpc.RunStateOf(status)), status.GetError()
+				return res, nil
+			}
+			if statusErr == nil {
+				statusErr = fmt.Errorf("run is %s", status.GetState())
+			}
+			err = errors.Join(err, &cancelError{runID: res.RunID, err: fmt.Errorf("%w; outcome unknown: %w", cancelErr, statusErr)})
+		default:
+			err = errors.Join(err, &cancelError{runID: res.RunID, err: cancelErr})
+		}
+	}
+	return res, err
+}
+
+// renderArgs expands templates in the string values of step i's args.
+func (r *runner) renderArgs(i int) (*structpb.Struct, error) {
+	step := r.def.Steps[i]
+	if len(step.Args) == 0 {
+		return nil, nil
+	}
+
+	steps := make(map[string]any, len(r.ancestors[i]))
+	for id := range r.ancestors[i] {
+		rep := r.reports[r.index[id]]
+		out := map[string]any{"operation": rep.Operation, "state": string(rep.Status)}
+		if rep.Result != nil {
+			out["requestId"] = rep.Result.RequestID
+			out["runId"] = rep.Result.RunID
+			out["state"] = rep.Result.State
+		}
+		steps[id] = out
+	}
+	data := map[string]any{"steps": steps}
+
+	rendered, err := renderValue(step.ID, step.Args, data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := structpb.NewStruct(rendered.(map[string]any))
+	if err != nil {
+		return nil, fmt.Errorf("step %s args: %w", step.ID, err)
+	}
+	return args, nil
+}
+
+func renderValue(stepID string, v any, data map[string]any) (any, error) {
+	switch tv := v.(type) {
+	case string:
+		if !strings.Contains(tv, "{{") {
+			return tv, nil
+		}
+		tmpl, err := template.New(stepID).Option("missingkey=error").Parse(tv)
+		if err != nil {
+			return nil, fmt.Errorf("step %s args: %w", stepID, err)
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			return nil, fmt.Errorf("step %s args: %w", stepID, err)
+		}
+		return sb.String(), nil
+	case map[string]any:
+		out := make(map[string]any, len(tv))
+		for k, item := range tv {
+			rendered, err := renderValue(stepID, item, data)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = rendered
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(tv))
+		for i, item := range tv {
+			rendered, err := renderValue(stepID, item, data)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
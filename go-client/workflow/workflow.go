@@ -0,0 +1,190 @@
+// Package workflow runs chained Step RPC operations as a DAG.
+//
+// A Definition declares steps with dependencies, per-step args, retry and
+// timeout policies, and continue-on-failure flags. Definitions are built in Go
+// or loaded from YAML:
+//
+//	name: publish
+//	maxParallel: 2
+//	steps:
+//	  - id: tests
+//	    operation: junit
+//	    args: {testResults: "build/test-results/**/*.xml"}
+//	    retry: {maxAttempts: 3, backoff: 5s}
+//	  - id: archive
+//	    operation: archiveArtifacts
+//	    dependsOn: [tests]
+//	    timeout: 2m
+//	    args: {artifacts: "build/libs/*.jar", fingerprint: true}
+//
+// String args may reference the outcome of any step they transitively depend on
+// with text/template syntax, e.g. "{{ .steps.tests.runId }}". Each referenced
+// step exposes requestId, runId, operation, and state.
+package workflow
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidDefinition is returned when a definition fails validation.
+var ErrInvalidDefinition = errors.New("invalid workflow definition")
+
+// Definition is a workflow of steps executed as a DAG.
+type Definition struct {
+	Name string `yaml:"name"`
+	// MaxParallel bounds how many steps run at once. Zero means unbounded.
+	MaxParallel int    `yaml:"maxParallel"`
+	Steps       []Step `yaml:"steps"`
+}
+
+// Step is one operation invocation in a workflow.
+type Step struct {
+	ID        string         `yaml:"id"`
+	Operation string         `yaml:"operation"`
+	DependsOn []string       `yaml:"dependsOn"`
+	Args      map[string]any `yaml:"args"`
+	Retry     Retry          `yaml:"retry"`
+	// Timeout bounds each attempt, including the wait for a CPS run to finish.
+	// Zero means no per-attempt timeout.
+	Timeout time.Duration `yaml:"timeout"`
+	// ContinueOnFailure lets dependents run, and the workflow succeed, when this
+	// step fails.
+	ContinueOnFailure bool `yaml:"continueOnFailure"`
+}
+
+// Retry controls how often a failed step is attempted again. Failed runs,
+// transport errors, timeouts, rate limiting, and server errors are retried;
+// rejected requests are not, nor is a timed-out attempt whose run could not be
+// canceled.
+type Retry struct {
+	// MaxAttempts is the total number of attempts. Zero or one means no retry.
+	MaxAttempts int `yaml:"maxAttempts"`
+	// Backoff is the fixed delay between attempts.
+	Backoff time.Duration `yaml:"backoff"`
+}
+
+// Parse decodes a YAML definition and validates it. Unknown fields are rejected.
+func Parse(data []byte) (*Definition, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	def := &Definition{}
+	if err := dec.Decode(def); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// Load reads and parses a YAML definition file.
+func Load(path string) (*Definition, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // workflow paths are chosen by the caller
+	if err != nil {
+		return nil, fmt.Errorf("read workflow: %w", err)
+	}
+	def, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return def, nil
+}
+
+// Validate checks step IDs are unique, operations are set, dependencies exist,
+// and the dependency graph is acyclic.
+func (d *Definition) Validate() error {
+	if len(d.Steps) == 0 {
+		return fmt.Errorf("%w: no steps", ErrInvalidDefinition)
+	}
+	if d.MaxParallel < 0 {
+		return fmt.Errorf("%w: maxParallel must not be negative", ErrInvalidDefinition)
+	}
+	index := make(map[string]int, len(d.Steps))
+	for i, s := range d.Steps {
+		if s.ID == "" {
+			return fmt.Errorf("%w: step %d has no id", ErrInvalidDefinition, i)
+		}
+		if _, dup := index[s.ID]; dup {
+			return fmt.Errorf("%w: duplicate step id %q", ErrInvalidDefinition, s.ID)
+		}
+		if s.Operation == "" {
+			return fmt.Errorf("%w: step %q has no operation", ErrInvalidDefinition, s.ID)
+		}
+		if s.Timeout < 0 || s.Retry.Backoff < 0 || s.Retry.MaxAttempts < 0 {
+			return fmt.Errorf("%w: step %q has a negative timeout or retry setting", ErrInvalidDefinition, s.ID)
+		}
+		index[s.ID] = i
+	}
+	for _, s := range d.Steps {
+		for _, dep := range s.DependsOn {
+			if _, ok := index[dep]; !ok {
+				return fmt.Errorf("%w: step %q depends on unknown step %q", ErrInvalidDefinition, s.ID, dep)
+			}
+		}
+	}
+
+	// marks is zero for unvisited steps.
+	const (
+		visiting = iota + 1
+		visited
+	)
+	marks := make([]int, len(d.Steps))
+	var visit func(i int, path []string) error
+	visit = func(i int, path []string) error {
+		switch marks[i] {
+		case visiting:
+			return fmt.Errorf("%w: dependency cycle %v", ErrInvalidDefinition, append(path, d.Steps[i].ID))
+		case visited:
+			return nil
+		}
+		marks[i] = visiting
+		for _, dep := range d.Steps[i].DependsOn {
+			if err := visit(index[dep], append(path, d.Steps[i].ID)); err != nil {
+				return err
+			}
+		}
+		marks[i] = visited
+		return nil
+	}
+	for i := range d.Steps {
+		if err := visit(i, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ancestors returns, for each step index, the set of step IDs it transitively
+// depends on. Validate must have succeeded.
+func (d *Definition) ancestors() []map[string]bool {
+	index := make(map[string]int, len(d.Steps))
+	for i, s := range d.Steps {
+		index[s.ID] = i
+	}
+	out := make([]map[string]bool, len(d.Steps))
+	var collect func(i int) map[string]bool
+	collect = func(i int) map[string]bool {
+		if out[i] != nil {
+			return out[i]
+		}
+		set := map[string]bool{}
+		for _, dep := range d.Steps[i].DependsOn {
+			set[dep] = true
+			for a := range collect(index[dep]) {
+				set[a] = true
+			}
+		}
+		out[i] = set
+		return set
+	}
+	for i := range d.Steps {
+		collect(i)
+	}
+	return out
+}
@@ -0,0 +1,499 @@
+package workflow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	steprpcv1 "github.com/albertocavalcante/jenkins-rpc/contracts/gen/go/proto/steprpc/v1"
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/workflow"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeExecutor runs operations through a per-operation function and records
+// call order and peak concurrency.
+type fakeExecutor struct {
+	mu        sync.Mutex
+	fn        map[string]func(ctx context.Context, attempt int, args *structpb.Struct) (*jenkinsrpc.Result, error)
+	calls     []string
+	attempts  map[string]int
+	active    int
+	peak      int
+	args      map[string]*structpb.Struct
+	cancelErr error
+	// status answers GetRunStatus; nil reports the run as not found.
+	status func(runID string) *steprpcv1.RunStatusResponse
+}
+
+func newFakeExecutor() *fakeExecutor {
+	return &fakeExecutor{
+		fn:       map[string]func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error){},
+		attempts: map[string]int{},
+		args:     map[string]*structpb.Struct{},
+	}
+}
+
+func (f *fakeExecutor) Execute(ctx context.Context, op string, args *structpb.Struct) (*jenkinsrpc.Result, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, op)
+	f.attempts[op]++
+	attempt := f.attempts[op]
+	f.args[op] = args
+	f.active++
+	f.peak = max(f.peak, f.active)
+	fn := f.fn[op]
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.active--
+		f.mu.Unlock()
+	}()
+	if fn != nil {
+		return fn(ctx, attempt, args)
+	}
+	time.Sleep(time.Millisecond)
+	return succeeded(op), nil
+}
+
+func (f *fakeExecutor) CancelRun(context.Context, string, string) (*steprpcv1.BridgeCompleteResponse, error) {
+	if f.cancelErr != nil {
+		return nil, f.cancelErr
+	}
+	return &steprpcv1.BridgeCompleteResponse{State: "cancelled"}, nil
+}
+
+func (f *fakeExecutor) GetRunStatus(_ context.Context, runID string) (*steprpcv1.RunStatusResponse, error) {
+	if f.status != nil {
+		if st := f.status(runID); st != nil {
+			return st, nil
+		}
+	}
+	return nil, &jenkinsrpc.HTTPError{
+		StatusCode: http.StatusNotFound,
+		ProtoError: &steprpcv1.Error{Code: "run_not_found", Message: "no run found"},
+	}
+}
+
+func succeeded(op string) *jenkinsrpc.Result {
+	return &jenkinsrpc.Result{RequestID: "req-" + op, RunID: "rpc-" + op, Operation: op, State: "succeeded"}
+}
+
+func failed(op string) *jenkinsrpc.Result {
+	return &jenkinsrpc.Result{
+		RunID:     "rpc-" + op,
+		Operation: op,
+		State:     "failed",
+		Error:     &steprpcv1.Error{Code: "operation_failed", Message: "boom"},
+	}
+}
+
+const publishYAML = `
+name: publish
+maxParallel: 1
+steps:
+  - id: tests
+    operation: junit
+    args: {testResults: "**/*.xml"}
+    retry: {maxAttempts: 3, backoff: 1ms}
+  - id: archive
+    operation: archiveArtifacts
+    dependsOn: [tests]
+    timeout: 2m
+    args:
+      artifacts: "build/libs/*.jar"
+      fingerprint: true
+  - id: stash
+    operation: stash
+    dependsOn: [tests]
+    args: {name: "tests-{{ .steps.tests.runId }}"}
+  - id: notify
+    operation: echo
+    dependsOn: [archive, stash]
+    args:
+      message: "{{ .steps.tests.state }}/{{ .steps.archive.runId }}"
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	def, err := workflow.Parse([]byte(publishYAML))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if def.Name != "publish" || def.MaxParallel != 1 || len(def.Steps) != 4 {
+		t.Fatalf("definition = %+v", def)
+	}
+	tests := def.Steps[0]
+	if tests.Retry.MaxAttempts != 3 || tests.Retry.Backoff != time.Millisecond {
+		t.Fatalf("retry = %+v, want 3 attempts with 1ms backoff", tests.Retry)
+	}
+	archive := def.Steps[1]
+	if archive.Timeout != 2*time.Minute || archive.Args["fingerprint"] != true {
+		t.Fatalf("archive = %+v", archive)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"no steps":       `name: empty`,
+		"unknown field":  "steps:\n  - id: a\n    operation: junit\n    retries: 3\n",
+		"missing op":     "steps:\n  - id: a\n",
+		"duplicate id":   "steps:\n  - {id: a, operation: junit}\n  - {id: a, operation: junit}\n",
+		"unknown dep":    "steps:\n  - {id: a, operation: junit, dependsOn: [b]}\n",
+		"cycle":          "steps:\n  - {id: a, operation: junit, dependsOn: [c]}\n  - {id: b, operation: junit, dependsOn: [a]}\n  - {id: c, operation: junit, dependsOn: [b]}\n",
+		"bad duration":   "steps:\n  - {id: a, operation: junit, timeout: soon}\n",
+		"negative slots": "maxParallel: -1\nsteps:\n  - {id: a, operation: junit}\n",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := workflow.Parse([]byte(data)); !errors.Is(err, workflow.ErrInvalidDefinition) {
+				t.Fatalf("Parse() error = %v, want ErrInvalidDefinition", err)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "publish.yaml")
+	if err := os.WriteFile(path, []byte(publishYAML), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	def, err := workflow.Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if def.Name != "publish" {
+		t.Fatalf("name = %q, want publish", def.Name)
+	}
+}
+
+func TestRun_DAGOrderAndTemplates(t *testing.T) {
+	t.Parallel()
+
+	def, err := workflow.Parse([]byte(publishYAML))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	exec := newFakeExecutor()
+
+	report, err := workflow.Run(context.Background(), exec, def)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, s := range report.Steps {
+		if s.Status != workflow.StepSucceeded || s.Attempts != 1 {
+			t.Fatalf("step %s = %s after %d attempts, want succeeded after 1", s.ID, s.Status, s.Attempts)
+		}
+	}
+	if exec.peak != 1 {
+		t.Fatalf("peak concurrency = %d, want 1", exec.peak)
+	}
+	if exec.calls[0] != "junit" || exec.calls[3] != "echo" {
+		t.Fatalf("calls = %v, want junit first and echo last", exec.calls)
+	}
+	if got := exec.args["stash"].GetFields()["name"].GetStringValue(); got != "tests-rpc-junit" {
+		t.Fatalf("stash name = %q, want tests-rpc-junit", got)
+	}
+	if got := exec.args["echo"].GetFields()["message"].GetStringValue(); got != "succeeded/rpc-archiveArtifacts" {
+		t.Fatalf("echo message = %q", got)
+	}
+}
+
+func TestRun_BoundedParallelism(t *testing.T) {
+	t.Parallel()
+
+	def := &workflow.Definition{Name: "fan-out", MaxParallel: 2}
+	for i := range 6 {
+		def.Steps = append(def.Steps, workflow.Step{ID: fmt.Sprintf("s%d", i), Operation: fmt.Sprintf("op%d", i)})
+	}
+	exec := newFakeExecutor()
+	release := make(chan struct{})
+	for i := range 6 {
+		exec.fn[fmt.Sprintf("op%d", i)] = func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error) {
+			<-release
+			return succeeded("op"), nil
+		}
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+
+	if _, err := workflow.Run(context.Background(), exec, def); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if exec.peak != 2 {
+		t.Fatalf("peak concurrency = %d, want 2", exec.peak)
+	}
+}
+
+func TestRun_RetryAndFailurePolicy(t *testing.T) {
+	t.Parallel()
+
+	def := &workflow.Definition{
+		Name: "mixed",
+		Steps: []workflow.Step{
+			{ID: "flaky", Operation: "flaky", Retry: workflow.Retry{MaxAttempts: 3}},
+			{ID: "optional", Operation: "optional", ContinueOnFailure: true},
+			{ID: "after-optional", Operation: "after-optional", DependsOn: []string{"optional"}},
+			{ID: "broken", Operation: "broken"},
+			{ID: "after-broken", Operation: "after-broken", DependsOn: []string{"broken"}},
+		},
+	}
+	exec := newFakeExecutor()
+	exec.fn["flaky"] = func(_ context.Context, attempt int, _ *structpb.Struct) (*jenkinsrpc.Result, error) {
+		switch attempt {
+		case 1:
+			return nil, &url.Error{Op: "Post", URL: "http://jenkins/step-rpc/v1/invoke", Err: errors.New("connection reset")}
+		case 2:
+			return nil, &jenkinsrpc.HTTPError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return succeeded("flaky"), nil
+	}
+	exec.fn["optional"] = func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error) {
+		return failed("optional"), nil
+	}
+	exec.fn["broken"] = func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error) {
+		return failed("broken"), nil
+	}
+
+	report, err := workflow.Run(context.Background(), exec, def)
+	if !errors.Is(err, workflow.ErrWorkflowFailed) {
+		t.Fatalf("Run() error = %v, want ErrWorkflowFailed", err)
+	}
+
+	want := map[string]workflow.StepStatus{
+		"flaky":          workflow.StepSucceeded,
+		"optional":       workflow.StepFailed,
+		"after-optional": workflow.StepSucceeded,
+		"broken":         workflow.StepFailed,
+		"after-broken":   workflow.StepSkipped,
+	}
+	for id, status := range want {
+		if got := report.Step(id).Status; got != status {
+			t.Fatalf("step %s = %s, want %s", id, got, status)
+		}
+	}
+	if got := report.Step("flaky").Attempts; got != 3 {
+		t.Fatalf("flaky attempts = %d, want 3", got)
+	}
+	if !errors.Is(report.Step("broken").Err, jenkinsrpc.ErrOperationFailed) {
+		t.Fatalf("broken error = %v, want ErrOperationFailed", report.Step("broken").Err)
+	}
+	if slices.Contains(exec.calls, "after-broken") {
+		t.Fatalf("skipped step was executed: %v", exec.calls)
+	}
+}
+
+func TestRun_RetryOnlyWhenItCanHelp(t *testing.T) {
+	t.Parallel()
+
+	def := &workflow.Definition{
+		Name: "no-retry",
+		Steps: []workflow.Step{
+			{ID: "rejected", Operation: "rejected", Retry: workflow.Retry{MaxAttempts: 3}},
+			{ID: "uncatalogued", Operation: "uncatalogued", Retry: workflow.Retry{MaxAttempts: 3}},
+			{ID: "stuck", Operation: "stuck", Timeout: 5 * time.Millisecond, Retry: workflow.Retry{MaxAttempts: 3}},
+		},
+	}
+	exec := newFakeExecutor()
+	exec.cancelErr = &jenkinsrpc.HTTPError{StatusCode: http.StatusInternalServerError}
+	exec.fn["rejected"] = func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error) {
+		return nil, &jenkinsrpc.HTTPError{
+			StatusCode: http.StatusBadRequest,
+			ProtoError: &steprpcv1.Error{Code: "operation_not_allowed", Message: "denied"},
+		}
+	}
+	exec.fn["uncatalogued"] = func(context.Context, int, *structpb.Struct) (*jenkinsrpc.Result, error) {
+		return nil, fmt.Errorf("execute uncatalogued: %w", jenkinsrpc.ErrOperationNotInCatalog)
+	}
+	exec.fn["stuck"] = func(ctx context.Context, _ int, _ *structpb.Struct) (*jenkinsrpc.Result, error) {
+		<-ctx.Done()
+		return &jenkinsrpc.Result{RunID: "rpc-stuck", Operation: "stuck", State: "running"}, ctx.Err()
+	}
+
+	report, err := workflow.Run(context.Background(), exec, def)
+	if !errors.Is(err, workflow.ErrWorkflowFailed) {
+		t.Fatalf("Run() error = %v, want ErrWorkflowFailed", err)
+	}
+	for _, id := range []string{"rejected", "uncatalogued", "stuck"} {
+		got := report.Step(id)
+		if got.Status != workflow.StepFailed || got.Attempts != 1 {
+			t.Fatalf("step %s = %s after %d attempts, want failed after 1", id, got.Status, got.Attempts)
+		}
+	}
+	if err := report.Step("stuck").Err; !errors.Is(err, context.DeadlineExceeded) || jenkinsrpc.CategoryOf(err) != jenkinsrpc.CategoryServerError {
+		t.Fatalf("stuck error = %v, want deadline exceeded and cancel failure", err)
+	}
+}
+
+func TestRun_CancelLosesRaceToCompletion(t *testing.T) {
+	t.Parallel()
+
+	def := &workflow.Definition{
+		Name: "race",
+		Steps: []workflow.Step{
+			{ID: "finished", Operation: "finished", Timeout: 5 * time.Millisecond, Retry: workflow.Retry{MaxAttempts: 3}},
+			{ID: "vanished", Operation: "vanished", Timeout: 5 * time.Millisecond, Retry: workflow.Retry{MaxAttempts: 3}},
+		},
+	}
+	exec := newFakeExecutor()
+	// A worker completes each run just before the cancel reaches the plugin.
+	exec.cancelErr = &jenkinsrpc.HTTPError{
+		StatusCode: http.StatusNotFound,
+		ProtoError: &steprpcv1.Error{Code: "run_not_found", Message: "no pending bridge request found"},
+	}
+	exec.status = func(runID string) *steprpcv1.RunStatusResponse {
+		if runID == "rpc-finished" {
+			return &steprpcv1.RunStatusResponse{RunId: runID, State: "succeeded"}
+		}
+		return nil
+	}
+	for _, op := range []string{"finished", "vanished"} {
+		exec.fn[op] = func(ctx context.Context, _ int, _ *structpb.Struct) (*jenkinsrpc.Result, error) {
+			<-ctx.Done()
+			return &jenkinsrpc.Result{RunID: "rpc-" + op, Operation: op, State: "running"}, ctx.Err()
+		}
+	}
+
+	report, err := workflow.Run(context.Background(), exec, def)
+	if !errors.Is(err, workflow.ErrWorkflowFailed) {
+		t.Fatalf("Run() error = %v, want ErrWorkflowFailed", err)
+	}
+	if got := report.Step("finished"); got.Status != workflow.StepSucceeded || got.Attempts != 1 || got.Result.State != "succeeded" {
+		t.Fatalf("finished = %s after %d attempts (%v), want succeeded after 1", got.Status, got.Attempts, got.Err)
+	}
+	got := report.Step("vanished")
+	if got.Status != workflow.StepFailed || got.Attempts != 1 {
+		t.Fatalf("vanished = %s after %d attempts, want failed after 1", got.Status, got.Attempts)
+	}
+	if !errors.Is(got.Err, jenkinsrpc.ErrRunNotFound) {
+		t.Fatalf("vanished error = %v, want the cancel's run_not_found", got.Err)
+	}
+}
+
+// bridgeServer is a plugin whose CPS runs stay queued until canceled through
+// the bridge completion endpoint.
+func bridgeServer(t *testing.T, cancelled chan<- *steprpcv1.BridgeCompleteRequest) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/step-rpc/v1/catalog", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operations":[{"name":"junit","description":"Publish","executionMode":"OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"}]}`))
+	})
+	mux.HandleFunc("/step-rpc/v1/invoke", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"requestId":"req-1","runId":"rpc-1","state":"queued"}`))
+	})
+	mux.HandleFunc("/step-rpc/v1/runs/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"requestId":"req-1","runId":"rpc-1","operation":"junit","state":"queued"}`))
+	})
+	mux.HandleFunc("/step-rpc/v1/bridge/complete", func(w http.ResponseWriter, r *http.Request) {
+		in := &steprpcv1.BridgeCompleteRequest{}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("ReadAll() error = %v", err)
+		}
+		if err := protojson.Unmarshal(body, in); err != nil {
+			t.Errorf("Unmarshal() error = %v", err)
+		}
+		cancelled <- in
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"requestId":"req-1","runId":"rpc-1","state":"cancelled"}`))
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func bridgeClient(t *testing.T, ts *httptest.Server) *jenkinsrpc.Client {
+	t.Helper()
+	c, err := jenkinsrpc.New(ts.URL, "", ts.Client())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c.
+		WithRunContext(jenkinsrpc.NewRunContextForRun("job/1", "built-in", "/ws")).
+		WithBridgePollPolicy(jenkinsrpc.PollPolicy{InitialInterval: time.Millisecond})
+}
+
+func TestRun_CancelPropagatesToInFlightRun(t *testing.T) {
+	t.Parallel()
+
+	cancelled := make(chan *steprpcv1.BridgeCompleteRequest, 1)
+	client := bridgeClient(t, bridgeServer(t, cancelled))
+	def := &workflow.Definition{
+		Name: "cancel",
+		Steps: []workflow.Step{
+			{ID: "tests", Operation: "junit"},
+			{ID: "after", Operation: "junit", DependsOn: []string{"tests"}},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	report, err := workflow.Run(ctx, client, def)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v, want context.Canceled", err)
+	}
+	if got := report.Step("tests"); got.Status != workflow.StepCancelled || got.Result.State != "cancelled" {
+		t.Fatalf("tests = %+v, want cancelled", got)
+	}
+	if got := report.Step("after").Status; got != workflow.StepCancelled {
+		t.Fatalf("after = %s, want cancelled", got)
+	}
+
+	select {
+	case req := <-cancelled:
+		if req.GetRunId() != "rpc-1" || req.GetState() != "cancelled" {
+			t.Fatalf("bridge complete = %v, want rpc-1 cancelled", req)
+		}
+	default:
+		t.Fatalf("in-flight run was not cancelled")
+	}
+}
+
+func TestRun_TimeoutCancelsRunAndFails(t *testing.T) {
+	t.Parallel()
+
+	cancelled := make(chan *steprpcv1.BridgeCompleteRequest, 1)
+	client := bridgeClient(t, bridgeServer(t, cancelled))
+	def := &workflow.Definition{
+		Name:  "timeout",
+		Steps: []workflow.Step{{ID: "tests", Operation: "junit", Timeout: 20 * time.Millisecond}},
+	}
+
+	report, err := workflow.Run(context.Background(), client, def)
+	if !errors.Is(err, workflow.ErrWorkflowFailed) {
+		t.Fatalf("Run() error = %v, want ErrWorkflowFailed", err)
+	}
+	if got := report.Step("tests"); got.Status != workflow.StepFailed || !errors.Is(got.Err, context.DeadlineExceeded) {
+		t.Fatalf("tests = %+v, want failed with deadline exceeded", got)
+	}
+	if len(cancelled) != 1 {
+		t.Fatalf("in-flight run was not cancelled")
+	}
+}
@@ -0,0 +1,188 @@
+package io.albertocavalcante.jenkins.steprpc
+
+import hudson.ProxyConfiguration
+import java.io.IOException
+import java.net.Inet6Address
+import java.net.InetAddress
+import java.net.URI
+import java.net.UnknownHostException
+import java.net.http.HttpClient
+import java.net.http.HttpRequest
+import java.net.http.HttpResponse
+import java.nio.charset.StandardCharsets
+import java.time.Duration
+import java.time.Instant
+import java.util.UUID
+import java.util.concurrent.TimeUnit
+import java.util.logging.Level
+import java.util.logging.Logger
+import javax.crypto.Mac
+import javax.crypto.spec.SecretKeySpec
+import jenkins.util.SystemProperties
+import jenkins.util.Timer
+
+// Waits before each redelivery of a callback not acknowledged with a 2xx; the
+// first delivery is immediate.
+private val CALLBACK_RETRY_DELAYS: List<Duration> = listOf(
+    Duration.ofSeconds(10),
+    Duration.ofMinutes(1),
+    Duration.ofMinutes(5),
+    Duration.ofMinutes(30),
+)
+
+private val CALLBACK_TIMEOUT: Duration = Duration.ofSeconds(10)
+
+private val LOGGER: Logger = Logger.getLogger(CallbackNotifier::class.java.name)
+
+// System property naming the only hosts callbacks may go to, comma separated;
+// "*.example.com" matches any subdomain. Set it to call back to internal hosts.
+val CALLBACK_ALLOWED_HOSTS_PROPERTY: String = "${CallbackNotifier::class.java.name}.allowedHosts"
+
+// InvokeRequest.callback_url and callback_secret of a run. The secret is kept
+// out of toString so records can be logged.
+data class RunCallback(val url: String, val secret: String) {
+    override fun toString(): String = "RunCallback(url=$url, secret=***)"
+}
+
+// X-StepRpc-Signature for body sent as deliveryId at timestamp (Unix seconds):
+// v1= and the hex HMAC-SHA256 of "deliveryId.timestamp.body", as specified on
+// InvokeRequest in contracts.proto.
+fun callbackSignature(secret: String, deliveryId: String, timestamp: Long, body: ByteArray): String {
+    val mac = Mac.getInstance("HmacSHA256")
+    mac.init(SecretKeySpec(secret.toByteArray(StandardCharsets.UTF_8), "HmacSHA256"))
+    mac.update("$deliveryId.$timestamp.".toByteArray(StandardCharsets.UTF_8))
+    return "v1=" + mac.doFinal(body).joinToString("") { "%02x".format(it) }
+}
+
+// Posts a terminal run's RunStatusResponse to its callback URL, redelivering
+// under the same delivery id until a 2xx or the retry schedule runs out.
+// Deliveries go through the Jenkins proxy configuration, do not follow
+// redirects, and re-check the URL against policy, so a host that has since
+// resolved to an internal address is not called.
+class CallbackNotifier(
+    private val policy: CallbackPolicy = CallbackPolicy(),
+    private val client: HttpClient = ProxyConfiguration.newHttpClientBuilder()
+        .connectTimeout(CALLBACK_TIMEOUT)
+        .followRedirects(HttpClient.Redirect.NEVER)
+        .build(),
+) {
+    fun notify(record: RunRecord) {
+        val callback = record.callback ?: return
+        val body = protoToJson(runStatusResponse(record)).toByteArray(StandardCharsets.UTF_8)
+        val delivery = Delivery(record.runId, callback, UUID.randomUUID().toString(), body)
+        Timer.get().execute { deliver(delivery, 0) }
+    }
+
+    private fun deliver(delivery: Delivery, attempt: Int) {
+        val problem = policy.problem(delivery.callback.url)
+        if (problem != null) {
+            LOGGER.log(Level.WARNING, "callback for run ${delivery.runId} refused: $problem")
+            audit("callback.refused", delivery, attempt, null)
+            return
+        }
+        val status = try {
+            send(delivery)
+        } catch (e: IOException) {
+            LOGGER.log(Level.FINE, "callback for run ${delivery.runId} failed", e)
+            null
+        } catch (_: InterruptedException) {
+            Thread.currentThread().interrupt()
+            return
+        }
+
+        if (status != null && status in 200..299) {
+            audit("callback.delivered", delivery, attempt, status)
+            return
+        }
+        val delay = CALLBACK_RETRY_DELAYS.getOrNull(attempt)
+        if (delay == null) {
+            audit("callback.failed", delivery, attempt, status)
+            return
+        }
+        Timer.get().schedule(Runnable { deliver(delivery, attempt + 1) }, delay.toMillis(), TimeUnit.MILLISECONDS)
+    }
+
+    private fun send(delivery: Delivery): Int {
+        val timestamp = Instant.now().epochSecond
+        val request = HttpRequest.newBuilder(URI.create(delivery.callback.url))
+            .timeout(CALLBACK_TIMEOUT)
+            .header("Content-Type", "application/json")
+            .header("X-StepRpc-Delivery", delivery.id)
+            .header("X-StepRpc-Timestamp", timestamp.toString())
+            .header("X-StepRpc-Signature", callbackSignature(delivery.callback.secret, delivery.id, timestamp, delivery.body))
+            .POST(HttpRequest.BodyPublishers.ofByteArray(delivery.body))
+            .build()
+        return client.send(request, HttpResponse.BodyHandlers.discarding()).statusCode()
+    }
+
+    private fun audit(action: String, delivery: Delivery, attempt: Int, status: Int?) {
+        AuditLogger.log(
+            action,
+            mapOf(
+                "runId" to delivery.runId,
+                "deliveryId" to delivery.id,
+                "attempt" to (attempt + 1).toString(),
+                "status" to status?.toString(),
+            ),
+        )
+    }
+
+    private class Delivery(val runId: String, val callback: RunCallback, val id: String, val body: ByteArray)
+}
+
+// Decides which URLs runs may be called back at. With no allowedHosts, any
+// http or https host that resolves only to public addresses is accepted, which
+// keeps invokers from probing the controller's own network. With allowedHosts,
+// only those hosts are accepted, whatever they resolve to.
+class CallbackPolicy(
+    private val allowedHosts: List<String> = configuredCallbackHosts(),
+    private val resolve: (String) -> List<InetAddress> = { InetAddress.getAllByName(it).toList() },
+) {
+    // Reports why url cannot be used as a callback URL, or null when it can.
+    fun problem(url: String): String? {
+        val uri = try {
+            URI(url)
+        } catch (_: Exception) {
+            return "callbackUrl is not a valid URL"
+        }
+        val scheme = uri.scheme?.lowercase()
+        val host = uri.host?.lowercase()?.removeSurrounding("[", "]")
+        if ((scheme != "http" && scheme != "https") || host.isNullOrBlank()) {
+            return "callbackUrl must be an absolute http or https URL"
+        }
+        if (allowedHosts.isNotEmpty()) {
+            return "callbackUrl host '$host' is not in the callback allowlist".takeUnless { allowedHosts.any { hostMatches(it, host) } }
+        }
+        val addresses = try {
+            resolve(host)
+        } catch (_: UnknownHostException) {
+            return "callbackUrl host '$host' cannot be resolved"
+        }
+        if (addresses.isEmpty() || addresses.any { !it.isPublic() }) {
+            return "callbackUrl host '$host' resolves to a non-public address; an administrator must allow it with $CALLBACK_ALLOWED_HOSTS_PROPERTY"
+        }
+        return null
+    }
+
+    private fun hostMatches(pattern: String, host: String): Boolean =
+        if (pattern.startsWith("*.")) host.endsWith(pattern.substring(1)) else host == pattern
+}
+
+private fun configuredCallbackHosts(): List<String> =
+    SystemProperties.getString(CALLBACK_ALLOWED_HOSTS_PROPERTY, "")
+        .split(',')
+        .map { it.trim().lowercase() }
+        .filter { it.isNotEmpty() }
+
+// Loopback, link-local, private (including IPv6 unique local and carrier-grade
+// NAT), wildcard, and multicast addresses are not public.
+private fun InetAddress.isPublic(): Boolean {
+    if (isLoopbackAddress || isLinkLocalAddress || isSiteLocalAddress || isAnyLocalAddress || isMulticastAddress) {
+        return false
+    }
+    val bytes = address
+    if (this is Inet6Address) {
+        return (bytes[0].toInt() and 0xfe) != 0xfc
+    }
+    return !((bytes[0].toInt() and 0xff) == 100 && (bytes[1].toInt() and 0xc0) == 64)
+}
@@ -1,7 +1,25 @@
 package io.albertocavalcante.jenkins.steprpc
 
+import java.time.Duration
+import java.time.Instant
+import java.util.UUID
 import java.util.concurrent.ConcurrentHashMap
 import java.util.concurrent.ConcurrentLinkedQueue
+import java.util.concurrent.locks.ReentrantLock
+import kotlin.concurrent.withLock
+
+// Lease length granted when a claim or heartbeat asks for none, and the cap on
+// what it may ask for.
+val DEFAULT_LEASE_TTL: Duration = Duration.ofSeconds(60)
+val MAX_LEASE_TTL: Duration = Duration.ofSeconds(600)
+
+// Longest bridge/pending long poll; a waiting request holds a request thread.
+val MAX_PENDING_WAIT: Duration = Duration.ofSeconds(30)
+
+// How long a request handed out by bridge/pending is withheld from further
+// pending fetches and claims. A worker that has not completed it by then is
+// presumed gone and the request is delivered again.
+val PENDING_DELIVERY_TTL: Duration = DEFAULT_LEASE_TTL
 
 data class PendingBridgeRequest(
     val requestId: String,
@@ -11,28 +29,197 @@ data class PendingBridgeRequest(
     val targetRunExternalizableId: String,
 )
 
+data class BridgeLease(
+    val leaseId: String,
+    val runId: String,
+    val workerId: String,
+    val expiresAt: Instant,
+) {
+    fun isLive(now: Instant): Boolean = now.isBefore(expiresAt)
+}
+
+// A target run with queued requests, as listed by bridge/runs.
+data class BridgeRunSummary(val targetRunExternalizableId: String, val pending: Int, val leased: Int)
+
+data class BridgeClaim(val request: PendingBridgeRequest, val lease: BridgeLease)
+
+// Outcome of a heartbeat or completion checked against the current lease.
+sealed interface LeaseCheck<out T> {
+    data class Ok<T>(val value: T) : LeaseCheck<T>
+
+    object NotFound : LeaseCheck<Nothing>
+
+    data class Rejected(val code: String, val message: String) : LeaseCheck<Nothing>
+}
+
 class CpsBridgeQueue {
     private val byTargetRun = ConcurrentHashMap<String, ConcurrentLinkedQueue<PendingBridgeRequest>>()
     private val targetRunByRunID = ConcurrentHashMap<String, String>()
+    private val leaseByRunID = ConcurrentHashMap<String, BridgeLease>()
+    private val deliveredUntilByRunID = ConcurrentHashMap<String, Instant>()
+    private val lock = ReentrantLock()
+    private val enqueued = lock.newCondition()
 
     fun enqueue(request: PendingBridgeRequest) {
-        val queue = byTargetRun.computeIfAbsent(request.targetRunExternalizableId) { ConcurrentLinkedQueue() }
-        queue.add(request)
-        targetRunByRunID[request.runId] = request.targetRunExternalizableId
+        lock.withLock {
+            val queue = byTargetRun.computeIfAbsent(request.targetRunExternalizableId) { ConcurrentLinkedQueue() }
+            queue.add(request)
+            targetRunByRunID[request.runId] = request.targetRunExternalizableId
+            enqueued.signalAll()
+        }
     }
 
-    fun nextForRun(targetRunExternalizableId: String): PendingBridgeRequest? {
-        return byTargetRun[targetRunExternalizableId]?.peek()
+    // Long-poll form of nextForRun: waits up to wait for a request to be
+    // enqueued. A lease or delivery expiring meanwhile is noticed when the
+    // wait ends.
+    fun awaitNextForRun(targetRunExternalizableId: String, wait: Duration): PendingBridgeRequest? = lock.withLock {
+        var remaining = wait.toNanos()
+        var next = nextForRun(targetRunExternalizableId)
+        while (next == null && remaining > 0) {
+            remaining = enqueued.awaitNanos(remaining)
+            next = nextForRun(targetRunExternalizableId)
+        }
+        next
+    }
+
+    // Target runs with queued requests, optionally only jobs inside folder.
+    // pending counts requests a claim would hand out now; leased those under a
+    // live lease or recently delivered by nextForRun.
+    fun runs(folder: String?, now: Instant = Instant.now()): List<BridgeRunSummary> {
+        val prefix = folder?.trim('/')?.takeIf { it.isNotEmpty() }?.let { "$it/" }
+        lock.withLock {
+            return byTargetRun
+                .filterKeys { prefix == null || it.startsWith(prefix) }
+                .mapNotNull { (targetRun, queue) ->
+                    val leased = queue.count { !isAvailable(it, now) }
+                    BridgeRunSummary(targetRun, queue.size - leased, leased).takeIf { queue.isNotEmpty() }
+                }
+                .sortedBy { it.targetRunExternalizableId }
+        }
+    }
+
+    // Unleased delivery for workers that predate claim. Requests under a live
+    // lease or delivered within PENDING_DELIVERY_TTL are skipped, so each
+    // request is handed out once rather than on every poll; an expired lease is
+    // dropped so the legacy worker can complete without one.
+    fun nextForRun(targetRunExternalizableId: String, now: Instant = Instant.now()): PendingBridgeRequest? {
+        lock.withLock {
+            val next = byTargetRun[targetRunExternalizableId]
+                ?.firstOrNull { isAvailable(it, now) }
+                ?: return null
+            leaseByRunID.remove(next.runId)
+            deliveredUntilByRunID[next.runId] = now.plus(PENDING_DELIVERY_TTL)
+            return next
+        }
+    }
+
+    // Leases the first request for the run that has no live lease and was not
+    // just delivered by nextForRun. A request whose lease expired is
+    // redelivered under a new lease id.
+    fun claim(
+        targetRunExternalizableId: String,
+        workerId: String,
+        ttl: Duration,
+        now: Instant = Instant.now(),
+    ): BridgeClaim? {
+        lock.withLock {
+            val next = byTargetRun[targetRunExternalizableId]
+                ?.firstOrNull { isAvailable(it, now) }
+                ?: return null
+            deliveredUntilByRunID.remove(next.runId)
+            val lease = BridgeLease(
+                leaseId = UUID.randomUUID().toString(),
+                runId = next.runId,
+                workerId = workerId,
+                expiresAt = now.plus(ttl),
+            )
+            leaseByRunID[next.runId] = lease
+            return BridgeClaim(next, lease)
+        }
+    }
+
+    fun heartbeat(runId: String, leaseId: String, ttl: Duration, now: Instant = Instant.now()): LeaseCheck<BridgeLease> {
+        lock.withLock {
+            if (!targetRunByRunID.containsKey(runId)) {
+                return LeaseCheck.NotFound
+            }
+            val current = leaseByRunID[runId]
+            checkLease(runId, current, leaseId, now)?.let { return it }
+            val extended = current!!.copy(expiresAt = now.plus(ttl))
+            leaseByRunID[runId] = extended
+            return LeaseCheck.Ok(extended)
+        }
+    }
+
+    // Removes a request once it is complete. A leased request needs its current,
+    // live lease unless cancel is set; leaseId on an unleased request is a
+    // mismatch.
+    fun complete(
+        runId: String,
+        leaseId: String?,
+        cancel: Boolean = false,
+        now: Instant = Instant.now(),
+    ): LeaseCheck<PendingBridgeRequest> {
+        lock.withLock {
+            if (!targetRunByRunID.containsKey(runId)) {
+                return LeaseCheck.NotFound
+            }
+            val current = leaseByRunID[runId]
+            if (!leaseId.isNullOrEmpty() || (current != null && !cancel)) {
+                checkLease(runId, current, leaseId.orEmpty(), now)?.let { return it }
+            }
+            return complete(runId)?.let { LeaseCheck.Ok(it) } ?: LeaseCheck.NotFound
+        }
+    }
+
+    // Finds a pending request a worker may report on: a leased request needs
+    // its current, live lease, as for complete.
+    fun active(runId: String, leaseId: String?, now: Instant = Instant.now()): LeaseCheck<PendingBridgeRequest> {
+        lock.withLock {
+            val targetRun = targetRunByRunID[runId] ?: return LeaseCheck.NotFound
+            val current = leaseByRunID[runId]
+            if (!leaseId.isNullOrEmpty() || current != null) {
+                checkLease(runId, current, leaseId.orEmpty(), now)?.let { return it }
+            }
+            val request = byTargetRun[targetRun]?.firstOrNull { it.runId == runId } ?: return LeaseCheck.NotFound
+            return LeaseCheck.Ok(request)
+        }
     }
 
     fun complete(runId: String): PendingBridgeRequest? {
-        val targetRun = targetRunByRunID.remove(runId) ?: return null
-        val queue = byTargetRun[targetRun] ?: return null
-        val match = queue.firstOrNull { it.runId == runId } ?: return null
-        queue.remove(match)
-        if (queue.isEmpty()) {
-            byTargetRun.remove(targetRun, queue)
+        lock.withLock {
+            val targetRun = targetRunByRunID.remove(runId) ?: return null
+            leaseByRunID.remove(runId)
+            deliveredUntilByRunID.remove(runId)
+            val queue = byTargetRun[targetRun] ?: return null
+            val match = queue.firstOrNull { it.runId == runId } ?: return null
+            queue.remove(match)
+            if (queue.isEmpty()) {
+                byTargetRun.remove(targetRun, queue)
+            }
+            return match
         }
-        return match
     }
+
+    private fun isAvailable(request: PendingBridgeRequest, now: Instant): Boolean =
+        leaseByRunID[request.runId]?.isLive(now) != true &&
+            deliveredUntilByRunID[request.runId]?.isAfter(now) != true
+
+    private fun checkLease(runId: String, current: BridgeLease?, leaseId: String, now: Instant): LeaseCheck.Rejected? = when {
+        current == null || current.leaseId != leaseId -> LeaseCheck.Rejected(
+            code = "lease_mismatch",
+            message = "lease '$leaseId' is not the current lease for run '$runId'",
+        )
+        !current.isLive(now) -> LeaseCheck.Rejected(
+            code = "lease_expired",
+            message = "lease '$leaseId' for run '$runId' expired at ${current.expiresAt}",
+        )
+        else -> null
+    }
+}
+
+// Clamps a requested lease length; zero or negative means the default.
+fun leaseTtl(seconds: Long): Duration = when {
+    seconds <= 0 -> DEFAULT_LEASE_TTL
+    else -> minOf(Duration.ofSeconds(seconds), MAX_LEASE_TTL)
 }
@@ -1,8 +1,22 @@
 package io.albertocavalcante.jenkins.steprpc
 
 import java.time.Instant
+import java.util.concurrent.CompletableFuture
+import java.util.concurrent.CompletionException
 import java.util.concurrent.ConcurrentHashMap
 
+val TERMINAL_STATES = setOf("succeeded", "failed", "cancelled")
+
+const val MODE_DIRECT = "OPERATION_EXECUTION_MODE_DIRECT"
+const val MODE_CPS_BRIDGE = "OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED"
+
+// Last progress a bridge worker reported through bridge/progress.
+data class RunProgress(
+    val percent: Int,
+    val message: String,
+    val updatedAt: Instant,
+)
+
 data class RunRecord(
     val requestId: String,
     val runId: String,
@@ -11,17 +25,62 @@ data class RunRecord(
     val createdAt: Instant,
     val errorCode: String? = null,
     val errorMessage: String? = null,
+    // Step result data served by runs/{runId}/result.
+    val result: Map<String, Any?> = emptyMap(),
+    // Console output served by runs/{runId}/log.
+    val log: String = "",
+    val executionMode: String = MODE_DIRECT,
+    val startedAt: Instant? = null,
+    val updatedAt: Instant = createdAt,
+    val completedAt: Instant? = null,
+    val attempts: Int = 0,
+    val workerId: String? = null,
+    val progress: RunProgress? = null,
+    val callback: RunCallback? = null,
 )
 
-class InMemoryRunStore {
+// onTerminal is called once for each run, with the record that first reached
+// a terminal state.
+class InMemoryRunStore(private val onTerminal: (RunRecord) -> Unit = {}) {
     private val byRunID = ConcurrentHashMap<String, RunRecord>()
 
+    // Completes with the runId once the run is created; an incomplete future is
+    // a requestId reserved by an invoke that is still executing.
+    private val runIDByRequestID = ConcurrentHashMap<String, CompletableFuture<String>>()
+
     fun put(record: RunRecord) {
         byRunID[record.runId] = record
+        runIDByRequestID.computeIfAbsent(record.requestId) { CompletableFuture() }.complete(record.runId)
     }
 
     fun get(runId: String): RunRecord? = byRunID[runId]
 
+    fun findByRequestId(requestId: String): RunRecord? = runIDByRequestID[requestId]?.getNow(null)?.let { byRunID[it] }
+
+    // Lets invoke treat requestId as an idempotency key. Returns null when the
+    // caller now holds requestId and must follow up with create or release;
+    // otherwise returns the run of the first attempt, waiting for it if that
+    // attempt is still executing, so concurrent retries never start a second run.
+    fun reserve(requestId: String): RunRecord? {
+        while (true) {
+            val prior = runIDByRequestID.putIfAbsent(requestId, CompletableFuture()) ?: return null
+            val runId = try {
+                prior.join()
+            } catch (_: CompletionException) {
+                // The first attempt failed before creating a run; compete for
+                // the reservation again.
+                continue
+            }
+            return byRunID.getValue(runId)
+        }
+    }
+
+    // Drops a reservation whose invoke failed before a run was created.
+    fun release(requestId: String) {
+        runIDByRequestID.remove(requestId)
+            ?.completeExceptionally(IllegalStateException("invoke for requestId '$requestId' did not create a run"))
+    }
+
     fun create(
         requestId: String,
         runId: String,
@@ -29,17 +88,35 @@ class InMemoryRunStore {
         state: String,
         errorCode: String? = null,
         errorMessage: String? = null,
+        result: Map<String, Any?> = emptyMap(),
+        log: String = "",
+        executionMode: String = MODE_DIRECT,
+        createdAt: Instant = Instant.now(),
+        startedAt: Instant? = null,
+        callback: RunCallback? = null,
     ): RunRecord {
+        val now = Instant.now()
         val record = RunRecord(
             requestId = requestId,
             runId = runId,
             operation = operation,
             state = state,
-            createdAt = Instant.now(),
+            createdAt = createdAt,
             errorCode = errorCode,
             errorMessage = errorMessage,
+            result = result,
+            log = log,
+            executionMode = executionMode,
+            startedAt = startedAt,
+            updatedAt = now,
+            completedAt = if (state in TERMINAL_STATES) now else null,
+            attempts = if (startedAt != null) 1 else 0,
+            callback = callback,
         )
         put(record)
+        if (record.completedAt != null) {
+            onTerminal(record)
+        }
         return record
     }
 
@@ -48,14 +125,47 @@ class InMemoryRunStore {
         state: String,
         errorCode: String? = null,
         errorMessage: String? = null,
+        result: Map<String, Any?>? = null,
     ): RunRecord? {
-        val current = byRunID[runId] ?: return null
-        val updated = current.copy(
-            state = state,
-            errorCode = errorCode,
-            errorMessage = errorMessage,
-        )
-        put(updated)
+        var completed = false
+        val updated = byRunID.computeIfPresent(runId) { _, current ->
+            val now = Instant.now()
+            completed = current.completedAt == null && state in TERMINAL_STATES
+            current.copy(
+                state = state,
+                errorCode = errorCode,
+                errorMessage = errorMessage,
+                result = result ?: current.result,
+                updatedAt = now,
+                completedAt = current.completedAt ?: now.takeIf { state in TERMINAL_STATES },
+            )
+        }
+        if (completed && updated != null) {
+            onTerminal(updated)
+        }
         return updated
     }
+
+    // Records a bridge delivery: each fetch of a pending request counts as an
+    // attempt by workerId, and the first one moves the run from queued to
+    // running.
+    fun claim(runId: String, workerId: String): RunRecord? {
+        return byRunID.computeIfPresent(runId) { _, current ->
+            val now = Instant.now()
+            current.copy(
+                state = if (current.state == "queued") "running" else current.state,
+                startedAt = current.startedAt ?: now,
+                updatedAt = now,
+                attempts = current.attempts + 1,
+                workerId = workerId,
+            )
+        }
+    }
+
+    fun progress(runId: String, percent: Int, message: String): RunRecord? {
+        return byRunID.computeIfPresent(runId) { _, current ->
+            val now = Instant.now()
+            current.copy(progress = RunProgress(percent, message, now), updatedAt = now)
+        }
+    }
 }
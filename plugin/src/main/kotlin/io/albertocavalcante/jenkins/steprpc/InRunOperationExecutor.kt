@@ -11,8 +11,13 @@ import hudson.model.Run
 import hudson.model.TaskListener
 import hudson.tasks.Builder
 import hudson.tasks.Publisher
+import hudson.tasks.test.AbstractTestResultAction
+import hudson.util.StreamTaskListener
+import java.io.ByteArrayOutputStream
 import java.io.File
+import java.io.OutputStream
 import java.lang.reflect.Method
+import java.nio.charset.StandardCharsets
 import java.time.Instant
 import java.util.UUID
 import jenkins.model.Jenkins
@@ -20,12 +25,21 @@ import jenkins.tasks.SimpleBuildStep
 import net.sf.json.JSONObject
 import org.jenkinsci.Symbol
 
+// Most console output kept for one direct run. Runs live in memory, so output
+// past this is dropped and the log ends with a truncation notice.
+const val MAX_CAPTURED_LOG_BYTES = 1024 * 1024
+
 data class InRunExecutionResult(
     val runId: String,
     val state: String,
     val createdAt: Instant,
     val errorCode: String? = null,
     val errorMessage: String? = null,
+    val result: Map<String, Any?> = emptyMap(),
+    val log: String = "",
+    val executionMode: String = MODE_DIRECT,
+    // When the step began executing; null while a bridge request is queued.
+    val startedAt: Instant? = null,
 )
 
 class InRunOperationExecutor(private val cpsBridgeQueue: CpsBridgeQueue) {
@@ -33,7 +47,8 @@ class InRunOperationExecutor(private val cpsBridgeQueue: CpsBridgeQueue) {
         val runContext = parseRunContext(args)
         val run = resolveRun(runContext)
         val rpcRunId = "rpc-${UUID.randomUUID().toString().substring(0, 12)}"
-        val listener = TaskListener.NULL
+        val logBuffer = BoundedLogBuffer()
+        val listener = StreamTaskListener(logBuffer, StandardCharsets.UTF_8)
         val node = resolveNode(runContext)
         val workspace = resolveWorkspace(runContext, node)
         val launcher = node.createLauncher(listener)
@@ -57,6 +72,7 @@ class InRunOperationExecutor(private val cpsBridgeQueue: CpsBridgeQueue) {
                     runId = rpcRunId,
                     state = "queued",
                     createdAt = Instant.now(),
+                    executionMode = MODE_CPS_BRIDGE,
                 )
             }
             return InRunExecutionResult(
@@ -68,6 +84,8 @@ class InRunOperationExecutor(private val cpsBridgeQueue: CpsBridgeQueue) {
             )
         }
 
+        val artifactsBefore = run.artifacts.map { it.relativePath }.toSet()
+        val startedAt = Instant.now()
         return try {
             val step = instantiateSimpleBuildStep(descriptor, stepArgs)
             executeStep(step, run, workspace, env, launcher, listener)
@@ -75,16 +93,46 @@ class InRunOperationExecutor(private val cpsBridgeQueue: CpsBridgeQueue) {
                 runId = rpcRunId,
                 state = "succeeded",
                 createdAt = Instant.now(),
+                result = stepResult(run, artifactsBefore),
+                log = capturedLog(listener, logBuffer),
+                startedAt = startedAt,
             )
         } catch (e: Exception) {
+            listener.error(e.message ?: "operation execution failed")
             InRunExecutionResult(
                 runId = rpcRunId,
                 state = "failed",
                 createdAt = Instant.now(),
                 errorCode = "operation_failed",
                 errorMessage = e.message ?: "operation execution failed",
+                result = stepResult(run, artifactsBefore),
+                log = capturedLog(listener, logBuffer),
+                startedAt = startedAt,
+            )
+        }
+    }
+
+    // Describes what a step left on the run: its test counts when it has a test
+    // result action, and the artifacts archived while the step ran.
+    private fun stepResult(run: Run<*, *>, artifactsBefore: Set<String>): Map<String, Any?> {
+        val result = linkedMapOf<String, Any?>()
+        run.getAction(AbstractTestResultAction::class.java)?.let { tests ->
+            result["tests"] = mapOf(
+                "total" to tests.totalCount,
+                "failed" to tests.failCount,
+                "skipped" to tests.skipCount,
             )
         }
+        val archived = run.artifacts.map { it.relativePath }.filterNot { it in artifactsBefore }
+        if (archived.isNotEmpty()) {
+            result["artifacts"] = archived
+        }
+        return result
+    }
+
+    private fun capturedLog(listener: StreamTaskListener, buffer: BoundedLogBuffer): String {
+        listener.logger.flush()
+        return buffer.text()
     }
 
     fun discoverOperations(): List<OperationDefinition> {
@@ -316,3 +364,28 @@ private data class RunContext(
 )
 
 private const val RUN_CONTEXT_KEY = "runContext"
+
+// Keeps the first limit bytes written to it and counts the rest.
+class BoundedLogBuffer(private val limit: Int = MAX_CAPTURED_LOG_BYTES) : OutputStream() {
+    private val kept = ByteArrayOutputStream()
+    private var dropped = 0L
+
+    @Synchronized
+    override fun write(b: Int) {
+        if (kept.size() < limit) kept.write(b) else dropped++
+    }
+
+    @Synchronized
+    override fun write(b: ByteArray, off: Int, len: Int) {
+        val n = minOf(len, limit - kept.size())
+        kept.write(b, off, n)
+        dropped += len - n
+    }
+
+    // The kept output, ending with a notice when some was dropped.
+    @Synchronized
+    fun text(): String {
+        val text = kept.toString(StandardCharsets.UTF_8)
+        return if (dropped == 0L) text else "$text\n[step-rpc: log truncated, $dropped more bytes not kept]\n"
+    }
+}
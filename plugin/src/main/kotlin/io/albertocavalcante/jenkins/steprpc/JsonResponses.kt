@@ -3,6 +3,7 @@ package io.albertocavalcante.jenkins.steprpc
 import com.google.protobuf.Message
 import io.albertocavalcante.jenkins.steprpc.v1.Error
 import io.albertocavalcante.jenkins.steprpc.v1.ErrorResponse
+import io.albertocavalcante.jenkins.steprpc.v1.RunState
 import java.nio.charset.StandardCharsets
 import net.sf.json.JSONObject
 import org.kohsuke.stapler.HttpResponse
@@ -19,6 +20,22 @@ fun jsonResponse(payload: Message, statusCode: Int = 200): HttpResponse {
     return responseWithBody(statusCode, body)
 }
 
+// RunState for a legacy state string, e.g. RUN_STATE_QUEUED for "queued".
+fun runState(state: String): RunState = when (state) {
+    "queued" -> RunState.RUN_STATE_QUEUED
+    "running" -> RunState.RUN_STATE_RUNNING
+    "succeeded" -> RunState.RUN_STATE_SUCCEEDED
+    "failed" -> RunState.RUN_STATE_FAILED
+    "cancelled" -> RunState.RUN_STATE_CANCELLED
+    else -> RunState.RUN_STATE_UNSPECIFIED
+}
+
+// Legacy state string for runState, e.g. "queued"; empty when unspecified.
+fun stateName(runState: RunState): String = when (runState) {
+    RunState.RUN_STATE_UNSPECIFIED, RunState.UNRECOGNIZED -> ""
+    else -> runState.name.removePrefix("RUN_STATE_").lowercase()
+}
+
 fun errorResponse(statusCode: Int, code: String, message: String, details: Map<String, String> = emptyMap()): HttpResponse {
     val error = Error.newBuilder()
         .setCode(code)
@@ -1,12 +1,23 @@
 package io.albertocavalcante.jenkins.steprpc
 
 import com.google.protobuf.Struct
+import com.google.protobuf.Timestamp
 import com.google.protobuf.Value
+import java.time.Instant
+import net.sf.json.JSONObject
 
 fun structToAnyMap(struct: Struct): Map<String, Any?> {
     return struct.fieldsMap.mapValues { (_, value) -> valueToAny(value) }
 }
 
+fun anyMapToStruct(map: Map<String, Any?>): Struct {
+    return Struct.newBuilder().also { mergeJsonIntoBuilder(JSONObject.fromObject(map).toString(), it) }.build()
+}
+
+fun protoTimestamp(instant: Instant): Timestamp {
+    return Timestamp.newBuilder().setSeconds(instant.epochSecond).setNanos(instant.nano).build()
+}
+
 private fun valueToAny(value: Value): Any? {
     return when (value.kindCase) {
         Value.KindCase.NULL_VALUE -> null
@@ -7,11 +7,13 @@ import org.kohsuke.stapler.HttpResponse
 
 @Extension
 class StepRpcRootAction : UnprotectedRootAction {
-    private val runStore = InMemoryRunStore()
+    private val callbackPolicy = CallbackPolicy()
+    private val callbackNotifier = CallbackNotifier(callbackPolicy)
+    private val runStore = InMemoryRunStore(onTerminal = callbackNotifier::notify)
     private val cpsBridgeQueue = CpsBridgeQueue()
     private val operationRegistry = OperationRegistry()
     private val executor = InRunOperationExecutor(cpsBridgeQueue)
-    private val v1Api = StepRpcV1Api(runStore, operationRegistry, executor, cpsBridgeQueue)
+    private val v1Api = StepRpcV1Api(runStore, operationRegistry, executor, cpsBridgeQueue, callbackPolicy)
 
     override fun getIconFileName(): String? = null
 
@@ -1,6 +1,9 @@
 package io.albertocavalcante.jenkins.steprpc
 
 import com.google.protobuf.InvalidProtocolBufferException
+import io.albertocavalcante.jenkins.steprpc.v1.BatchInvokeRequest
+import io.albertocavalcante.jenkins.steprpc.v1.BatchInvokeResponse
+import io.albertocavalcante.jenkins.steprpc.v1.BatchInvokeResult
 import io.albertocavalcante.jenkins.steprpc.v1.CatalogOperation
 import io.albertocavalcante.jenkins.steprpc.v1.CatalogResponse
 import io.albertocavalcante.jenkins.steprpc.v1.Error
@@ -8,16 +11,30 @@ import io.albertocavalcante.jenkins.steprpc.v1.HealthResponse
 import io.albertocavalcante.jenkins.steprpc.v1.InvokeRequest
 import io.albertocavalcante.jenkins.steprpc.v1.InvokeResponse
 import io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode
+import java.time.Instant
 import jenkins.model.Jenkins
 import org.kohsuke.stapler.HttpResponse
 import org.kohsuke.stapler.StaplerRequest2
 import org.kohsuke.stapler.interceptor.RequirePOST
 
+// Largest batch doBatchInvoke accepts; clients split longer ones.
+private const val MAX_BATCH_SIZE = 100
+
+// Advertised in the health document.
+private val SERVER_CAPABILITIES = listOf("invoke", "runs", "catalog", "bridge", "webhooks", "batch_invoke", "run_output", "bridge_leases", "bridge_results", "bridge_discovery", "run_state")
+
+private sealed interface InvokeOutcome {
+    data class Accepted(val record: RunRecord) : InvokeOutcome
+
+    data class Rejected(val statusCode: Int, val code: String, val message: String) : InvokeOutcome
+}
+
 class StepRpcV1Api(
     private val runStore: InMemoryRunStore,
     private val operationRegistry: OperationRegistry,
     private val executor: InRunOperationExecutor,
     private val cpsBridgeQueue: CpsBridgeQueue,
+    private val callbackPolicy: CallbackPolicy = CallbackPolicy(),
 ) {
     fun doIndex(): HttpResponse {
         Jenkins.get().checkPermission(Jenkins.READ)
@@ -26,6 +43,7 @@ class StepRpcV1Api(
                 .setApiVersion("v1")
                 .setService("jenkins-step-rpc-plugin")
                 .setStatus("ok")
+                .addAllCapabilities(SERVER_CAPABILITIES)
                 .build(),
         )
     }
@@ -76,11 +94,64 @@ class StepRpcV1Api(
             )
         }
 
+        return when (val outcome = invokeOne(payload.build())) {
+            is InvokeOutcome.Accepted -> jsonResponse(invokeResponse(outcome.record))
+            is InvokeOutcome.Rejected -> errorResponse(outcome.statusCode, outcome.code, outcome.message)
+        }
+    }
+
+    @RequirePOST
+    fun doBatchInvoke(req: StaplerRequest2): HttpResponse {
+        Jenkins.get().checkPermission(StepRpcPermissions.INVOKE)
+
+        val body = req.reader.readText()
+        if (body.isBlank()) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "request body is required",
+            )
+        }
+
+        val requests = try {
+            BatchInvokeRequest.newBuilder().also { mergeJsonIntoBuilder(body, it) }.requestsList
+        } catch (_: InvalidProtocolBufferException) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_json",
+                message = "request body must be valid JSON",
+            )
+        }
+
+        if (requests.size > MAX_BATCH_SIZE) {
+            return errorResponse(
+                statusCode = 400,
+                code = "batch_too_large",
+                message = "batch of ${requests.size} exceeds $MAX_BATCH_SIZE requests",
+                details = mapOf("maxBatchSize" to MAX_BATCH_SIZE.toString()),
+            )
+        }
+
+        AuditLogger.log("invoke.batch", mapOf("size" to requests.size.toString()))
+        val results = requests.map { request ->
+            val result = BatchInvokeResult.newBuilder()
+            when (val outcome = invokeOne(request)) {
+                is InvokeOutcome.Accepted -> result.setResponse(invokeResponse(outcome.record))
+                is InvokeOutcome.Rejected -> result
+                    .setError(Error.newBuilder().setCode(outcome.code).setMessage(outcome.message).build())
+                    .setStatus(outcome.statusCode)
+            }
+            result.build()
+        }
+        return jsonResponse(BatchInvokeResponse.newBuilder().addAllResults(results).build())
+    }
+
+    private fun invokeOne(payload: InvokeRequest): InvokeOutcome {
         val requestId = payload.requestId
         val operation = payload.operation
 
         if (requestId.isBlank() || operation.isBlank()) {
-            return errorResponse(
+            return InvokeOutcome.Rejected(
                 statusCode = 400,
                 code = "bad_request",
                 message = "requestId and operation are required",
@@ -88,13 +159,38 @@ class StepRpcV1Api(
         }
 
         if (!operationRegistry.isAllowed(operation)) {
-            return errorResponse(
+            return InvokeOutcome.Rejected(
                 statusCode = 400,
                 code = "operation_not_allowed",
                 message = "operation '$operation' is not in allowlist",
             )
         }
 
+        val callback = payload.callbackUrl.takeIf { it.isNotBlank() }?.let { RunCallback(it, payload.callbackSecret) }
+        if (callback != null) {
+            val problem = callbackPolicy.problem(callback.url)
+                ?: "callbackSecret is required with callbackUrl".takeIf { callback.secret.isBlank() }
+            if (problem != null) {
+                return InvokeOutcome.Rejected(statusCode = 400, code = "bad_request", message = problem)
+            }
+        }
+
+        val existing = runStore.reserve(requestId)
+        if (existing != null) {
+            if (existing.operation != operation) {
+                return InvokeOutcome.Rejected(
+                    statusCode = 400,
+                    code = "bad_request",
+                    message = "requestId '$requestId' was already used for operation '${existing.operation}'",
+                )
+            }
+            AuditLogger.log(
+                "invoke.replayed",
+                mapOf("requestId" to requestId, "operation" to operation, "runId" to existing.runId, "state" to existing.state),
+            )
+            return InvokeOutcome.Accepted(existing)
+        }
+
         val args = structToAnyMap(payload.args)
         val redactedArgs = AuditLogger.redactSensitiveArgs(args)
         AuditLogger.log(
@@ -102,11 +198,17 @@ class StepRpcV1Api(
             mapOf("requestId" to requestId, "operation" to operation, "args" to redactedArgs.toString()),
         )
 
-        val execution = executor.execute(
-            requestId = requestId,
-            operation = operation,
-            args = args,
-        )
+        val receivedAt = Instant.now()
+        val execution = try {
+            executor.execute(
+                requestId = requestId,
+                operation = operation,
+                args = args,
+            )
+        } catch (e: Exception) {
+            runStore.release(requestId)
+            throw e
+        }
 
         val record = runStore.create(
             requestId = requestId,
@@ -115,6 +217,12 @@ class StepRpcV1Api(
             state = execution.state,
             errorCode = execution.errorCode,
             errorMessage = execution.errorMessage,
+            result = execution.result,
+            log = execution.log,
+            executionMode = execution.executionMode,
+            createdAt = receivedAt,
+            startedAt = execution.startedAt,
+            callback = callback,
         )
 
         AuditLogger.log(
@@ -122,10 +230,15 @@ class StepRpcV1Api(
             mapOf("requestId" to requestId, "operation" to operation, "runId" to record.runId, "state" to record.state),
         )
 
+        return InvokeOutcome.Accepted(record)
+    }
+
+    private fun invokeResponse(record: RunRecord): InvokeResponse {
         val responseBuilder = InvokeResponse.newBuilder()
             .setRequestId(record.requestId)
             .setRunId(record.runId)
             .setState(record.state)
+            .setRunState(runState(record.state))
         if (record.errorCode != null) {
             responseBuilder.setError(
                 Error.newBuilder()
@@ -134,10 +247,7 @@ class StepRpcV1Api(
                     .build(),
             )
         }
-
-        return jsonResponse(
-            responseBuilder.build(),
-        )
+        return responseBuilder.build()
     }
 
     fun getRuns(): StepRpcV1RunsApi = StepRpcV1RunsApi(runStore)
@@ -1,12 +1,22 @@
 package io.albertocavalcante.jenkins.steprpc
 
 import com.google.protobuf.InvalidProtocolBufferException
-import com.google.protobuf.Struct
+import com.google.protobuf.Message
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeClaimRequest
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeClaimResponse
 import io.albertocavalcante.jenkins.steprpc.v1.BridgeCompleteRequest
 import io.albertocavalcante.jenkins.steprpc.v1.BridgeCompleteResponse
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeHeartbeatRequest
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeHeartbeatResponse
 import io.albertocavalcante.jenkins.steprpc.v1.BridgePendingResponse
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeProgressRequest
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeProgressResponse
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeRunSummary as BridgeRunSummaryMessage
+import io.albertocavalcante.jenkins.steprpc.v1.BridgeRunsResponse
+import java.time.Duration
 import jenkins.model.Jenkins
 import org.kohsuke.stapler.HttpResponse
+import org.kohsuke.stapler.HttpResponses
 import org.kohsuke.stapler.StaplerRequest2
 import org.kohsuke.stapler.interceptor.RequirePOST
 
@@ -25,28 +35,175 @@ class StepRpcV1BridgeApi(
             )
         }
 
-        val pending = cpsBridgeQueue.nextForRun(targetRun)
+        val waitSeconds = req.getParameter("waitSeconds")?.let { it.toLongOrNull() ?: -1L } ?: 0L
+        if (waitSeconds < 0) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "waitSeconds must be a non-negative integer",
+            )
+        }
+
+        // A long poll that ends with nothing pending answers 204 rather than
+        // no_pending_request, so waiting workers reconnect without an error.
+        // Requests already handed out are not pending, so a long poll waits
+        // for a new one and each delivery is claimed in the run store once.
+        val pending = if (waitSeconds > 0) {
+            cpsBridgeQueue.awaitNextForRun(targetRun, minOf(Duration.ofSeconds(waitSeconds), MAX_PENDING_WAIT))
+                ?: return HttpResponses.status(204)
+        } else {
+            cpsBridgeQueue.nextForRun(targetRun)
+                ?: return errorResponse(
+                    statusCode = 404,
+                    code = "no_pending_request",
+                    message = "no pending bridge request for run '$targetRun'",
+                )
+        }
+
+        val workerId = req.getParameter("workerId")?.takeIf { it.isNotBlank() }
+            ?: Jenkins.getAuthentication2().name
+        runStore.claim(pending.runId, workerId)
+
+        AuditLogger.log(
+            "bridge.pending",
+            mapOf("targetRun" to targetRun, "runId" to pending.runId, "operation" to pending.operation, "workerId" to workerId),
+        )
+
+        return jsonResponse(pendingResponse(pending))
+    }
+
+    fun doRuns(req: StaplerRequest2): HttpResponse {
+        Jenkins.get().checkPermission(StepRpcPermissions.INVOKE)
+        val runs = cpsBridgeQueue.runs(req.getParameter("folder")).map {
+            BridgeRunSummaryMessage.newBuilder()
+                .setRunExternalizableId(it.targetRunExternalizableId)
+                .setPending(it.pending)
+                .setLeased(it.leased)
+                .build()
+        }
+        return jsonResponse(BridgeRunsResponse.newBuilder().addAllRuns(runs).build())
+    }
+
+    @RequirePOST
+    fun doClaim(req: StaplerRequest2): HttpResponse {
+        Jenkins.get().checkPermission(StepRpcPermissions.INVOKE)
+        val body = parseBody(req, BridgeClaimRequest.newBuilder()) ?: return badJson()
+        val targetRun = body.runExternalizableId
+        if (targetRun.isBlank()) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "runExternalizableId is required",
+            )
+        }
+        val workerId = body.workerId.ifBlank { Jenkins.getAuthentication2().name }
+        val ttl = leaseTtl(body.leaseSeconds.toLong())
+
+        val claimed = cpsBridgeQueue.claim(targetRun, workerId, ttl)
             ?: return errorResponse(
                 statusCode = 404,
                 code = "no_pending_request",
                 message = "no pending bridge request for run '$targetRun'",
             )
+        val record = runStore.claim(claimed.request.runId, workerId)
 
         AuditLogger.log(
-            "bridge.pending",
-            mapOf("targetRun" to targetRun, "runId" to pending.runId, "operation" to pending.operation),
+            "bridge.claim",
+            mapOf(
+                "targetRun" to targetRun,
+                "runId" to claimed.request.runId,
+                "operation" to claimed.request.operation,
+                "workerId" to workerId,
+                "leaseId" to claimed.lease.leaseId,
+                "attempt" to record?.attempts?.toString(),
+            ),
+        )
+
+        return jsonResponse(
+            BridgeClaimResponse.newBuilder()
+                .setRequest(pendingResponse(claimed.request))
+                .setLeaseId(claimed.lease.leaseId)
+                .setLeaseExpiresAt(protoTimestamp(claimed.lease.expiresAt))
+                .setAttempt(record?.attempts ?: 1)
+                .build(),
         )
+    }
+
+    @RequirePOST
+    fun doHeartbeat(req: StaplerRequest2): HttpResponse {
+        Jenkins.get().checkPermission(StepRpcPermissions.INVOKE)
+        val body = parseBody(req, BridgeHeartbeatRequest.newBuilder()) ?: return badJson()
+        val runId = body.runId
+        val leaseId = body.leaseId
+        if (runId.isBlank() || leaseId.isBlank()) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "runId and leaseId are required",
+            )
+        }
+        val ttl = leaseTtl(body.leaseSeconds.toLong())
+
+        return when (val check = cpsBridgeQueue.heartbeat(runId, leaseId, ttl)) {
+            is LeaseCheck.Ok -> jsonResponse(
+                BridgeHeartbeatResponse.newBuilder()
+                    .setRunId(runId)
+                    .setLeaseId(leaseId)
+                    .setLeaseExpiresAt(protoTimestamp(check.value.expiresAt))
+                    .build(),
+            )
+            is LeaseCheck.Rejected -> errorResponse(statusCode = 409, code = check.code, message = check.message)
+            LeaseCheck.NotFound -> errorResponse(
+                statusCode = 404,
+                code = "run_not_found",
+                message = "no pending bridge request found for run '$runId'",
+            )
+        }
+    }
+
+    @RequirePOST
+    fun doProgress(req: StaplerRequest2): HttpResponse {
+        Jenkins.get().checkPermission(StepRpcPermissions.INVOKE)
+        val body = parseBody(req, BridgeProgressRequest.newBuilder()) ?: return badJson()
+        val runId = body.runId
+        if (runId.isBlank()) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "runId is required",
+            )
+        }
+        val percent = body.percent
+        if (percent < 0 || percent > 100) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "percent must be a whole number from 0 to 100",
+            )
+        }
+        val leaseId = body.leaseId
+        val message = body.message
 
-        val argsBuilder = Struct.newBuilder()
-        mergeJsonIntoBuilder(net.sf.json.JSONObject.fromObject(pending.args).toString(), argsBuilder)
+        when (val check = cpsBridgeQueue.active(runId, leaseId)) {
+            is LeaseCheck.Ok -> Unit
+            is LeaseCheck.Rejected -> return errorResponse(statusCode = 409, code = check.code, message = check.message)
+            LeaseCheck.NotFound -> return errorResponse(
+                statusCode = 404,
+                code = "run_not_found",
+                message = "no pending bridge request found for run '$runId'",
+            )
+        }
+        val progress = runStore.progress(runId, percent, message)?.progress
+            ?: return errorResponse(
+                statusCode = 404,
+                code = "run_not_found",
+                message = "no run found for id '$runId'",
+            )
 
         return jsonResponse(
-            BridgePendingResponse.newBuilder()
-                .setRequestId(pending.requestId)
-                .setRunId(pending.runId)
-                .setOperation(pending.operation)
-                .setArgs(argsBuilder.build())
-                .setTargetRunExternalizableId(pending.targetRunExternalizableId)
+            BridgeProgressResponse.newBuilder()
+                .setRunId(runId)
+                .setProgress(runProgress(progress))
                 .build(),
         )
     }
@@ -75,7 +232,7 @@ class StepRpcV1BridgeApi(
         }
 
         val runId = payload.runId
-        val state = payload.state
+        val state = payload.state.ifBlank { stateName(payload.runState) }
         if (runId.isBlank() || state.isBlank()) {
             return errorResponse(
                 statusCode = 400,
@@ -92,12 +249,15 @@ class StepRpcV1BridgeApi(
             )
         }
 
-        val completed = cpsBridgeQueue.complete(runId)
-            ?: return errorResponse(
+        val completed = when (val check = cpsBridgeQueue.complete(runId, payload.leaseId, cancel = state == "cancelled")) {
+            is LeaseCheck.Ok -> check.value
+            is LeaseCheck.Rejected -> return errorResponse(statusCode = 409, code = check.code, message = check.message)
+            LeaseCheck.NotFound -> return errorResponse(
                 statusCode = 404,
                 code = "run_not_found",
                 message = "no pending bridge request found for run '$runId'",
             )
+        }
 
         val errorCode = if (payload.hasError() && payload.error.code.isNotBlank()) payload.error.code else null
         val errorMessage = if (payload.hasError() && payload.error.message.isNotBlank()) payload.error.message else null
@@ -106,6 +266,7 @@ class StepRpcV1BridgeApi(
             state = state,
             errorCode = errorCode,
             errorMessage = errorMessage,
+            result = if (payload.hasResult()) structToAnyMap(payload.result) else null,
         )
 
         AuditLogger.log(
@@ -118,7 +279,35 @@ class StepRpcV1BridgeApi(
                 .setRequestId(completed.requestId)
                 .setRunId(completed.runId)
                 .setState(state)
+                .setRunState(runState(state))
                 .build(),
         )
     }
+
+    private fun pendingResponse(pending: PendingBridgeRequest): BridgePendingResponse {
+        return BridgePendingResponse.newBuilder()
+            .setRequestId(pending.requestId)
+            .setRunId(pending.runId)
+            .setOperation(pending.operation)
+            .setArgs(anyMapToStruct(pending.args))
+            .setTargetRunExternalizableId(pending.targetRunExternalizableId)
+            .build()
+    }
+
+    // Merges the body of req, {} when blank, into builder; null when the body
+    // is not valid JSON for the message.
+    private fun <B : Message.Builder> parseBody(req: StaplerRequest2, builder: B): B? {
+        val body = req.reader.readText().ifBlank { "{}" }
+        return try {
+            builder.also { mergeJsonIntoBuilder(body, it) }
+        } catch (_: InvalidProtocolBufferException) {
+            null
+        }
+    }
+
+    private fun badJson(): HttpResponse = errorResponse(
+        statusCode = 400,
+        code = "bad_json",
+        message = "request body must be valid JSON",
+    )
 }
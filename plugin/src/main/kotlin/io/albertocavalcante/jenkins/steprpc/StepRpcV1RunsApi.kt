@@ -1,10 +1,18 @@
 package io.albertocavalcante.jenkins.steprpc
 
-import com.google.protobuf.Timestamp
 import io.albertocavalcante.jenkins.steprpc.v1.Error
+import io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode
+import io.albertocavalcante.jenkins.steprpc.v1.RunLogResponse
+import io.albertocavalcante.jenkins.steprpc.v1.RunProgress as RunProgressMessage
+import io.albertocavalcante.jenkins.steprpc.v1.RunResultResponse
 import io.albertocavalcante.jenkins.steprpc.v1.RunStatusResponse
+import java.nio.charset.StandardCharsets
 import jenkins.model.Jenkins
 import org.kohsuke.stapler.HttpResponse
+import org.kohsuke.stapler.StaplerRequest2
+
+// Most log bytes one runs/{runId}/log response carries.
+private const val MAX_LOG_CHUNK_BYTES = 64 * 1024
 
 class StepRpcV1RunsApi(private val runStore: InMemoryRunStore) {
     fun doIndex(): HttpResponse {
@@ -16,7 +24,7 @@ class StepRpcV1RunsApi(private val runStore: InMemoryRunStore) {
         )
     }
 
-    fun getDynamic(runId: String): HttpResponse {
+    fun getDynamic(runId: String): Any {
         Jenkins.get().checkPermission(Jenkins.READ)
         val record = runStore.get(runId)
             ?: return errorResponse(
@@ -24,28 +32,95 @@ class StepRpcV1RunsApi(private val runStore: InMemoryRunStore) {
                 code = "run_not_found",
                 message = "no run found for id '$runId'",
             )
+        return StepRpcV1RunApi(record)
+    }
+}
 
-        val response = RunStatusResponse.newBuilder()
-            .setRequestId(record.requestId)
+// Serves runs/{runId} (status), runs/{runId}/result and runs/{runId}/log.
+class StepRpcV1RunApi(private val record: RunRecord) {
+    fun doIndex(): HttpResponse = jsonResponse(runStatusResponse(record))
+
+    fun doResult(): HttpResponse {
+        val response = RunResultResponse.newBuilder()
             .setRunId(record.runId)
-            .setOperation(record.operation)
             .setState(record.state)
-            .setCreatedAt(
-                Timestamp.newBuilder()
-                    .setSeconds(record.createdAt.epochSecond)
-                    .setNanos(record.createdAt.nano)
-                    .build(),
-            )
-
+            .setRunState(runState(record.state))
+            .setResult(anyMapToStruct(record.result))
         if (record.errorCode != null) {
-            response.setError(
-                Error.newBuilder()
-                    .setCode(record.errorCode)
-                    .setMessage(record.errorMessage ?: "operation execution failed")
-                    .build(),
+            response.setError(runError(record))
+        }
+        return jsonResponse(response.build())
+    }
+
+    fun doLog(req: StaplerRequest2): HttpResponse {
+        val offset = req.getParameter("offset")?.let { it.toLongOrNull() ?: -1L } ?: 0L
+        if (offset < 0) {
+            return errorResponse(
+                statusCode = 400,
+                code = "bad_request",
+                message = "offset must be a non-negative integer",
             )
         }
 
-        return jsonResponse(response.build())
+        val bytes = record.log.toByteArray(StandardCharsets.UTF_8)
+        val start = minOf(offset, bytes.size.toLong()).toInt()
+        val end = utf8Boundary(bytes, minOf(bytes.size, start + MAX_LOG_CHUNK_BYTES))
+        val text = String(bytes, start, end - start, StandardCharsets.UTF_8)
+        return jsonResponse(
+            RunLogResponse.newBuilder()
+                .setRunId(record.runId)
+                .setOffset(start.toLong())
+                .setText(text)
+                .setNextOffset(end.toLong())
+                .setComplete(record.state in TERMINAL_STATES && end == bytes.size)
+                .build(),
+        )
+    }
+}
+
+// RunStatusResponse for record, served by runs/{runId} and sent to callback URLs.
+fun runStatusResponse(record: RunRecord): RunStatusResponse {
+    val response = RunStatusResponse.newBuilder()
+        .setRequestId(record.requestId)
+        .setRunId(record.runId)
+        .setOperation(record.operation)
+        .setState(record.state)
+        .setRunState(runState(record.state))
+        .setCreatedAt(protoTimestamp(record.createdAt))
+        .setUpdatedAt(protoTimestamp(record.updatedAt))
+        .setAttempts(record.attempts)
+        .setExecutionMode(OperationExecutionMode.valueOf(record.executionMode))
+
+    if (record.errorCode != null) {
+        response.setError(runError(record))
+    }
+    record.startedAt?.let { response.setStartedAt(protoTimestamp(it)) }
+    record.completedAt?.let { response.setCompletedAt(protoTimestamp(it)) }
+    record.workerId?.let { response.setWorkerId(it) }
+    record.progress?.let { response.setProgress(runProgress(it)) }
+    if (record.state in TERMINAL_STATES && record.result.isNotEmpty()) {
+        response.setResult(anyMapToStruct(record.result))
+    }
+    return response.build()
+}
+
+private fun runError(record: RunRecord): Error = Error.newBuilder()
+    .setCode(record.errorCode)
+    .setMessage(record.errorMessage ?: "operation execution failed")
+    .build()
+
+// Generated RunProgress for the last progress a bridge worker reported.
+fun runProgress(progress: RunProgress): RunProgressMessage = RunProgressMessage.newBuilder()
+    .setPercent(progress.percent)
+    .setMessage(progress.message)
+    .setUpdatedAt(protoTimestamp(progress.updatedAt))
+    .build()
+
+// Moves end back so a chunk never splits a UTF-8 sequence.
+private fun utf8Boundary(bytes: ByteArray, end: Int): Int {
+    var i = end
+    while (i > 0 && i < bytes.size && (bytes[i].toInt() and 0xC0) == 0x80) {
+        i--
     }
+    return i
 }
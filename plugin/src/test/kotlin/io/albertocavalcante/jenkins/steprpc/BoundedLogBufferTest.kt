@@ -0,0 +1,23 @@
+package io.albertocavalcante.jenkins.steprpc
+
+import java.nio.charset.StandardCharsets
+import kotlin.test.Test
+import kotlin.test.assertEquals
+
+class BoundedLogBufferTest {
+    @Test
+    fun `output within the limit is kept as written`() {
+        val buffer = BoundedLogBuffer(limit = 16)
+        buffer.write("hello\n".toByteArray(StandardCharsets.UTF_8))
+        assertEquals("hello\n", buffer.text())
+    }
+
+    @Test
+    fun `output past the limit is dropped with a notice`() {
+        val buffer = BoundedLogBuffer(limit = 8)
+        buffer.write("0123456".toByteArray(StandardCharsets.UTF_8))
+        buffer.write("789abc".toByteArray(StandardCharsets.UTF_8))
+        buffer.write('d'.code)
+        assertEquals("01234567\n[step-rpc: log truncated, 6 more bytes not kept]\n", buffer.text())
+    }
+}
@@ -0,0 +1,79 @@
+package io.albertocavalcante.jenkins.steprpc
+
+import java.net.InetAddress
+import java.net.UnknownHostException
+import java.nio.charset.StandardCharsets
+import kotlin.test.Test
+import kotlin.test.assertEquals
+import kotlin.test.assertFalse
+import kotlin.test.assertNotNull
+import kotlin.test.assertNull
+
+class CallbackNotifierTest {
+    @Test
+    fun `signature matches the documented scheme`() {
+        // Same vector as webhook.Sign in the Go client.
+        val body = """{"runId":"rpc-1","state":"succeeded"}""".toByteArray(StandardCharsets.UTF_8)
+        assertEquals(
+            "v1=c76e86ac85da0ba6529065f6df34b54f7f725d689f5e78fc37a775ed01649f83",
+            callbackSignature("s3cret", "dlv-1", 1700000000L, body),
+        )
+    }
+
+    // Resolves hosts from a fixed table, and IP literals as themselves.
+    private val dns = mapOf(
+        "hooks.example.com" to listOf("93.184.216.34"),
+        "internal.example.com" to listOf("10.0.0.5"),
+        "split.example.com" to listOf("93.184.216.34", "127.0.0.1"),
+    )
+
+    private fun policy(vararg allowedHosts: String) = CallbackPolicy(allowedHosts.toList()) { host ->
+        val addresses = dns[host] ?: listOf(host).filter { it.first().isDigit() || ':' in it }
+        if (addresses.isEmpty()) throw UnknownHostException(host)
+        addresses.map { InetAddress.getByName(it) }
+    }
+
+    @Test
+    fun `only absolute http urls are accepted`() {
+        val policy = policy()
+        assertNull(policy.problem("https://hooks.example.com/step-rpc"))
+        assertNotNull(policy.problem("file:///etc/passwd"))
+        assertNotNull(policy.problem("/relative"))
+        assertNotNull(policy.problem("https://"))
+        assertNotNull(policy.problem("not a url"))
+    }
+
+    @Test
+    fun `hosts resolving to internal addresses are refused by default`() {
+        val policy = policy()
+        for (url in listOf(
+            "http://127.0.0.1:8080/",
+            "http://[::1]/",
+            "http://169.254.169.254/latest/meta-data/",
+            "http://10.0.0.5:9000/",
+            "http://192.168.1.1/",
+            "http://100.64.0.1/",
+            "http://[fd00::1]/",
+            "http://0.0.0.0/",
+            "https://internal.example.com/hook",
+            "https://split.example.com/hook",
+            "https://missing.example.com/hook",
+        )) {
+            assertNotNull(policy.problem(url), url)
+        }
+    }
+
+    @Test
+    fun `the allowlist admits only the hosts an administrator named`() {
+        val policy = policy("internal.example.com", "*.hooks.example.com")
+        assertNull(policy.problem("https://internal.example.com/hook"))
+        assertNull(policy.problem("https://ci.hooks.example.com/hook"))
+        assertNotNull(policy.problem("https://hooks.example.com/hook"))
+        assertNotNull(policy.problem("http://127.0.0.1/"))
+    }
+
+    @Test
+    fun `secret is not printed`() {
+        assertFalse(RunCallback("https://hooks.example.com/", "s3cret").toString().contains("s3cret"))
+    }
+}
@@ -1,7 +1,11 @@
 package io.albertocavalcante.jenkins.steprpc
 
+import java.time.Duration
+import java.time.Instant
 import kotlin.test.Test
 import kotlin.test.assertEquals
+import kotlin.test.assertIs
+import kotlin.test.assertNotEquals
 import kotlin.test.assertNotNull
 import kotlin.test.assertNull
 
@@ -71,4 +75,176 @@ class CpsBridgeQueueTest {
         assertNotNull(second)
         assertEquals("req-2", second.requestId)
     }
+
+    @Test
+    fun `claimed request is not handed out again while the lease is live`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1"))
+        queue.enqueue(request("req-2", "rpc-2"))
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+
+        val first = queue.claim("job/demo#1", "worker-a", Duration.ofSeconds(30), now)
+        assertNotNull(first)
+        assertEquals("rpc-1", first.request.runId)
+        assertEquals(now.plusSeconds(30), first.lease.expiresAt)
+
+        val second = queue.claim("job/demo#1", "worker-b", Duration.ofSeconds(30), now)
+        assertNotNull(second)
+        assertEquals("rpc-2", second.request.runId)
+        assertNull(queue.claim("job/demo#1", "worker-c", Duration.ofSeconds(30), now))
+        assertNull(queue.nextForRun("job/demo#1", now))
+    }
+
+    @Test
+    fun `pending delivery is not repeated until it lapses`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1"))
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+
+        assertEquals("rpc-1", queue.nextForRun("job/demo#1", now)?.runId)
+        assertNull(queue.nextForRun("job/demo#1", now.plusSeconds(1)))
+        assertNull(queue.claim("job/demo#1", "worker-b", Duration.ofSeconds(30), now.plusSeconds(1)))
+        assertEquals(listOf(BridgeRunSummary("job/demo#1", pending = 0, leased = 1)), queue.runs(folder = null, now = now))
+
+        val lapsed = now.plus(PENDING_DELIVERY_TTL)
+        assertEquals("rpc-1", queue.nextForRun("job/demo#1", lapsed)?.runId)
+        assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(queue.complete("rpc-1", leaseId = null, now = lapsed))
+    }
+
+    @Test
+    fun `awaitNextForRun waits for a new request after a delivery`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1"))
+        assertEquals("rpc-1", queue.nextForRun("job/demo#1")?.runId)
+
+        assertNull(queue.awaitNextForRun("job/demo#1", Duration.ofMillis(20)))
+        val producer = Thread {
+            Thread.sleep(50)
+            queue.enqueue(request("req-2", "rpc-2"))
+        }
+        producer.start()
+        val pending = queue.awaitNextForRun("job/demo#1", Duration.ofSeconds(5))
+        producer.join()
+        assertEquals("rpc-2", pending?.runId)
+    }
+
+    @Test
+    fun `expired lease is redelivered under a new lease`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1"))
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+        val ttl = Duration.ofSeconds(30)
+
+        val first = assertNotNull(queue.claim("job/demo#1", "worker-a", ttl, now))
+        val heartbeat = queue.heartbeat("rpc-1", first.lease.leaseId, ttl, now.plusSeconds(20))
+        assertIs<LeaseCheck.Ok<BridgeLease>>(heartbeat)
+        assertEquals(now.plusSeconds(50), heartbeat.value.expiresAt)
+        assertNull(queue.claim("job/demo#1", "worker-b", ttl, now.plusSeconds(40)))
+
+        val later = now.plusSeconds(60)
+        val expired = queue.heartbeat("rpc-1", first.lease.leaseId, ttl, later)
+        assertEquals("lease_expired", assertIs<LeaseCheck.Rejected>(expired).code)
+
+        val second = assertNotNull(queue.claim("job/demo#1", "worker-b", ttl, later))
+        assertEquals("rpc-1", second.request.runId)
+        assertNotEquals(first.lease.leaseId, second.lease.leaseId)
+
+        val stale = queue.complete("rpc-1", first.lease.leaseId, now = later)
+        assertEquals("lease_mismatch", assertIs<LeaseCheck.Rejected>(stale).code)
+        val done = queue.complete("rpc-1", second.lease.leaseId, now = later)
+        assertEquals("req-1", assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(done).value.requestId)
+        assertIs<LeaseCheck.NotFound>(queue.heartbeat("rpc-1", second.lease.leaseId, ttl, later))
+    }
+
+    @Test
+    fun `leased request needs its lease to complete unless cancelled`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1"))
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+        assertNotNull(queue.claim("job/demo#1", "worker-a", Duration.ofSeconds(30), now))
+
+        val unleased = queue.complete("rpc-1", leaseId = null, now = now)
+        assertEquals("lease_mismatch", assertIs<LeaseCheck.Rejected>(unleased).code)
+        assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(queue.complete("rpc-1", leaseId = null, cancel = true, now = now))
+        assertIs<LeaseCheck.NotFound>(queue.complete("rpc-1", leaseId = null, now = now))
+    }
+
+    @Test
+    fun `active checks the lease without removing the request`() {
+        val queue = CpsBridgeQueue()
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+        queue.enqueue(request("req-1", "rpc-1"))
+        assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(queue.active("rpc-1", leaseId = null, now = now))
+
+        val claim = assertNotNull(queue.claim("job/demo#1", "worker-a", Duration.ofSeconds(30), now))
+        assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(queue.active("rpc-1", claim.lease.leaseId, now))
+        assertEquals("lease_mismatch", assertIs<LeaseCheck.Rejected>(queue.active("rpc-1", leaseId = null, now = now)).code)
+        val later = now.plusSeconds(31)
+        assertEquals("lease_expired", assertIs<LeaseCheck.Rejected>(queue.active("rpc-1", claim.lease.leaseId, later)).code)
+        assertIs<LeaseCheck.NotFound>(queue.active("rpc-2", leaseId = null, now = now))
+
+        assertIs<LeaseCheck.Ok<PendingBridgeRequest>>(queue.complete("rpc-1", claim.lease.leaseId, now = now))
+        assertIs<LeaseCheck.NotFound>(queue.active("rpc-1", claim.lease.leaseId, now))
+    }
+
+    @Test
+    fun `runs lists queued target runs by folder`() {
+        val queue = CpsBridgeQueue()
+        val now = Instant.parse("2026-01-01T00:00:00Z")
+        queue.enqueue(request("req-1", "rpc-1"))
+        queue.enqueue(request("req-2", "rpc-2"))
+        queue.enqueue(request("req-3", "rpc-3").copy(targetRunExternalizableId = "team/app#7"))
+        queue.enqueue(request("req-4", "rpc-4").copy(targetRunExternalizableId = "teamwork#1"))
+        assertNotNull(queue.claim("job/demo#1", "worker-a", Duration.ofSeconds(30), now))
+
+        assertEquals(
+            listOf(
+                BridgeRunSummary("job/demo#1", pending = 1, leased = 1),
+                BridgeRunSummary("team/app#7", pending = 1, leased = 0),
+                BridgeRunSummary("teamwork#1", pending = 1, leased = 0),
+            ),
+            queue.runs(folder = null, now = now),
+        )
+        assertEquals(listOf("team/app#7"), queue.runs("/team/", now).map { it.targetRunExternalizableId })
+
+        queue.complete("rpc-3")
+        assertEquals(emptyList(), queue.runs("team", now))
+    }
+
+    @Test
+    fun `lease ttl is clamped`() {
+        assertEquals(DEFAULT_LEASE_TTL, leaseTtl(0))
+        assertEquals(Duration.ofSeconds(5), leaseTtl(5))
+        assertEquals(MAX_LEASE_TTL, leaseTtl(86_400))
+    }
+
+    @Test
+    fun `awaitNextForRun wakes when a request is enqueued`() {
+        val queue = CpsBridgeQueue()
+        val producer = Thread {
+            Thread.sleep(50)
+            queue.enqueue(request("req-1", "rpc-1"))
+        }
+        producer.start()
+
+        val pending = queue.awaitNextForRun("job/demo#1", Duration.ofSeconds(5))
+        producer.join()
+        assertEquals("rpc-1", pending?.runId)
+    }
+
+    @Test
+    fun `awaitNextForRun returns null when the wait passes`() {
+        val queue = CpsBridgeQueue()
+        queue.enqueue(request("req-1", "rpc-1").copy(targetRunExternalizableId = "job/other#1"))
+
+        assertNull(queue.awaitNextForRun("job/demo#1", Duration.ofMillis(20)))
+    }
+
+    private fun request(requestId: String, runId: String) = PendingBridgeRequest(
+        requestId = requestId,
+        runId = runId,
+        operation = "stash",
+        args = emptyMap(),
+        targetRunExternalizableId = "job/demo#1",
+    )
 }
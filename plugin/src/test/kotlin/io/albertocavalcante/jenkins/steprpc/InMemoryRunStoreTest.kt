@@ -1,7 +1,11 @@
 package io.albertocavalcante.jenkins.steprpc
 
+import java.time.Instant
+import java.util.concurrent.CompletableFuture
+import java.util.concurrent.TimeUnit
 import kotlin.test.Test
 import kotlin.test.assertEquals
+import kotlin.test.assertFalse
 import kotlin.test.assertNotNull
 import kotlin.test.assertNull
 
@@ -95,4 +99,137 @@ class InMemoryRunStoreTest {
         assertNull(updated.errorCode)
         assertNull(updated.errorMessage)
     }
+
+    @Test
+    fun `findByRequestId tracks latest record for request`() {
+        val store = InMemoryRunStore()
+        store.create(
+            requestId = "req-1",
+            runId = "run-1",
+            operation = "junit",
+            state = "queued",
+        )
+        store.update(runId = "run-1", state = "succeeded")
+
+        val found = store.findByRequestId("req-1")
+        assertNotNull(found)
+        assertEquals("run-1", found.runId)
+        assertEquals("succeeded", found.state)
+        assertNull(store.findByRequestId("req-2"))
+    }
+
+    @Test
+    fun `reserve hands a concurrent retry the first attempt's run`() {
+        val store = InMemoryRunStore()
+        assertNull(store.reserve("req-1"))
+
+        val retry = CompletableFuture.supplyAsync { store.reserve("req-1") }
+        Thread.sleep(50)
+        assertFalse(retry.isDone)
+
+        store.create(requestId = "req-1", runId = "run-1", operation = "junit", state = "queued")
+        val replayed = retry.get(5, TimeUnit.SECONDS)
+        assertNotNull(replayed)
+        assertEquals("run-1", replayed.runId)
+    }
+
+    @Test
+    fun `released reservation can be taken again`() {
+        val store = InMemoryRunStore()
+        assertNull(store.reserve("req-1"))
+        assertNull(store.findByRequestId("req-1"))
+
+        store.release("req-1")
+        assertNull(store.reserve("req-1"))
+    }
+
+    @Test
+    fun `claim and completion track the bridge lifecycle`() {
+        val store = InMemoryRunStore()
+        val queued = store.create(
+            requestId = "req-1",
+            runId = "run-1",
+            operation = "stash",
+            state = "queued",
+            executionMode = MODE_CPS_BRIDGE,
+        )
+        assertNull(queued.startedAt)
+        assertNull(queued.completedAt)
+        assertEquals(0, queued.attempts)
+
+        val first = store.claim("run-1", "worker-a")
+        assertNotNull(first)
+        assertNotNull(first.startedAt)
+        assertEquals(1, first.attempts)
+        assertEquals("running", first.state)
+
+        val second = store.claim("run-1", "worker-b")
+        assertNotNull(second)
+        assertEquals(first.startedAt, second.startedAt)
+        assertEquals(2, second.attempts)
+        assertEquals("worker-b", second.workerId)
+        assertNull(store.claim("missing", "worker-a"))
+
+        val done = store.update(runId = "run-1", state = "succeeded")
+        assertNotNull(done)
+        assertNotNull(done.completedAt)
+        assertEquals(done.completedAt, done.updatedAt)
+        assertEquals(MODE_CPS_BRIDGE, done.executionMode)
+    }
+
+    @Test
+    fun `bridge progress and result are kept on the record`() {
+        val store = InMemoryRunStore()
+        store.create(
+            requestId = "req-1",
+            runId = "run-1",
+            operation = "readFile",
+            state = "running",
+            executionMode = MODE_CPS_BRIDGE,
+        )
+
+        val reported = store.progress("run-1", 40, "reading")
+        assertNotNull(reported)
+        assertEquals(40, reported.progress?.percent)
+        assertEquals("reading", reported.progress?.message)
+        assertNull(store.progress("missing", 10, ""))
+
+        val done = store.update(runId = "run-1", state = "succeeded", result = mapOf("content" to "hello"))
+        assertNotNull(done)
+        assertEquals(mapOf<String, Any?>("content" to "hello"), done.result)
+        assertEquals(reported.progress, done.progress)
+
+        val unchanged = store.update(runId = "run-1", state = "succeeded")
+        assertEquals(done.result, unchanged?.result)
+    }
+
+    @Test
+    fun `onTerminal fires once when a run completes`() {
+        val completed = mutableListOf<RunRecord>()
+        val store = InMemoryRunStore(onTerminal = { completed.add(it) })
+        store.create(requestId = "req-1", runId = "run-1", operation = "stash", state = "queued")
+        store.create(requestId = "req-2", runId = "run-2", operation = "junit", state = "succeeded")
+        assertEquals(listOf("run-2"), completed.map { it.runId })
+
+        store.update(runId = "run-1", state = "running")
+        store.update(runId = "run-1", state = "failed", errorCode = "operation_failed")
+        store.update(runId = "run-1", state = "failed")
+        assertEquals(listOf("run-2", "run-1"), completed.map { it.runId })
+        assertEquals("operation_failed", completed.last().errorCode)
+    }
+
+    @Test
+    fun `direct runs are created started and complete`() {
+        val store = InMemoryRunStore()
+        val record = store.create(
+            requestId = "req-1",
+            runId = "run-1",
+            operation = "junit",
+            state = "succeeded",
+            startedAt = Instant.now(),
+        )
+        assertEquals(1, record.attempts)
+        assertNotNull(record.completedAt)
+        assertEquals(MODE_DIRECT, record.executionMode)
+    }
 }
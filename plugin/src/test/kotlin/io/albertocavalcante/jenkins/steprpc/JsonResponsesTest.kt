@@ -0,0 +1,16 @@
+package io.albertocavalcante.jenkins.steprpc
+
+import io.albertocavalcante.jenkins.steprpc.v1.RunState
+import kotlin.test.Test
+import kotlin.test.assertEquals
+
+class JsonResponsesTest {
+    @Test
+    fun `runState and stateName map legacy states both ways`() {
+        for (state in listOf("queued", "running", "succeeded", "failed", "cancelled")) {
+            assertEquals(state, stateName(runState(state)))
+        }
+        assertEquals(RunState.RUN_STATE_UNSPECIFIED, runState("paused"))
+        assertEquals("", stateName(RunState.RUN_STATE_UNSPECIFIED))
+    }
+}
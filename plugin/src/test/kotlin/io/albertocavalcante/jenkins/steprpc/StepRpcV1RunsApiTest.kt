@@ -0,0 +1,51 @@
+package io.albertocavalcante.jenkins.steprpc
+
+import io.albertocavalcante.jenkins.steprpc.v1.OperationExecutionMode
+import io.albertocavalcante.jenkins.steprpc.v1.RunState
+import java.time.Instant
+import kotlin.test.Test
+import kotlin.test.assertEquals
+import kotlin.test.assertFalse
+import kotlin.test.assertTrue
+
+class StepRpcV1RunsApiTest {
+    @Test
+    fun `runStatusResponse carries the bridge lifecycle`() {
+        val store = InMemoryRunStore()
+        store.create(
+            requestId = "req-1",
+            runId = "run-1",
+            operation = "readFile",
+            state = "queued",
+            executionMode = MODE_CPS_BRIDGE,
+            createdAt = Instant.ofEpochSecond(1_700_000_000, 5),
+        )
+        store.claim("run-1", "worker-a")
+        store.progress("run-1", 40, "reading")
+        val record = store.update(runId = "run-1", state = "succeeded", result = mapOf("content" to "hello"))!!
+
+        val response = runStatusResponse(record)
+        assertEquals(RunState.RUN_STATE_SUCCEEDED, response.runState)
+        assertEquals(OperationExecutionMode.OPERATION_EXECUTION_MODE_CPS_BRIDGE_REQUIRED, response.executionMode)
+        assertEquals(1_700_000_000, response.createdAt.seconds)
+        assertEquals(5, response.createdAt.nanos)
+        assertTrue(response.hasStartedAt())
+        assertTrue(response.hasCompletedAt())
+        assertEquals(1, response.attempts)
+        assertEquals("worker-a", response.workerId)
+        assertEquals(40, response.progress.percent)
+        assertEquals("hello", response.result.fieldsMap["content"]?.stringValue)
+    }
+
+    @Test
+    fun `runStatusResponse leaves unset lifecycle fields out`() {
+        val record = InMemoryRunStore().create(requestId = "req-1", runId = "run-1", operation = "stash", state = "queued")
+
+        val response = runStatusResponse(record)
+        assertEquals(RunState.RUN_STATE_QUEUED, response.runState)
+        assertFalse(response.hasStartedAt())
+        assertFalse(response.hasCompletedAt())
+        assertFalse(response.hasProgress())
+        assertFalse(response.hasResult())
+    }
+}
@@ -0,0 +1,51 @@
+//go:build e2e
+
+package e2e_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/albertocavalcante/jenkins-rpc/go-client/conformance"
+)
+
+// TestConformance runs the wire contract suite against the controller. The
+// image has no Pipeline plugins, so the bridge checks are skipped.
+func TestConformance(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	jobName := "e2e-conformance"
+	jobXML := `<?xml version='1.1' encoding='UTF-8'?>
+<project>
+  <builders>
+    <hudson.tasks.Shell>
+      <command>echo "conformance" > conformance.txt</command>
+    </hudson.tasks.Shell>
+  </builders>
+</project>`
+	if err := createJob(ctx, jenkinsURL, jobName, jobXML); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := triggerBuild(ctx, jenkinsURL, jobName); err != nil {
+		t.Fatalf("trigger build: %v", err)
+	}
+	if err := waitForBuild(ctx, jenkinsURL, jobName, 1); err != nil {
+		t.Fatalf("wait for build: %v", err)
+	}
+
+	conformance.Run(t, conformance.Config{
+		BaseURL:         jenkinsURL,
+		DirectOperation: "archiveArtifacts",
+		DirectArgs: map[string]any{
+			"artifacts": "conformance.txt",
+			"runContext": map[string]any{
+				"jobFullName": jobName,
+				"buildNumber": 1,
+				"nodeName":    "built-in",
+				"workspace":   "/var/jenkins_home/workspace/" + jobName,
+			},
+		},
+	})
+}
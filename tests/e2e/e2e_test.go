@@ -83,10 +83,7 @@ func TestHealth(t *testing.T) {
 }
 
 func TestCatalog(t *testing.T) {
-	client, err := jenkinsrpc.New(jenkinsURL, "", nil)
-	if err != nil {
-		t.Fatalf("new client: %v", err)
-	}
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -158,10 +155,7 @@ func TestDirectInvoke(t *testing.T) {
 	}
 
 	// 3. Invoke archiveArtifacts via RPC.
-	client, err := jenkinsrpc.New(jenkinsURL, "", nil)
-	if err != nil {
-		t.Fatalf("new client: %v", err)
-	}
+	client := newTestClient(t)
 
 	args, err := structpb.NewStruct(map[string]any{
 		"artifacts": "artifact.txt",
@@ -8,9 +8,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"testing"
 	"time"
+
+	jenkinsrpc "github.com/albertocavalcante/jenkins-rpc/go-client"
+	"github.com/albertocavalcante/jenkins-rpc/go-client/cassette"
 )
 
 const composeDir = "docker"
@@ -149,3 +155,31 @@ func waitForBuild(ctx context.Context, baseURL, jobName string, buildNum int) er
 		}
 	}
 }
+
+// newTestClient returns a client for jenkinsURL. When E2E_RECORD_CASSETTES
+// names a directory, traffic is recorded and saved as <dir>/<TestName>.json
+// on cleanup so it can be replayed by unit tests.
+func newTestClient(t *testing.T) *jenkinsrpc.Client {
+	t.Helper()
+	dir := os.Getenv("E2E_RECORD_CASSETTES")
+	if dir == "" {
+		client, err := jenkinsrpc.New(jenkinsURL, "", nil)
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+		return client
+	}
+
+	rec := cassette.NewRecorder(nil)
+	client, err := jenkinsrpc.New(jenkinsURL, "", &http.Client{Transport: rec})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	t.Cleanup(func() {
+		path := filepath.Join(dir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+		if err := rec.Save(path); err != nil {
+			t.Errorf("save cassette: %v", err)
+		}
+	})
+	return client
+}